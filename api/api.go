@@ -0,0 +1,9 @@
+// Package api holds the OpenAPI description of the server.
+package api
+
+import _ "embed"
+
+// Spec is the OpenAPI 3 document in openapi.json.
+//
+//go:embed openapi.json
+var Spec []byte
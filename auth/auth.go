@@ -0,0 +1,92 @@
+// Package auth holds API key handling and the request scoped identity of the caller: the college and
+// placement season a request is served for and, on the recruiter portal, the recruiter making it.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+)
+
+const keyBytes = 32
+
+type (
+	recruiterKey struct{}
+	collegeKey   struct{}
+	seasonKey    struct{}
+	principalKey struct{}
+)
+
+// NewKey returns a random API key together with the hash to store for it.
+func NewKey() (key, hash string, err error) {
+	b := make([]byte, keyBytes)
+	if _, err = rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	key = hex.EncodeToString(b)
+
+	return key, HashKey(key), nil
+}
+
+// HashKey returns the hex encoded SHA-256 of an API key. Keys are random, so an unsalted hash is
+// enough to keep stored hashes from being used as keys.
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// WithPrincipal returns a copy of ctx naming the client the request was authenticated as, such as
+// "college:<id>". Clients are throttled by it.
+func WithPrincipal(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom returns the principal stored in ctx by WithPrincipal.
+func PrincipalFrom(ctx context.Context) (string, bool) {
+	p, ok := ctx.Value(principalKey{}).(string)
+
+	return p, ok && p != ""
+}
+
+// WithRecruiter returns a copy of ctx carrying the authenticated recruiter.
+func WithRecruiter(ctx context.Context, r *entities.Recruiter) context.Context {
+	return context.WithValue(ctx, recruiterKey{}, *r)
+}
+
+// RecruiterFrom returns the recruiter stored in ctx by WithRecruiter.
+func RecruiterFrom(ctx context.Context) (entities.Recruiter, bool) {
+	r, ok := ctx.Value(recruiterKey{}).(entities.Recruiter)
+
+	return r, ok
+}
+
+// WithCollege returns a copy of ctx scoped to the college with the given id.
+func WithCollege(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, collegeKey{}, id)
+}
+
+// CollegeFrom returns the college stored in ctx by WithCollege.
+func CollegeFrom(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(collegeKey{}).(uuid.UUID)
+
+	return id, ok && id != uuid.Nil
+}
+
+// WithSeason returns a copy of ctx scoped to the placement season with the given id.
+func WithSeason(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, seasonKey{}, id)
+}
+
+// SeasonFrom returns the season stored in ctx by WithSeason.
+func SeasonFrom(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(seasonKey{}).(uuid.UUID)
+
+	return id, ok && id != uuid.Nil
+}
@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+)
+
+func TestNewKey(t *testing.T) {
+	key, hash, err := NewKey()
+
+	assert.NoError(t, err)
+	assert.Len(t, key, 64)
+	assert.Equal(t, HashKey(key), hash)
+	assert.NotEqual(t, key, hash)
+}
+
+func TestRecruiterFrom(t *testing.T) {
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: uuid.New()}
+
+	_, ok := RecruiterFrom(context.Background())
+	assert.False(t, ok)
+
+	output, ok := RecruiterFrom(WithRecruiter(context.Background(), &rec))
+	assert.True(t, ok)
+	assert.Equal(t, rec, output)
+}
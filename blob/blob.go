@@ -0,0 +1,40 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned when no blob is stored under a key.
+//
+//nolint:gochecknoglobals // sentinel error
+var ErrNotFound = errors.New("blob: not found")
+
+// ErrInvalidKey is returned for keys that are not lower case hexadecimal, such as keys that would
+// escape the storage directory.
+//
+//nolint:gochecknoglobals // sentinel error
+var ErrInvalidKey = errors.New("blob: invalid key")
+
+// Storage keeps file contents under keys. Keys are content checksums, so storing the same key
+// twice stores the same contents and Put may skip the second write.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+func validKey(key string) bool {
+	if len(key) < 2 {
+		return false
+	}
+
+	for _, c := range key {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,102 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Disk stores blobs as files under a directory, spread over sub-directories named after the first
+// two characters of the key.
+type Disk struct {
+	dir string
+}
+
+// NewDisk returns storage rooted at dir, creating the directory if needed.
+func NewDisk(dir string) (Disk, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return Disk{}, err
+	}
+
+	return Disk{dir: dir}, nil
+}
+
+// Put writes the contents to a temporary file and renames it into place, so a reader never sees
+// a partly written blob. A key that is already stored is left as it is.
+func (d Disk) Put(_ context.Context, key string, r io.Reader) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+
+	if _, err = os.Stat(path); err == nil {
+		return nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".tmp*")
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(tmp, r); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err = tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return err
+	}
+
+	return nil
+}
+
+func (d Disk) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := d.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+
+	return f, err
+}
+
+func (d Disk) Delete(_ context.Context, key string) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return ErrNotFound
+	}
+
+	return err
+}
+
+func (d Disk) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(d.dir, key[:2], key), nil
+}
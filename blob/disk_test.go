@@ -0,0 +1,57 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisk(t *testing.T) {
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "documents")
+
+	d, err := NewDisk(dir)
+	assert.NoError(t, err)
+
+	key := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	assert.NoError(t, d.Put(ctx, key, strings.NewReader("test")))
+	assert.NoError(t, d.Put(ctx, key, strings.NewReader("ignored")), "second put of a stored key")
+
+	r, err := d.Open(ctx, key)
+	assert.NoError(t, err)
+
+	content, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, "test", string(content))
+
+	entries, err := os.ReadDir(filepath.Join(dir, "9f"))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary files are cleaned up")
+
+	assert.NoError(t, d.Delete(ctx, key))
+
+	_, err = d.Open(ctx, key)
+	assert.Equal(t, ErrNotFound, err)
+	assert.Equal(t, ErrNotFound, d.Delete(ctx, key))
+}
+
+func TestDiskInvalidKey(t *testing.T) {
+	ctx := context.Background()
+
+	d, err := NewDisk(t.TempDir())
+	assert.NoError(t, err)
+
+	for _, key := range []string{"", "a", "../etc/passwd", "ABCD", "ab/cd"} {
+		assert.Equal(t, ErrInvalidKey, d.Put(ctx, key, strings.NewReader("x")), "key %q", key)
+
+		_, err = d.Open(ctx, key)
+		assert.Equal(t, ErrInvalidKey, err, "key %q", key)
+	}
+}
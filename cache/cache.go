@@ -0,0 +1,97 @@
+// Package cache keeps read-mostly data, such as companies, in front of the stores.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// Backend stores cached values by key. LRU keeps them in process; a shared backend lets several
+// servers see each other's invalidations. A backend that cannot be reached reports a miss, so the
+// value is read from the store instead.
+type Backend interface {
+	Get(ctx context.Context, key string) ([]byte, bool)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration)
+	Delete(ctx context.Context, key string)
+}
+
+type entry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// LRU is an in-process Backend holding at most size values. Setting a value when it is full evicts
+// the least recently used one.
+type LRU struct {
+	mu    sync.Mutex
+	size  int
+	items map[string]*list.Element
+	// order holds the entries from the most to the least recently used.
+	order *list.List
+	// now is replaced in tests to expire entries.
+	now func() time.Time
+}
+
+func NewLRU(size int) *LRU {
+	return &LRU{size: size, items: make(map[string]*list.Element), order: list.New(), now: time.Now}
+}
+
+func (l *LRU) Get(_ context.Context, key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*entry)
+	if !l.now().Before(e.expires) {
+		l.remove(el)
+
+		return nil, false
+	}
+
+	l.order.MoveToFront(el)
+
+	return e.value, true
+}
+
+func (l *LRU) Set(_ context.Context, key string, value []byte, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	expires := l.now().Add(ttl)
+
+	if el, ok := l.items[key]; ok {
+		e := el.Value.(*entry)
+		e.value, e.expires = value, expires
+		l.order.MoveToFront(el)
+
+		return
+	}
+
+	if l.order.Len() >= l.size {
+		l.remove(l.order.Back())
+	}
+
+	l.items[key] = l.order.PushFront(&entry{key: key, value: value, expires: expires})
+}
+
+func (l *LRU) Delete(_ context.Context, key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.items[key]; ok {
+		l.remove(el)
+	}
+}
+
+// remove drops el. It is called with mu held.
+func (l *LRU) remove(el *list.Element) {
+	l.order.Remove(el)
+	delete(l.items, el.Value.(*entry).key)
+}
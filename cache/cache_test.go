@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	lru := NewLRU(2)
+	lru.now = func() time.Time { return now }
+
+	lru.Set(ctx, "a", []byte("1"), time.Minute)
+	lru.Set(ctx, "b", []byte("2"), time.Hour)
+	_, _ = lru.Get(ctx, "a")
+	lru.Set(ctx, "c", []byte("3"), time.Hour)
+
+	_, ok := lru.Get(ctx, "b")
+	assert.False(t, ok, "the least recently used value should be evicted")
+
+	val, ok := lru.Get(ctx, "a")
+	assert.True(t, ok, "a recently used value should be kept")
+	assert.Equal(t, []byte("1"), val)
+
+	now = now.Add(time.Minute)
+
+	_, ok = lru.Get(ctx, "a")
+	assert.False(t, ok, "a value should expire after its TTL")
+
+	lru.Delete(ctx, "c")
+
+	_, ok = lru.Get(ctx, "c")
+	assert.False(t, ok, "a deleted value should be gone")
+}
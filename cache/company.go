@@ -0,0 +1,166 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+// Stats counts the lookups served from the cache and those that went to the store.
+type Stats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+}
+
+// Companies caches companies by college and ID for the company store and the company lookup of the
+// student store, so a write through either store wrapper invalidates the company for both. Only
+// companies found are cached. A lookup racing with a write may cache the old company, which then
+// lasts until the TTL. The lookup of the student store is scoped to a season as well, so the cache
+// also remembers the seasons a company was found in.
+type Companies struct {
+	backend Backend
+	ttl     time.Duration
+	hits    atomic.Uint64
+	misses  atomic.Uint64
+}
+
+func NewCompanies(backend Backend, ttl time.Duration) *Companies {
+	return &Companies{backend: backend, ttl: ttl}
+}
+
+// Stats returns the hits and misses since the cache was created.
+func (c *Companies) Stats() Stats {
+	return Stats{Hits: c.hits.Load(), Misses: c.misses.Load()}
+}
+
+// CompanyStore returns s with GetByID cached and the cache invalidated by writes.
+func (c *Companies) CompanyStore(s pkgstore.CompanyStore) pkgstore.CompanyStore {
+	return companyStore{CompanyStore: s, cache: c}
+}
+
+// StudentStore returns s with GetCompanyByID cached.
+func (c *Companies) StudentStore(s pkgstore.StudentStore) pkgstore.StudentStore {
+	return studentStore{StudentStore: s, cache: c}
+}
+
+// get returns the company from the cache, or from load when it is not cached.
+func (c *Companies) get(ctx context.Context, id uuid.UUID,
+	load func(context.Context, uuid.UUID) (entities.Company, error)) (entities.Company, error) {
+	key, err := companyKey(ctx, id)
+	if err != nil {
+		return load(ctx, id)
+	}
+
+	if data, ok := c.backend.Get(ctx, key); ok {
+		var company entities.Company
+		if json.Unmarshal(data, &company) == nil {
+			c.hits.Add(1)
+
+			return company, nil
+		}
+	}
+
+	c.misses.Add(1)
+
+	company, err := load(ctx, id)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
+	c.set(ctx, key, company)
+
+	return company, nil
+}
+
+func (c *Companies) set(ctx context.Context, key string, company entities.Company) {
+	if data, err := json.Marshal(company); err == nil {
+		c.backend.Set(ctx, key, data, c.ttl)
+	}
+}
+
+// invalidate removes the company from the cache.
+func (c *Companies) invalidate(ctx context.Context, id uuid.UUID) {
+	if key, err := companyKey(ctx, id); err == nil {
+		c.backend.Delete(ctx, key)
+	}
+}
+
+// companyKey returns the cache key of the company in the college of ctx.
+func companyKey(ctx context.Context, id uuid.UUID) (string, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return "company:" + college.String() + ":" + id.String(), nil
+}
+
+type companyStore struct {
+	pkgstore.CompanyStore
+	cache *Companies
+}
+
+func (s companyStore) GetByID(ctx context.Context, id uuid.UUID) (entities.Company, error) {
+	return s.cache.get(ctx, id, s.CompanyStore.GetByID)
+}
+
+func (s companyStore) Update(ctx context.Context, id uuid.UUID, cmp entities.Company) (entities.Company, error) {
+	defer s.cache.invalidate(ctx, id)
+
+	return s.CompanyStore.Update(ctx, id, cmp)
+}
+
+func (s companyStore) Patch(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error {
+	defer s.cache.invalidate(ctx, id)
+
+	return s.CompanyStore.Patch(ctx, id, fields)
+}
+
+func (s companyStore) Delete(ctx context.Context, id uuid.UUID) error {
+	defer s.cache.invalidate(ctx, id)
+
+	return s.CompanyStore.Delete(ctx, id)
+}
+
+type studentStore struct {
+	pkgstore.StudentStore
+	cache *Companies
+}
+
+// GetCompanyByID serves the company from the cache once it was found in the season of ctx. A company
+// takes part in a season until it is deleted, which invalidates it, so the mark does not go stale.
+func (s studentStore) GetCompanyByID(ctx context.Context, id uuid.UUID) (entities.Company, error) {
+	key, err := companyKey(ctx, id)
+	if err != nil {
+		return s.StudentStore.GetCompanyByID(ctx, id)
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return s.StudentStore.GetCompanyByID(ctx, id)
+	}
+
+	found := key + ":season:" + season.String()
+
+	if _, ok := s.cache.backend.Get(ctx, found); ok {
+		return s.cache.get(ctx, id, s.StudentStore.GetCompanyByID)
+	}
+
+	s.cache.misses.Add(1)
+
+	company, err := s.StudentStore.GetCompanyByID(ctx, id)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
+	s.cache.set(ctx, key, company)
+	s.cache.backend.Set(ctx, found, []byte{1}, s.cache.ttl)
+
+	return company, nil
+}
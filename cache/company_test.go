@@ -0,0 +1,88 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func TestCompanies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCompany := store.NewMockCompanyStore(ctrl)
+	mockStudent := store.NewMockStudentStore(ctrl)
+
+	ctx := auth.WithSeason(auth.WithCollege(context.Background(), uuid.New()), uuid.New())
+	otherSeason := auth.WithSeason(ctx, uuid.New())
+	company := entities.Company{ID: uuid.New(), Name: "Zopsmart", Category: entities.DREAMIT,
+		Criteria: &entities.EligibilityCriteria{MinCGPA: 7.5}}
+	missing := uuid.New()
+
+	cache := NewCompanies(NewLRU(10), time.Minute)
+	companies, students := cache.CompanyStore(mockCompany), cache.StudentStore(mockStudent)
+
+	mockCompany.EXPECT().GetByID(ctx, company.ID).Return(company, nil).Times(2)
+	mockCompany.EXPECT().GetByID(ctx, missing).Return(entities.Company{}, errors.EntityNotFound{Reason: "id not found"}).Times(2)
+	mockCompany.EXPECT().Update(ctx, company.ID, company).Return(company, nil)
+	mockStudent.EXPECT().GetCompanyByID(ctx, company.ID).Return(company, nil)
+	mockStudent.EXPECT().GetCompanyByID(otherSeason, company.ID).Return(entities.Company{}, errors.EntityNotFound{Reason: "id not found"})
+
+	tests := []struct {
+		description string
+		ctx         context.Context
+		get         func(context.Context, uuid.UUID) (entities.Company, error)
+		id          uuid.UUID
+		expRes      entities.Company
+		expErr      error
+		expStats    Stats
+	}{
+		{"Success case: miss", ctx, companies.GetByID, company.ID, company, nil, Stats{Misses: 1}},
+		{"Success case: hit", ctx, companies.GetByID, company.ID, company, nil, Stats{Hits: 1, Misses: 1}},
+		{"Success case: student store checks the season first", ctx, students.GetCompanyByID, company.ID, company, nil,
+			Stats{Hits: 1, Misses: 2}},
+		{"Success case: student store hit in the season", ctx, students.GetCompanyByID, company.ID, company, nil,
+			Stats{Hits: 2, Misses: 2}},
+		{"Error case: company not in the season", otherSeason, students.GetCompanyByID, company.ID, entities.Company{},
+			errors.EntityNotFound{Reason: "id not found"}, Stats{Hits: 2, Misses: 3}},
+		{"Error case: not found is not cached", ctx, companies.GetByID, missing, entities.Company{},
+			errors.EntityNotFound{Reason: "id not found"}, Stats{Hits: 2, Misses: 4}},
+		{"Error case: not found again", ctx, companies.GetByID, missing, entities.Company{},
+			errors.EntityNotFound{Reason: "id not found"}, Stats{Hits: 2, Misses: 5}},
+	}
+
+	for i, tc := range tests {
+		res, err := tc.get(tc.ctx, tc.id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, res, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expStats, cache.Stats(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+
+	_, _ = companies.Update(ctx, company.ID, company)
+	_, _ = companies.GetByID(ctx, company.ID)
+
+	assert.Equal(t, Stats{Hits: 2, Misses: 6}, cache.Stats(), "an update should invalidate the company")
+}
+
+func TestCompaniesWithoutCollege(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockCompany := store.NewMockCompanyStore(ctrl)
+	id := uuid.New()
+	scopeErr := errors.MissingParam{Param: []string{"college"}}
+
+	mockCompany.EXPECT().GetByID(context.Background(), id).Return(entities.Company{}, scopeErr)
+
+	cache := NewCompanies(NewLRU(10), time.Minute)
+	_, err := cache.CompanyStore(mockCompany).GetByID(context.Background(), id)
+
+	assert.Equal(t, scopeErr, err)
+	assert.Equal(t, Stats{}, cache.Stats(), "a lookup without a college should bypass the cache")
+}
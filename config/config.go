@@ -0,0 +1,396 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/phone"
+	"github.com/aditi-zs/Placement-API/ratelimit"
+)
+
+const (
+	defaultMinAge          = 22
+	defaultDocumentDir     = "data/documents"
+	defaultMaxDocumentSize = 5 << 20
+
+	defaultSMTPPort     = 587
+	defaultPollInterval = 30 * time.Second
+	defaultMaxAttempts  = 5
+	defaultBackoff      = time.Minute
+
+	defaultWebhookPollInterval = 10 * time.Second
+	defaultWebhookMaxAttempts  = 8
+
+	defaultOutboxPollInterval = 2 * time.Second
+
+	defaultCompanyCacheSize = 1000
+	defaultCompanyCacheTTL  = 5 * time.Minute
+
+	// The admin API is shared by a college's office, which usually sits behind a single address.
+	defaultPublicRequests = 60
+	defaultAdminRequests  = 1200
+	defaultPortalRequests = 300
+
+	defaultIdempotencyTTL = 24 * time.Hour
+)
+
+// Config holds the settings read from the environment. Every setting has a default so the
+// server runs without any environment configured.
+type Config struct {
+	// MinAge is the minimum age a student must have reached on AgeReferenceDate, in seasons that
+	// do not set their own.
+	MinAge int
+	// AgeReferenceDate is the day ages are computed on, in seasons that do not set their own. The
+	// zero value means the current day.
+	AgeReferenceDate time.Time
+	// PhoneRegion is the region of phone numbers written without a country code.
+	PhoneRegion string
+	// DuplicateKeys are the keys students are compared on to detect duplicates.
+	DuplicateKeys []entities.DuplicateKey
+	// DocumentDir is the directory uploaded student documents are stored in.
+	DocumentDir string
+	// MaxDocumentSize is the largest document, in bytes, that can be uploaded.
+	MaxDocumentSize int64
+	// Notify holds the notification channels and how failed sends are retried.
+	Notify NotifyConfig
+	// Webhooks holds how webhook deliveries are retried.
+	Webhooks WebhookConfig
+	// Outbox holds how domain events are relayed from the outbox.
+	Outbox OutboxConfig
+	// CompanyCache holds how many companies are cached and for how long.
+	CompanyCache CacheConfig
+	// RateLimit holds the request limit of each route group.
+	RateLimit RateLimitConfig
+	// IdempotencyTTL is how long the response to a request sent with an Idempotency-Key is replayed.
+	IdempotencyTTL time.Duration
+	// ValidateResponses turns on checking responses against the API spec, for tests and staging.
+	ValidateResponses bool
+	// OperatorKey is the API key of the operator of the deployment, who manages the colleges. The
+	// college routes are closed when it is empty.
+	OperatorKey string
+}
+
+// RateLimitConfig holds the limits of the route groups: the college list, the admin API and the
+// recruiter portal. A zero limit turns throttling of the group off.
+type RateLimitConfig struct {
+	Public ratelimit.Limit
+	Admin  ratelimit.Limit
+	Portal ratelimit.Limit
+}
+
+// CacheConfig holds the size of an in-process cache and how long values are kept. Writes through this
+// server invalidate cached values; the TTL bounds how stale a value written by another server gets.
+type CacheConfig struct {
+	Size int
+	TTL  time.Duration
+}
+
+// OutboxConfig holds how often the outbox is polled for events to relay and how failed relays are
+// retried.
+type OutboxConfig struct {
+	PollInterval time.Duration
+	// Backoff is the wait before an event a sink failed to accept is retried the first time.
+	Backoff time.Duration
+	// LogFile is the file every event is appended to as a line of JSON. Events are not logged when it
+	// is empty.
+	LogFile string
+}
+
+// WebhookConfig holds how often pending webhook deliveries are polled and how failed ones are retried.
+type WebhookConfig struct {
+	PollInterval time.Duration
+	MaxAttempts  int
+	// Backoff is the wait before a failed delivery is retried the first time.
+	Backoff time.Duration
+}
+
+// NotifyConfig holds the notification settings. A channel without a server configured is written to
+// the notification log instead, so notifications can be followed in development.
+type NotifyConfig struct {
+	// SMTPHost is the mail server email is sent through. Email is logged when it is empty.
+	SMTPHost     string
+	SMTPPort     int
+	SMTPFrom     string
+	SMTPUsername string
+	SMTPPassword string
+	// SMSURL is the HTTP gateway SMS are posted to with SMSKey. SMS are logged when it is empty.
+	SMSURL string
+	SMSKey string
+	// LogFile is the file logged notifications are appended to. The empty value means standard output.
+	LogFile string
+	// PollInterval is the time between two polls of the notification queue.
+	PollInterval time.Duration
+	// MaxAttempts is the number of sends after which a notification is given up.
+	MaxAttempts int
+	// Backoff is the wait before a failed notification is retried the first time.
+	Backoff time.Duration
+}
+
+func Load() (Config, error) {
+	cfg := Config{
+		MinAge:          defaultMinAge,
+		PhoneRegion:     phone.DefaultRegion,
+		DuplicateKeys:   []entities.DuplicateKey{entities.PhoneKey, entities.NameDOBKey},
+		DocumentDir:     defaultDocumentDir,
+		MaxDocumentSize: defaultMaxDocumentSize,
+		Notify: NotifyConfig{
+			SMTPPort:     defaultSMTPPort,
+			PollInterval: defaultPollInterval,
+			MaxAttempts:  defaultMaxAttempts,
+			Backoff:      defaultBackoff,
+		},
+		Webhooks: WebhookConfig{
+			PollInterval: defaultWebhookPollInterval,
+			MaxAttempts:  defaultWebhookMaxAttempts,
+			Backoff:      defaultBackoff,
+		},
+		Outbox: OutboxConfig{
+			PollInterval: defaultOutboxPollInterval,
+			Backoff:      defaultBackoff,
+		},
+		CompanyCache: CacheConfig{
+			Size: defaultCompanyCacheSize,
+			TTL:  defaultCompanyCacheTTL,
+		},
+		RateLimit: RateLimitConfig{
+			Public: ratelimit.Limit{Requests: defaultPublicRequests, Per: time.Minute},
+			Admin:  ratelimit.Limit{Requests: defaultAdminRequests, Per: time.Minute},
+			Portal: ratelimit.Limit{Requests: defaultPortalRequests, Per: time.Minute},
+		},
+		IdempotencyTTL: defaultIdempotencyTTL,
+	}
+
+	if val := os.Getenv("MIN_AGE"); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return Config{}, errors.InvalidParam{Param: "MIN_AGE"}
+		}
+
+		cfg.MinAge = n
+	}
+
+	if val := os.Getenv("AGE_REFERENCE_DATE"); val != "" {
+		d, err := entities.ParseDate(val)
+		if err != nil {
+			return Config{}, errors.InvalidParam{Param: "AGE_REFERENCE_DATE"}
+		}
+
+		cfg.AgeReferenceDate = d.Time
+	}
+
+	if val := os.Getenv("PHONE_DEFAULT_REGION"); val != "" {
+		if !phone.IsRegion(val) {
+			return Config{}, errors.InvalidParam{Param: "PHONE_DEFAULT_REGION"}
+		}
+
+		cfg.PhoneRegion = strings.ToUpper(val)
+	}
+
+	if val, ok := os.LookupEnv("DUPLICATE_KEYS"); ok {
+		keys, err := parseDuplicateKeys(val)
+		if err != nil {
+			return Config{}, err
+		}
+
+		cfg.DuplicateKeys = keys
+	}
+
+	if val := os.Getenv("DOCUMENT_DIR"); val != "" {
+		cfg.DocumentDir = val
+	}
+
+	if val := os.Getenv("MAX_DOCUMENT_SIZE"); val != "" {
+		n, err := strconv.ParseInt(val, 10, 64)
+		if err != nil || n <= 0 {
+			return Config{}, errors.InvalidParam{Param: "MAX_DOCUMENT_SIZE"}
+		}
+
+		cfg.MaxDocumentSize = n
+	}
+
+	if err := loadNotify(&cfg.Notify); err != nil {
+		return Config{}, err
+	}
+
+	if err := loadRetry("WEBHOOK", &cfg.Webhooks.PollInterval, &cfg.Webhooks.MaxAttempts, &cfg.Webhooks.Backoff); err != nil {
+		return Config{}, err
+	}
+
+	if err := loadOutbox(&cfg.Outbox); err != nil {
+		return Config{}, err
+	}
+
+	if err := loadCache("COMPANY_CACHE", &cfg.CompanyCache); err != nil {
+		return Config{}, err
+	}
+
+	for name, limit := range map[string]*ratelimit.Limit{"RATE_LIMIT_PUBLIC": &cfg.RateLimit.Public,
+		"RATE_LIMIT_ADMIN": &cfg.RateLimit.Admin, "RATE_LIMIT_PORTAL": &cfg.RateLimit.Portal} {
+		if err := loadLimit(name, limit); err != nil {
+			return Config{}, err
+		}
+	}
+
+	if err := loadDuration("IDEMPOTENCY_TTL", &cfg.IdempotencyTTL); err != nil {
+		return Config{}, err
+	}
+
+	if val := os.Getenv("OPENAPI_VALIDATE_RESPONSES"); val != "" {
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return Config{}, errors.InvalidParam{Param: "OPENAPI_VALIDATE_RESPONSES"}
+		}
+
+		cfg.ValidateResponses = b
+	}
+
+	cfg.OperatorKey = os.Getenv("OPERATOR_API_KEY")
+
+	return cfg, nil
+}
+
+// loadNotify reads the NOTIFY_* settings into cfg.
+func loadNotify(cfg *NotifyConfig) error {
+	cfg.SMTPHost = os.Getenv("NOTIFY_SMTP_HOST")
+	cfg.SMTPFrom = os.Getenv("NOTIFY_SMTP_FROM")
+	cfg.SMTPUsername = os.Getenv("NOTIFY_SMTP_USERNAME")
+	cfg.SMTPPassword = os.Getenv("NOTIFY_SMTP_PASSWORD")
+	cfg.SMSURL = os.Getenv("NOTIFY_SMS_URL")
+	cfg.SMSKey = os.Getenv("NOTIFY_SMS_KEY")
+	cfg.LogFile = os.Getenv("NOTIFY_LOG_FILE")
+
+	if cfg.SMTPHost != "" && cfg.SMTPFrom == "" {
+		return errors.MissingParam{Param: []string{"NOTIFY_SMTP_FROM"}}
+	}
+
+	if val := os.Getenv("NOTIFY_SMTP_PORT"); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n <= 0 {
+			return errors.InvalidParam{Param: "NOTIFY_SMTP_PORT"}
+		}
+
+		cfg.SMTPPort = n
+	}
+
+	return loadRetry("NOTIFY", &cfg.PollInterval, &cfg.MaxAttempts, &cfg.Backoff)
+}
+
+// loadOutbox reads the OUTBOX_* settings into cfg. The outbox has no attempt limit: events are retried
+// until every sink accepts them.
+func loadOutbox(cfg *OutboxConfig) error {
+	cfg.LogFile = os.Getenv("OUTBOX_LOG_FILE")
+
+	if err := loadDuration("OUTBOX_POLL_INTERVAL", &cfg.PollInterval); err != nil {
+		return err
+	}
+
+	return loadDuration("OUTBOX_BACKOFF", &cfg.Backoff)
+}
+
+// loadCache reads the <prefix>_SIZE and <prefix>_TTL settings of a cache.
+func loadCache(prefix string, cfg *CacheConfig) error {
+	if val := os.Getenv(prefix + "_SIZE"); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n <= 0 {
+			return errors.InvalidParam{Param: prefix + "_SIZE"}
+		}
+
+		cfg.Size = n
+	}
+
+	return loadDuration(prefix+"_TTL", &cfg.TTL)
+}
+
+// loadLimit reads a limit such as "100/1m", for 100 requests a minute, into limit when the setting is
+// present. "off" removes the limit.
+func loadLimit(name string, limit *ratelimit.Limit) error {
+	val := os.Getenv(name)
+	if val == "" {
+		return nil
+	}
+
+	if val == "off" {
+		*limit = ratelimit.Limit{}
+
+		return nil
+	}
+
+	requests, per, ok := strings.Cut(val, "/")
+	if !ok {
+		return errors.InvalidParam{Param: name}
+	}
+
+	n, err := strconv.Atoi(requests)
+	if err != nil || n <= 0 {
+		return errors.InvalidParam{Param: name}
+	}
+
+	d, err := time.ParseDuration(per)
+	if err != nil || d <= 0 {
+		return errors.InvalidParam{Param: name}
+	}
+
+	*limit = ratelimit.Limit{Requests: n, Per: d}
+
+	return nil
+}
+
+// loadRetry reads the <prefix>_POLL_INTERVAL, <prefix>_MAX_ATTEMPTS and <prefix>_BACKOFF settings of a
+// delivery queue.
+func loadRetry(prefix string, interval *time.Duration, attempts *int, backoff *time.Duration) error {
+	if val := os.Getenv(prefix + "_MAX_ATTEMPTS"); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n <= 0 {
+			return errors.InvalidParam{Param: prefix + "_MAX_ATTEMPTS"}
+		}
+
+		*attempts = n
+	}
+
+	if err := loadDuration(prefix+"_POLL_INTERVAL", interval); err != nil {
+		return err
+	}
+
+	return loadDuration(prefix+"_BACKOFF", backoff)
+}
+
+// loadDuration reads a positive duration such as "30s" into d when the setting is present.
+func loadDuration(name string, d *time.Duration) error {
+	val := os.Getenv(name)
+	if val == "" {
+		return nil
+	}
+
+	v, err := time.ParseDuration(val)
+	if err != nil || v <= 0 {
+		return errors.InvalidParam{Param: name}
+	}
+
+	*d = v
+
+	return nil
+}
+
+// parseDuplicateKeys reads a comma separated list of keys. An empty list turns detection off.
+func parseDuplicateKeys(val string) ([]entities.DuplicateKey, error) {
+	keys := []entities.DuplicateKey{}
+
+	for _, k := range strings.Split(val, ",") {
+		k = strings.TrimSpace(k)
+		if k == "" {
+			continue
+		}
+
+		if !entities.IsValidDuplicateKey(entities.DuplicateKey(k)) {
+			return nil, errors.InvalidParam{Param: "DUPLICATE_KEYS"}
+		}
+
+		keys = append(keys, entities.DuplicateKey(k))
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,140 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/ratelimit"
+)
+
+func TestLoad(t *testing.T) {
+	defaultKeys := []entities.DuplicateKey{entities.PhoneKey, entities.NameDOBKey}
+	docDir, maxDoc := "data/documents", int64(5<<20)
+	notify := NotifyConfig{SMTPPort: 587, PollInterval: 30 * time.Second, MaxAttempts: 5, Backoff: time.Minute}
+	webhooks := WebhookConfig{PollInterval: 10 * time.Second, MaxAttempts: 8, Backoff: time.Minute}
+	outbox := OutboxConfig{PollInterval: 2 * time.Second, Backoff: time.Minute}
+	cache := CacheConfig{Size: 1000, TTL: 5 * time.Minute}
+	day := 24 * time.Hour
+	limits := RateLimitConfig{
+		Public: ratelimit.Limit{Requests: 60, Per: time.Minute},
+		Admin:  ratelimit.Limit{Requests: 1200, Per: time.Minute},
+		Portal: ratelimit.Limit{Requests: 300, Per: time.Minute},
+	}
+	tests := []struct {
+		description string
+		env         map[string]string
+		expRes      Config
+		expErr      error
+	}{
+		{"Success case: defaults", map[string]string{}, Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys,
+			DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify, Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil},
+		{"Success case: age settings", map[string]string{"MIN_AGE": "21", "AGE_REFERENCE_DATE": "2026-07-01"},
+			Config{MinAge: 21, AgeReferenceDate: time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC), PhoneRegion: "IN", DuplicateKeys: defaultKeys,
+				DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify, Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: phone region", map[string]string{"PHONE_DEFAULT_REGION": "gb"}, Config{MinAge: 22, PhoneRegion: "GB", DuplicateKeys: defaultKeys,
+			DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify, Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil},
+		{"Success case: duplicate keys", map[string]string{"DUPLICATE_KEYS": "name_dob"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: []entities.DuplicateKey{entities.NameDOBKey},
+				DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify, Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: duplicate detection off", map[string]string{"DUPLICATE_KEYS": ""},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: []entities.DuplicateKey{},
+				DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify, Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: document storage", map[string]string{"DOCUMENT_DIR": "/var/lib/placement", "MAX_DOCUMENT_SIZE": "1048576"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: "/var/lib/placement",
+				MaxDocumentSize: 1 << 20, Notify: notify, Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: notification channels", map[string]string{"NOTIFY_SMTP_HOST": "mail.example.com", "NOTIFY_SMTP_PORT": "25",
+			"NOTIFY_SMTP_FROM": "placements@example.com", "NOTIFY_SMS_URL": "https://sms.example.com/send", "NOTIFY_SMS_KEY": "secret",
+			"NOTIFY_POLL_INTERVAL": "10s", "NOTIFY_MAX_ATTEMPTS": "3", "NOTIFY_BACKOFF": "5m"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: docDir, MaxDocumentSize: maxDoc,
+				Notify: NotifyConfig{SMTPHost: "mail.example.com", SMTPPort: 25, SMTPFrom: "placements@example.com",
+					SMSURL: "https://sms.example.com/send", SMSKey: "secret", PollInterval: 10 * time.Second, MaxAttempts: 3,
+					Backoff: 5 * time.Minute}, Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: webhook retries", map[string]string{"WEBHOOK_MAX_ATTEMPTS": "3", "WEBHOOK_BACKOFF": "30s"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify,
+				Webhooks: WebhookConfig{PollInterval: 10 * time.Second, MaxAttempts: 3, Backoff: 30 * time.Second}, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: outbox relay", map[string]string{"OUTBOX_POLL_INTERVAL": "500ms", "OUTBOX_LOG_FILE": "events.log"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify,
+				Webhooks: webhooks, Outbox: OutboxConfig{PollInterval: 500 * time.Millisecond, Backoff: time.Minute, LogFile: "events.log"},
+				CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: company cache", map[string]string{"COMPANY_CACHE_SIZE": "50", "COMPANY_CACHE_TTL": "30s"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify,
+				Webhooks: webhooks, Outbox: outbox, CompanyCache: CacheConfig{Size: 50, TTL: 30 * time.Second},
+				RateLimit: limits, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: rate limits", map[string]string{"RATE_LIMIT_ADMIN": "10/1s", "RATE_LIMIT_PUBLIC": "off"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify,
+				Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: RateLimitConfig{Admin: ratelimit.Limit{Requests: 10, Per: time.Second},
+					Portal: limits.Portal}, IdempotencyTTL: day}, nil,
+		},
+		{"Success case: idempotency keys", map[string]string{"IDEMPOTENCY_TTL": "1h"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify,
+				Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: time.Hour}, nil,
+		},
+		{"Success case: response validation", map[string]string{"OPENAPI_VALIDATE_RESPONSES": "true"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify,
+				Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day, ValidateResponses: true}, nil,
+		},
+		{"Success case: operator key", map[string]string{"OPERATOR_API_KEY": "s3cret"},
+			Config{MinAge: 22, PhoneRegion: "IN", DuplicateKeys: defaultKeys, DocumentDir: docDir, MaxDocumentSize: maxDoc, Notify: notify,
+				Webhooks: webhooks, Outbox: outbox, CompanyCache: cache, RateLimit: limits, IdempotencyTTL: day, OperatorKey: "s3cret"}, nil,
+		},
+		{"Error case: smtp server without a sender", map[string]string{"NOTIFY_SMTP_HOST": "mail.example.com"}, Config{},
+			errors.MissingParam{Param: []string{"NOTIFY_SMTP_FROM"}},
+		},
+		{"Error case: invalid poll interval", map[string]string{"NOTIFY_POLL_INTERVAL": "often"}, Config{},
+			errors.InvalidParam{Param: "NOTIFY_POLL_INTERVAL"},
+		},
+		{"Error case: invalid webhook attempts", map[string]string{"WEBHOOK_MAX_ATTEMPTS": "0"}, Config{},
+			errors.InvalidParam{Param: "WEBHOOK_MAX_ATTEMPTS"},
+		},
+		{"Error case: invalid outbox backoff", map[string]string{"OUTBOX_BACKOFF": "-1s"}, Config{},
+			errors.InvalidParam{Param: "OUTBOX_BACKOFF"},
+		},
+		{"Error case: invalid company cache size", map[string]string{"COMPANY_CACHE_SIZE": "0"}, Config{},
+			errors.InvalidParam{Param: "COMPANY_CACHE_SIZE"},
+		},
+		{"Error case: invalid rate limit", map[string]string{"RATE_LIMIT_PORTAL": "100"}, Config{},
+			errors.InvalidParam{Param: "RATE_LIMIT_PORTAL"},
+		},
+		{"Error case: invalid response validation flag", map[string]string{"OPENAPI_VALIDATE_RESPONSES": "sometimes"}, Config{},
+			errors.InvalidParam{Param: "OPENAPI_VALIDATE_RESPONSES"},
+		},
+		{"Error case: invalid document size", map[string]string{"MAX_DOCUMENT_SIZE": "0"}, Config{},
+			errors.InvalidParam{Param: "MAX_DOCUMENT_SIZE"},
+		},
+		{"Error case: invalid minimum age", map[string]string{"MIN_AGE": "abc"}, Config{}, errors.InvalidParam{Param: "MIN_AGE"}},
+		{"Error case: invalid reference date", map[string]string{"AGE_REFERENCE_DATE": "July"}, Config{},
+			errors.InvalidParam{Param: "AGE_REFERENCE_DATE"},
+		},
+		{"Error case: unsupported phone region", map[string]string{"PHONE_DEFAULT_REGION": "XX"}, Config{},
+			errors.InvalidParam{Param: "PHONE_DEFAULT_REGION"},
+		},
+		{"Error case: unknown duplicate key", map[string]string{"DUPLICATE_KEYS": "phone,email"}, Config{},
+			errors.InvalidParam{Param: "DUPLICATE_KEYS"},
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			output, err := Load()
+
+			assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package college
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+type handler struct {
+	service service.CollegeSvc
+}
+
+//nolint:revive // it's a factory function
+func New(s service.CollegeSvc) handler {
+	return handler{service: s}
+}
+
+// Authenticate lets requests through only with the API key of a college in the X-API-KEY header and
+// scopes the request context to that college, so the stores only see its data.
+func (h handler) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := h.service.Authenticate(r.Context(), r.Header.Get("X-API-KEY"))
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("authentication failed"))
+
+			return
+		}
+
+		ctx := auth.WithPrincipal(auth.WithCollege(r.Context(), c.ID), "college:"+c.ID.String())
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Operator lets requests through only with the operator API key in the X-API-KEY header. The college
+// routes are for the operator of the deployment; without a key configured they are closed.
+func Operator(key string) func(http.Handler) http.Handler {
+	hash := auth.HashKey(key)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sent := r.Header.Get("X-API-KEY")
+			if key == "" || subtle.ConstantTimeCompare([]byte(auth.HashKey(sent)), []byte(hash)) != 1 {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte("authentication failed"))
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), "operator")))
+		})
+	}
+}
+
+func (h handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Get(ctx)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	collegeID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(collegeID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: collegeID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var c entities.College
+	if err = json.Unmarshal(req, &c); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	resp, err := h.service.Create(ctx, &c)
+	if err != nil {
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+// RotateKey issues the college in the path a new API key. The previous key stops working.
+func (h handler) RotateKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	collegeID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(collegeID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: collegeID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.RotateKey(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
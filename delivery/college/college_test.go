@@ -0,0 +1,152 @@
+package college
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockCollegeSvc {
+	ctrl := gomock.NewController(t)
+	mockCollege := service.NewMockCollegeSvc(ctrl)
+
+	return mockCollege
+}
+
+func TestAuthenticate(t *testing.T) {
+	mockCollege := initializeTest(t)
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		key         string
+		mockErr     error
+		statusCode  int
+		expCollege  uuid.UUID
+	}{
+		{"Success case", "s3cret", nil, 200, id},
+		{"Error case: no key", "", errors.MissingParam{Param: []string{"X-API-KEY"}}, 401, uuid.Nil},
+		{"Error case: unknown key", "guess", errors.EntityNotFound{Reason: "unknown api key"}, 401, uuid.Nil},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/students", http.NoBody)
+		req.Header.Set("X-API-KEY", tc.key)
+		resRec := httptest.NewRecorder()
+
+		var (
+			seen          uuid.UUID
+			seenPrincipal string
+		)
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen, _ = auth.CollegeFrom(r.Context())
+			seenPrincipal, _ = auth.PrincipalFrom(r.Context())
+		})
+
+		mockCollege.EXPECT().Authenticate(gomock.Any(), tc.key).Return(entities.College{ID: id}, tc.mockErr)
+		New(mockCollege).Authenticate(next).ServeHTTP(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expCollege, seen, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expCollege != uuid.Nil {
+			assert.Equal(t, "college:"+id.String(), seenPrincipal, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestOperator(t *testing.T) {
+	tests := []struct {
+		description string
+		key         string
+		sent        string
+		statusCode  int
+	}{
+		{"Success case", "operator", "operator", 200},
+		{"Error case: wrong key", "operator", "guess", 401},
+		{"Error case: no key sent", "operator", "", 401},
+		{"Error case: no key configured", "", "", 401},
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/colleges", http.NoBody)
+		req.Header.Set("X-API-KEY", tc.sent)
+		resRec := httptest.NewRecorder()
+
+		Operator(tc.key)(next).ServeHTTP(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	mockCollege := initializeTest(t)
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		id          string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+		expRes      string
+	}{
+		{"Success case", id.String(), 1, nil, 200, `"apiKey":"s3cret"`},
+		{"Error case: invalid id", "abc", 0, nil, 400, errors.InvalidParam{Param: "abc"}.Error()},
+		{"Error case: unknown college", id.String(), 1, errors.EntityNotFound{Reason: "id not found"}, 404, "id not found"},
+	}
+
+	for i, tc := range tests {
+		req := mux.SetURLVars(httptest.NewRequest("POST", "/colleges/"+tc.id+"/key", http.NoBody), map[string]string{"id": tc.id})
+		resRec := httptest.NewRecorder()
+
+		mockCollege.EXPECT().RotateKey(gomock.Any(), id).Return(entities.College{ID: id, APIKey: "s3cret"}, tc.mockErr).
+			Times(tc.mockTimes)
+		New(mockCollege).RotateKey(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Contains(t, resRec.Body.String(), tc.expRes, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	mockCollege := initializeTest(t)
+
+	tests := []struct {
+		description string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", `{"name":"RVCE"}`, 1, nil, 201},
+		{"Error case: invalid body", `{"name":`, 0, nil, 400},
+		{"Error case: name taken", `{"name":"RVCE"}`, 1, errors.Conflict{Reason: "exists"}, 409},
+		{"Error case: missing name", `{"name":"RVCE"}`, 1, errors.MissingParam{Param: []string{"name"}}, 400},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/colleges", strings.NewReader(tc.body))
+		resRec := httptest.NewRecorder()
+
+		mockCollege.EXPECT().Create(gomock.Any(), &entities.College{Name: "RVCE"}).Return(entities.College{}, tc.mockErr).
+			Times(tc.mockTimes)
+		New(mockCollege).Create(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aditi-zs/Placement-API/entities"
 	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/mergepatch"
 	"github.com/aditi-zs/Placement-API/service"
 )
 
@@ -183,6 +184,65 @@ func (h handler) Update(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(respBody)
 }
 
+func (h handler) Patch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	cmpID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(cmpID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: cmpID}.Error()))
+
+		return
+	}
+
+	if !mergepatch.IsContentType(r.Header.Get("Content-Type")) {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		_, _ = w.Write([]byte("Header Content-Type incorrect"))
+
+		return
+	}
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	if !json.Valid(req) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	resp, err := h.service.Patch(ctx, id, req)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
 func (h handler) Delete(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	cmpID := mux.Vars(r)["id"]
@@ -214,6 +274,95 @@ func (h handler) Delete(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write([]byte("Data deleted Successfully"))
 }
 
+func (h handler) GetRounds(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cmpID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(cmpID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: cmpID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.GetRounds(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+// SetRounds replaces the default rounds of a company. The body is the ordered list of rounds.
+func (h handler) SetRounds(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	cmpID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(cmpID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: cmpID}.Error()))
+
+		return
+	}
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var rounds []entities.Round
+	if err = json.Unmarshal(req, &rounds); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	resp, err := h.service.SetRounds(ctx, id, rounds)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
 func validateBody(c entities.Company) error {
 	var missingParams []string
 	if c.Name == "" {
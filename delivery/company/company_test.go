@@ -199,6 +199,65 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestPatch(t *testing.T) {
+	mockCompany := initializeTest(t)
+	validID, err := uuid.Parse("1fa46d13-6a50-11ed-90d1-64bc589051b4")
+
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	tests := []struct {
+		description string
+		inputID     string
+		contType    string
+		input       string
+		mockTimes   int
+		mockRes     entities.Company
+		mockErr     error
+		expRes      string
+		statusCode  int
+	}{
+		{"Success case: category only", validID.String(), "application/merge-patch+json", `{"category":"OPEN DREAM"}`, 1,
+			entities.Company{ID: validID, Name: "Google", Category: "OPEN DREAM"}, nil,
+			`{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4","name":"Google","category":"OPEN DREAM"}`, 200,
+		},
+		{"Error case: invalid id", "abc", "application/merge-patch+json", `{}`, 0, entities.Company{}, nil,
+			"Invalid Parameter: abc", 400,
+		},
+		{"Error case: unsupported content type", validID.String(), "text/plain", `{}`, 0, entities.Company{}, nil,
+			"Header Content-Type incorrect", 415,
+		},
+		{"Error case: invalid body", validID.String(), "application/merge-patch+json", `{`, 0, entities.Company{}, nil,
+			"invalid body", 400,
+		},
+		{"Error case: id not found", validID.String(), "application/merge-patch+json", `{"name":"Alphabet"}`, 1, entities.Company{},
+			errors.EntityNotFound{Reason: "id not found"}, "Entity Not Found:id not found", 404,
+		},
+		{"Error case: db error", validID.String(), "application/json", `{"name":"Alphabet"}`, 1, entities.Company{},
+			errors.DB{Reason: "server error"}, "DB Error: server error", 400,
+		},
+	}
+
+	for i, tc := range tests {
+		req, err := http.NewRequest("PATCH", "/companies/{id}", strings.NewReader(tc.input))
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+
+		req.Header.Set("Content-Type", tc.contType)
+
+		resRec := httptest.NewRecorder()
+		req = mux.SetURLVars(req, map[string]string{"id": tc.inputID})
+		h := New(mockCompany)
+		mockCompany.EXPECT().Patch(gomock.Any(), validID, []byte(tc.input)).Return(tc.mockRes, tc.mockErr).Times(tc.mockTimes)
+		h.Patch(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
+		assert.Equal(t, tc.expRes, resRec.Body.String(), "Test[%v] failed\n(%v)", i, tc.description)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	id := uuid.New()
 	mockCompany := initializeTest(t)
@@ -230,3 +289,32 @@ func TestDelete(t *testing.T) {
 		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
 	}
 }
+
+func TestSetRounds(t *testing.T) {
+	mockCompany := initializeTest(t)
+	id := uuid.New()
+	rounds := []entities.Round{{Number: 1, Name: "Aptitude"}, {Number: 2, Name: "HR"}}
+
+	tests := []struct {
+		description string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case: rounds replaced", `[{"name":"Aptitude"},{"name":"HR"}]`, 1, nil, 200},
+		{"Error case: body is not a list", `{"name":"Aptitude"}`, 0, nil, 400},
+		{"Error case: company not found", `[{"name":"Aptitude"}]`, 1, errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("PUT", "/companies/{id}/rounds", strings.NewReader(tc.body))
+		req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+		resRec := httptest.NewRecorder()
+
+		mockCompany.EXPECT().SetRounds(gomock.Any(), id, gomock.Any()).Return(rounds, tc.mockErr).Times(tc.mockTimes)
+		New(mockCompany).SetRounds(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
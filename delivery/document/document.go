@@ -0,0 +1,237 @@
+package document
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+// FormOverhead is allowed on top of the document size for the rest of the multipart body.
+const FormOverhead = 1 << 20
+
+type handler struct {
+	service service.DocumentSvc
+	maxSize int64
+}
+
+// New returns the document handlers. Request bodies much larger than maxSize are cut off with
+// 413 Request Entity Too Large before they are read.
+//
+//nolint:revive // it's a factory function
+func New(s service.DocumentSvc, maxSize int64) handler {
+	return handler{service: s, maxSize: maxSize}
+}
+
+func (h handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	studentID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(studentID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors2.InvalidParam{Param: studentID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Get(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors2.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+// Upload reads a multipart/form-data body with the file in the "file" part and an optional "kind".
+func (h handler) Upload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	studentID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(studentID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors2.InvalidParam{Param: studentID}.Error()))
+
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+FormOverhead)
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			_, _ = w.Write([]byte("file is larger than " + strconv.FormatInt(h.maxSize, 10) + " bytes"))
+
+			return
+		}
+
+		if errors.Is(err, http.ErrMissingFile) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(errors2.MissingParam{Param: []string{"file"}}.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid multipart body"))
+
+		return
+	}
+
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	doc := entities.Document{StudentID: id, Kind: entities.DocumentKind(strings.ToUpper(r.FormValue("kind"))),
+		FileName: filepath.Base(header.Filename)}
+
+	resp, err := h.service.Upload(ctx, &doc, content)
+	if err != nil {
+		if _, ok := err.(errors2.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors2.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.Header().Set("Location", "/students/"+id.String()+"/documents/"+resp.ID.String())
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+// Download streams the contents of a document with its stored content type and file name.
+func (h handler) Download(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	studentID, docID, ok := parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	doc, content, err := h.service.Download(ctx, studentID, docID)
+	if err != nil {
+		if _, ok := err.(errors2.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	defer content.Close()
+
+	w.Header().Set("Content-Type", doc.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(doc.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": doc.FileName}))
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(w, content)
+}
+
+func (h handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	studentID, docID, ok := parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.service.Delete(ctx, studentID, docID)
+	if err != nil {
+		if _, ok := err.(errors2.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	_, _ = w.Write([]byte("Data deleted Successfully"))
+}
+
+// parseIDs reads the student and document ids from the path, writing 400 when either is invalid.
+func parseIDs(w http.ResponseWriter, r *http.Request) (studentID, docID uuid.UUID, ok bool) {
+	vars := mux.Vars(r)
+
+	studentID, err := uuid.Parse(vars["id"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors2.InvalidParam{Param: vars["id"]}.Error()))
+
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	docID, err = uuid.Parse(vars["documentId"])
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors2.InvalidParam{Param: vars["documentId"]}.Error()))
+
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return studentID, docID, true
+}
@@ -0,0 +1,164 @@
+package document
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockDocumentSvc {
+	ctrl := gomock.NewController(t)
+	mockDocument := service.NewMockDocumentSvc(ctrl)
+
+	return mockDocument
+}
+
+// multipartBody builds an upload form. An empty fileName leaves out the file part.
+func multipartBody(t *testing.T, fileName, kind string, content []byte) (io.Reader, string) {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	mw := multipart.NewWriter(&buf)
+
+	if kind != "" {
+		_ = mw.WriteField("kind", kind)
+	}
+
+	if fileName != "" {
+		fw, err := mw.CreateFormFile("file", fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _ = fw.Write(content)
+	}
+
+	_ = mw.Close()
+
+	return &buf, mw.FormDataContentType()
+}
+
+func TestUpload(t *testing.T) {
+	mockDocument := initializeTest(t)
+	stuID := uuid.New()
+	docID := uuid.New()
+	content := []byte("%PDF-1.4")
+
+	tests := []struct {
+		description string
+		fileName    string
+		kind        string
+		content     []byte
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", "../resume.pdf", "resume", content, 1, nil, 201},
+		{"Error case: missing file", "", "resume", nil, 0, nil, 400},
+		{"Error case: body too large", "big.pdf", "resume", make([]byte, 64+FormOverhead), 0, nil, 413},
+		{"Error case: same file again", "resume.pdf", "resume", content, 1,
+			errors.Conflict{Reason: "file already uploaded as document " + docID.String()}, 409},
+		{"Error case: student not found", "resume.pdf", "resume", content, 1, errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		body, contType := multipartBody(t, tc.fileName, tc.kind, tc.content)
+		req := httptest.NewRequest("POST", "/students/{id}/documents", body)
+		req.Header.Set("Content-Type", contType)
+		req = mux.SetURLVars(req, map[string]string{"id": stuID.String()})
+		resRec := httptest.NewRecorder()
+
+		mockDocument.EXPECT().Upload(gomock.Any(), &entities.Document{StudentID: stuID, Kind: entities.Resume,
+			FileName: "resume.pdf"}, tc.content).Return(entities.Document{ID: docID}, tc.mockErr).Times(tc.mockTimes)
+		New(mockDocument, 64).Upload(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.statusCode == http.StatusCreated {
+			assert.Equal(t, "/students/"+stuID.String()+"/documents/"+docID.String(), resRec.Header().Get("Location"),
+				"Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestDownload(t *testing.T) {
+	mockDocument := initializeTest(t)
+	stuID := uuid.New()
+	docID := uuid.New()
+	doc := entities.Document{ID: docID, StudentID: stuID, FileName: "my resume.pdf", ContentType: "application/pdf", Size: 8}
+
+	tests := []struct {
+		description string
+		docID       string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", docID.String(), 1, nil, 200},
+		{"Error case: invalid document id", "abc", 0, nil, 400},
+		{"Error case: document not found", docID.String(), 1, errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/students/{id}/documents/{documentId}", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": stuID.String(), "documentId": tc.docID})
+		resRec := httptest.NewRecorder()
+
+		var content io.ReadCloser
+		if tc.mockErr == nil {
+			content = io.NopCloser(strings.NewReader("%PDF-1.4"))
+		}
+
+		mockDocument.EXPECT().Download(gomock.Any(), stuID, docID).Return(doc, content, tc.mockErr).Times(tc.mockTimes)
+		New(mockDocument, 64).Download(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.statusCode == http.StatusOK {
+			assert.Equal(t, "%PDF-1.4", resRec.Body.String(), "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Equal(t, "application/pdf", resRec.Header().Get("Content-Type"), "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Equal(t, `attachment; filename="my resume.pdf"`, resRec.Header().Get("Content-Disposition"),
+				"Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockDocument := initializeTest(t)
+	stuID := uuid.New()
+	docID := uuid.New()
+
+	tests := []struct {
+		description string
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", nil, 204},
+		{"Error case: document not found", errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("DELETE", "/students/{id}/documents/{documentId}", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": stuID.String(), "documentId": docID.String()})
+		resRec := httptest.NewRecorder()
+
+		mockDocument.EXPECT().Delete(gomock.Any(), stuID, docID).Return(tc.mockErr)
+		New(mockDocument, 64).Delete(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
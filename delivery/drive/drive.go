@@ -0,0 +1,497 @@
+package drive
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+type handler struct {
+	service service.DriveSvc
+}
+
+//nolint:revive // it's a factory function
+func New(s service.DriveSvc) handler {
+	return handler{service: s}
+}
+
+func (h handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var companyID uuid.UUID
+
+	if val := r.URL.Query().Get("companyId"); val != "" {
+		id, err := uuid.Parse(val)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(errors.InvalidParam{Param: "companyId"}.Error()))
+
+			return
+		}
+
+		companyID = id
+	}
+
+	resp, err := h.service.Get(ctx, companyID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driveID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(driveID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: driveID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var drive entities.Drive
+	if err = json.Unmarshal(req, &drive); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(unmarshalError(err)))
+
+		return
+	}
+
+	if err = validateBody(&drive); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Create(ctx, &drive)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driveID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(driveID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: driveID}.Error()))
+
+		return
+	}
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var drive entities.Drive
+	if err = json.Unmarshal(req, &drive); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(unmarshalError(err)))
+
+		return
+	}
+
+	if err = validateBody(&drive); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Update(ctx, id, &drive)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driveID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(driveID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: driveID}.Error()))
+
+		return
+	}
+
+	err = h.service.Delete(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	_, _ = w.Write([]byte("Data deleted Successfully"))
+}
+
+// Register signs a student up for a drive. The body is {"studentId": "<uuid>"}.
+func (h handler) Register(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driveID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(driveID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: driveID}.Error()))
+
+		return
+	}
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var body struct {
+		StudentID uuid.UUID `json:"studentId"`
+	}
+
+	if err = json.Unmarshal(req, &body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	if body.StudentID == uuid.Nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.MissingParam{Param: []string{"studentId"}}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Register(ctx, id, body.StudentID)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) GetRegistrations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driveID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(driveID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: driveID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.GetRegistrations(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+// RecordResult stores the result of one round for one student. The body carries score, passed,
+// remarks and interviewer.
+func (h handler) RecordResult(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	var res entities.RoundResult
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	if err = json.Unmarshal(req, &res); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	if res.DriveID, err = uuid.Parse(vars["id"]); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: vars["id"]}.Error()))
+
+		return
+	}
+
+	if res.StudentID, err = uuid.Parse(vars["studentId"]); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: vars["studentId"]}.Error()))
+
+		return
+	}
+
+	if res.Round, err = strconv.Atoi(vars["round"]); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: vars["round"]}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.RecordResult(ctx, &res)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) GetResults(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	driveID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(driveID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: driveID}.Error()))
+
+		return
+	}
+
+	var studentID uuid.UUID
+
+	if val := r.URL.Query().Get("studentId"); val != "" {
+		if studentID, err = uuid.Parse(val); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(errors.InvalidParam{Param: "studentId"}.Error()))
+
+			return
+		}
+	}
+
+	resp, err := h.service.GetResults(ctx, id, studentID)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func validateBody(d *entities.Drive) error {
+	var missingParams []string
+	if d.Comp.ID == uuid.Nil {
+		missingParams = append(missingParams, "comp")
+	}
+
+	if d.Date.IsZero() {
+		missingParams = append(missingParams, "date")
+	}
+
+	if d.RegistrationOpens.IsZero() {
+		missingParams = append(missingParams, "registrationOpens")
+	}
+
+	if d.RegistrationCloses.IsZero() {
+		missingParams = append(missingParams, "registrationCloses")
+	}
+
+	if len(missingParams) != 0 {
+		return errors.MissingParam{Param: missingParams}
+	}
+
+	return nil
+}
+
+// unmarshalError keeps the message of field level validation errors, such as an unparsable date,
+// and hides the details of any other decoding error.
+func unmarshalError(err error) string {
+	if e, ok := err.(errors.InvalidParam); ok {
+		return e.Error()
+	}
+
+	return "invalid body"
+}
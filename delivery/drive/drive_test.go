@@ -0,0 +1,196 @@
+package drive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockDriveSvc {
+	ctrl := gomock.NewController(t)
+	mockDrive := service.NewMockDriveSvc(ctrl)
+
+	return mockDrive
+}
+
+func TestGet(t *testing.T) {
+	mockDrive := initializeTest(t)
+	cmpID := uuid.New()
+
+	tests := []struct {
+		description string
+		query       string
+		mockTimes   int
+		companyID   uuid.UUID
+		statusCode  int
+	}{
+		{"Success case: all drives", "", 1, uuid.Nil, 200},
+		{"Success case: drives of a company", "?companyId=" + cmpID.String(), 1, cmpID, 200},
+		{"Error case: invalid company id", "?companyId=abc", 0, uuid.Nil, 400},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/drives"+tc.query, http.NoBody)
+		resRec := httptest.NewRecorder()
+
+		mockDrive.EXPECT().Get(gomock.Any(), tc.companyID).Return([]entities.Drive{}, nil).Times(tc.mockTimes)
+		New(mockDrive).Get(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	mockDrive := initializeTest(t)
+	cmpID := uuid.New()
+	valid := `{"comp":{"id":"` + cmpID.String() + `"},"date":"2023-07-15","registrationOpens":"2023-07-01T09:00:00Z",` +
+		`"registrationCloses":"2023-07-10T18:00:00Z","rounds":[{"name":"Aptitude"}],"branches":["CSE"]}`
+
+	tests := []struct {
+		description string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+		expBody     string
+	}{
+		{"Success case: drive created", valid, 1, nil, 201, ""},
+		{"Error case: missing fields", `{"rounds":[{"name":"HR"}]}`, 0, nil, 400,
+			"Missing Parameter: comp,date,registrationOpens,registrationCloses"},
+		{"Error case: invalid date", strings.Replace(valid, "2023-07-15", "15th July", 1), 0, nil, 400,
+			`Invalid Parameter: date "15th July" should be in YYYY-MM-DD format`},
+		{"Error case: company does not exist", valid, 1, errors.EntityNotFound{Reason: "id not found"}, 404, ""},
+		{"Error case: invalid schedule", valid, 1, errors.InvalidParam{Param: "registration should close by the drive date"}, 400, ""},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/drives", strings.NewReader(tc.body))
+		resRec := httptest.NewRecorder()
+
+		mockDrive.EXPECT().Create(gomock.Any(), gomock.Any()).Return(entities.Drive{}, tc.mockErr).Times(tc.mockTimes)
+		New(mockDrive).Create(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expBody != "" {
+			assert.Equal(t, tc.expBody, resRec.Body.String(), "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	mockDrive := initializeTest(t)
+	driveID := uuid.New()
+	stuID := uuid.New()
+	reg := entities.Registration{DriveID: driveID, StudentID: stuID, RegisteredAt: time.Date(2023, 7, 5, 10, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		description string
+		id          string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case: student registered", driveID.String(), `{"studentId":"` + stuID.String() + `"}`, 1, nil, 201},
+		{"Error case: invalid drive id", "abc", `{"studentId":"` + stuID.String() + `"}`, 0, nil, 400},
+		{"Error case: missing student id", driveID.String(), `{}`, 0, nil, 400},
+		{"Error case: registration closed", driveID.String(), `{"studentId":"` + stuID.String() + `"}`, 1,
+			errors.Conflict{Reason: "registration for this drive closed at 2023-07-10T18:00:00Z"}, 409},
+		{"Error case: student not eligible", driveID.String(), `{"studentId":"` + stuID.String() + `"}`, 1,
+			errors.Ineligible{Criteria: []string{"branch ECE is not one of CSE"}}, 400},
+		{"Error case: drive not found", driveID.String(), `{"studentId":"` + stuID.String() + `"}`, 1,
+			errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/drives/{id}/registrations", strings.NewReader(tc.body))
+		req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+		resRec := httptest.NewRecorder()
+
+		mockDrive.EXPECT().Register(gomock.Any(), driveID, stuID).Return(reg, tc.mockErr).Times(tc.mockTimes)
+		New(mockDrive).Register(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockDrive := initializeTest(t)
+	driveID := uuid.New()
+
+	tests := []struct {
+		description string
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case: drive deleted", nil, 204},
+		{"Error case: drive not found", errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("DELETE", "/drives/{id}", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": driveID.String()})
+		resRec := httptest.NewRecorder()
+
+		mockDrive.EXPECT().Delete(gomock.Any(), driveID).Return(tc.mockErr)
+		New(mockDrive).Delete(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRecordResult(t *testing.T) {
+	mockDrive := initializeTest(t)
+	driveID := uuid.New()
+	stuID := uuid.New()
+
+	tests := []struct {
+		description string
+		vars        map[string]string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case: result recorded", map[string]string{"id": driveID.String(), "round": "1", "studentId": stuID.String()},
+			`{"score":72.5,"passed":true,"interviewer":"R. Rao"}`, 1, nil, 200},
+		{"Error case: invalid round", map[string]string{"id": driveID.String(), "round": "first", "studentId": stuID.String()},
+			`{"passed":true}`, 0, nil, 400},
+		{"Error case: invalid student id", map[string]string{"id": driveID.String(), "round": "1", "studentId": "abc"},
+			`{"passed":true}`, 0, nil, 400},
+		{"Error case: previous round not cleared", map[string]string{"id": driveID.String(), "round": "2", "studentId": stuID.String()},
+			`{"passed":true}`, 1, errors.Conflict{Reason: "student has not cleared round 1"}, 409},
+		{"Error case: round not found", map[string]string{"id": driveID.String(), "round": "5", "studentId": stuID.String()},
+			`{"passed":true}`, 1, errors.EntityNotFound{Reason: "round 5 not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("PUT", "/drives/{id}/rounds/{round}/results/{studentId}", strings.NewReader(tc.body))
+		req = mux.SetURLVars(req, tc.vars)
+		resRec := httptest.NewRecorder()
+
+		mockDrive.EXPECT().RecordResult(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, res *entities.RoundResult) (entities.RoundResult, error) {
+				assert.Equal(t, driveID, res.DriveID, "Test[%d] failed\n(%s)", i, tc.description)
+				assert.Equal(t, stuID, res.StudentID, "Test[%d] failed\n(%s)", i, tc.description)
+
+				return *res, tc.mockErr
+			}).Times(tc.mockTimes)
+		New(mockDrive).RecordResult(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,157 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+const (
+	heartbeatInterval = 15 * time.Second
+	// retryMillis is how long a client waits before reconnecting after the stream ends.
+	retryMillis = 3000
+)
+
+type handler struct {
+	service service.EventSvc
+	// heartbeat is the time between two keep-alive comments, which stop proxies from closing an idle
+	// stream. It is shortened in tests.
+	heartbeat time.Duration
+}
+
+//nolint:revive // it's a factory function
+func New(s service.EventSvc) handler {
+	return handler{service: s, heartbeat: heartbeatInterval}
+}
+
+// Stream sends the student status changes and offers of the college and season as server-sent
+// events, optionally only those of the company or branch query parameters. A client reconnecting
+// with Last-Event-ID first gets the buffered events it missed. The stream ends when the client
+// disconnects or falls too far behind, after which it reconnects.
+func (h handler) Stream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, lastID, err := parseStream(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("streaming not supported"))
+
+		return
+	}
+
+	replay, updates, cancel := h.service.Subscribe(filter, lastID)
+	defer cancel()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err = fmt.Fprintf(w, "retry: %d\n\n", retryMillis); err != nil {
+		return
+	}
+
+	for i := range replay {
+		if writeEvent(w, &replay[i]) != nil {
+			return
+		}
+	}
+
+	flusher.Flush()
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-updates:
+			if !ok || writeEvent(w, &e) != nil {
+				return
+			}
+		case <-ticker.C:
+			if _, err = io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+
+		flusher.Flush()
+	}
+}
+
+// parseStream returns the filter of a stream request and the ID in its Last-Event-ID header.
+func parseStream(r *http.Request) (entities.EventFilter, uuid.UUID, error) {
+	var filter entities.EventFilter
+
+	college, ok := auth.CollegeFrom(r.Context())
+	if !ok {
+		return filter, uuid.Nil, errors.MissingParam{Param: []string{"college"}}
+	}
+
+	season, ok := auth.SeasonFrom(r.Context())
+	if !ok {
+		return filter, uuid.Nil, errors.MissingParam{Param: []string{"season"}}
+	}
+
+	filter.CollegeID, filter.SeasonID = college, season
+
+	if val := r.URL.Query().Get("company"); val != "" {
+		id, err := uuid.Parse(val)
+		if err != nil {
+			return filter, uuid.Nil, errors.InvalidParam{Param: "company"}
+		}
+
+		filter.CompanyID = id
+	}
+
+	if val := entities.Branch(r.URL.Query().Get("branch")); val != "" {
+		if !entities.IsValidBranch(val) {
+			return filter, uuid.Nil, errors.InvalidParam{Param: "branch"}
+		}
+
+		filter.Branch = val
+	}
+
+	var lastID uuid.UUID
+
+	if val := r.Header.Get("Last-Event-ID"); val != "" {
+		id, err := uuid.Parse(val)
+		if err != nil {
+			return filter, uuid.Nil, errors.InvalidParam{Param: "Last-Event-ID"}
+		}
+
+		lastID = id
+	}
+
+	return filter, lastID, nil
+}
+
+// writeEvent writes e as a server-sent event named after its type, with its ID for resuming.
+func writeEvent(w io.Writer, e *entities.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+
+	return err
+}
@@ -0,0 +1,78 @@
+package event
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func TestStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockEvent := service.NewMockEventSvc(ctrl)
+
+	college, season, company := uuid.New(), uuid.New(), uuid.New()
+	missed := entities.Event{ID: uuid.MustParse("6f2a1c3e-4b5d-4e6f-8a7b-9c0d1e2f3a4b"), Type: entities.OfferIssued}
+	live := entities.Event{ID: uuid.MustParse("0b1c2d3e-4f5a-4b6c-8d7e-8f9a0b1c2d3e"), Type: entities.StudentStatusChanged}
+	lastID := uuid.New()
+
+	tests := []struct {
+		description string
+		query       string
+		lastEventID string
+		filter      entities.EventFilter
+		mockTimes   int
+		statusCode  int
+		expBody     string
+	}{
+		{"Success case: filtered stream resumed", "?company=" + company.String() + "&branch=CSE", lastID.String(),
+			entities.EventFilter{CollegeID: college, SeasonID: season, CompanyID: company, Branch: entities.CSE}, 1, 200,
+			"retry: 3000\n\n" +
+				`id: 6f2a1c3e-4b5d-4e6f-8a7b-9c0d1e2f3a4b` + "\nevent: offer.issued\ndata: " + `{"id":"6f2a1c3e-4b5d-4e6f-8a7b-9c0d1e2f3a4b",`,
+		},
+		{"Error case: invalid company", "?company=abc", "", entities.EventFilter{}, 0, 400, "Invalid Parameter: company"},
+		{"Error case: invalid branch", "?branch=ARTS", "", entities.EventFilter{}, 0, 400, "Invalid Parameter: branch"},
+		{"Error case: invalid last event id", "", "7", entities.EventFilter{}, 0, 400, "Invalid Parameter: Last-Event-ID"},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/events/stream"+tc.query, nil)
+		req = req.WithContext(auth.WithSeason(auth.WithCollege(context.Background(), college), season))
+		req.Header.Set("Last-Event-ID", tc.lastEventID)
+		resRec := httptest.NewRecorder()
+
+		updates := make(chan entities.Event, 1)
+		updates <- live
+		close(updates)
+
+		mockEvent.EXPECT().Subscribe(tc.filter, lastID).Return([]entities.Event{missed}, (<-chan entities.Event)(updates), func() {}).
+			Times(tc.mockTimes)
+		handler{service: mockEvent, heartbeat: time.Hour}.Stream(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Contains(t, resRec.Body.String(), tc.expBody, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.statusCode == 200 {
+			assert.Contains(t, resRec.Body.String(), "id: 0b1c2d3e-4f5a-4b6c-8d7e-8f9a0b1c2d3e\nevent: student.status_changed\n",
+				"Test[%d] failed\n(%s)", i, tc.description)
+			assert.Equal(t, "text/event-stream", resRec.Header().Get("Content-Type"), "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestStreamWithoutScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	resRec := httptest.NewRecorder()
+
+	New(service.NewMockEventSvc(ctrl)).Stream(resRec, httptest.NewRequest("GET", "/events/stream", nil))
+
+	assert.Equal(t, 400, resRec.Code)
+}
@@ -0,0 +1,219 @@
+package letter
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+type handler struct {
+	service service.LetterSvc
+}
+
+//nolint:revive // it's a factory function
+func New(s service.LetterSvc) handler {
+	return handler{service: s}
+}
+
+func (h handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Get(ctx)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	templateID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(templateID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: templateID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var tmpl entities.LetterTemplate
+	if err = json.Unmarshal(req, &tmpl); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	resp, err := h.service.Create(ctx, &tmpl)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	templateID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(templateID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: templateID}.Error()))
+
+		return
+	}
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var tmpl entities.LetterTemplate
+	if err = json.Unmarshal(req, &tmpl); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	resp, err := h.service.Update(ctx, id, &tmpl)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	templateID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(templateID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: templateID}.Error()))
+
+		return
+	}
+
+	err = h.service.Delete(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	_, _ = w.Write([]byte("Data deleted Successfully"))
+}
@@ -0,0 +1,106 @@
+package letter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockLetterSvc {
+	ctrl := gomock.NewController(t)
+	mockLetter := service.NewMockLetterSvc(ctrl)
+
+	return mockLetter
+}
+
+func TestCreate(t *testing.T) {
+	mockLetter := initializeTest(t)
+	valid := `{"name":"default","title":"Offer","body":"Dear {{.StudentName}}"}`
+
+	tests := []struct {
+		description string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", valid, 1, nil, 201},
+		{"Error case: invalid body", `{"name":`, 0, nil, 400},
+		{"Error case: invalid template", valid, 1, errors.InvalidParam{Param: "template: body:1: unclosed action"}, 400},
+		{"Error case: company already has a template", valid, 1,
+			errors.Conflict{Reason: "a letter template already exists for this company"}, 409},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/letter-templates", strings.NewReader(tc.body))
+		resRec := httptest.NewRecorder()
+
+		mockLetter.EXPECT().Create(gomock.Any(), gomock.Any()).Return(entities.LetterTemplate{}, tc.mockErr).Times(tc.mockTimes)
+		New(mockLetter).Create(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetByID(t *testing.T) {
+	mockLetter := initializeTest(t)
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		id          string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", id.String(), 1, nil, 200},
+		{"Error case: invalid id", "abc", 0, nil, 400},
+		{"Error case: template not found", id.String(), 1, errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/letter-templates/{id}", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+		resRec := httptest.NewRecorder()
+
+		mockLetter.EXPECT().GetByID(gomock.Any(), id).Return(entities.LetterTemplate{}, tc.mockErr).Times(tc.mockTimes)
+		New(mockLetter).GetByID(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mockLetter := initializeTest(t)
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", nil, 204},
+		{"Error case: template not found", errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("DELETE", "/letter-templates/{id}", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": id.String()})
+		resRec := httptest.NewRecorder()
+
+		mockLetter.EXPECT().Delete(gomock.Any(), id).Return(tc.mockErr)
+		New(mockLetter).Delete(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,282 @@
+package offer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+type handler struct {
+	service service.OfferSvc
+}
+
+//nolint:revive // it's a factory function
+func New(s service.OfferSvc) handler {
+	return handler{service: s}
+}
+
+func (h handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var studentID uuid.UUID
+
+	if val := r.URL.Query().Get("studentId"); val != "" {
+		id, err := uuid.Parse(val)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(errors.InvalidParam{Param: "studentId"}.Error()))
+
+			return
+		}
+
+		studentID = id
+	}
+
+	resp, err := h.service.Get(ctx, studentID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	offerID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(offerID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: offerID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var offer entities.Offer
+	if err = json.Unmarshal(req, &offer); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(unmarshalError(err)))
+
+		return
+	}
+
+	if err = validateBody(&offer); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Create(ctx, &offer)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+// Accept accepts an offer on behalf of its student and places them with the company.
+func (h handler) Accept(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, h.service.Accept)
+}
+
+func (h handler) Decline(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, r, h.service.Decline)
+}
+
+// Letter serves the offer letter as a PDF.
+func (h handler) Letter(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	offerID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(offerID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: offerID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Letter(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `inline; filename="offer-`+id.String()+`.pdf"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(resp)
+}
+
+// respond runs an accept or decline of the offer in the path and writes the updated offer.
+func (h handler) respond(w http.ResponseWriter, r *http.Request,
+	action func(ctx context.Context, id uuid.UUID) (entities.Offer, error)) {
+	ctx := r.Context()
+	offerID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(offerID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: offerID}.Error()))
+
+		return
+	}
+
+	resp, err := action(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func validateBody(o *entities.Offer) error {
+	var missingParams []string
+	if o.StudentID == uuid.Nil {
+		missingParams = append(missingParams, "studentId")
+	}
+
+	if o.Comp.ID == uuid.Nil {
+		missingParams = append(missingParams, "comp")
+	}
+
+	if o.Role == "" {
+		missingParams = append(missingParams, "role")
+	}
+
+	if o.CTC == 0 {
+		missingParams = append(missingParams, "ctc")
+	}
+
+	if o.JoiningDate.IsZero() {
+		missingParams = append(missingParams, "joiningDate")
+	}
+
+	if o.ExpiresOn.IsZero() {
+		missingParams = append(missingParams, "expiresOn")
+	}
+
+	if len(missingParams) != 0 {
+		return errors.MissingParam{Param: missingParams}
+	}
+
+	return nil
+}
+
+// unmarshalError keeps the message of field level validation errors, such as an unparsable date,
+// and hides the details of any other decoding error.
+func unmarshalError(err error) string {
+	if e, ok := err.(errors.InvalidParam); ok {
+		return e.Error()
+	}
+
+	return "invalid body"
+}
@@ -0,0 +1,177 @@
+package offer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockOfferSvc {
+	ctrl := gomock.NewController(t)
+	mockOffer := service.NewMockOfferSvc(ctrl)
+
+	return mockOffer
+}
+
+func TestGet(t *testing.T) {
+	mockOffer := initializeTest(t)
+	stuID := uuid.New()
+
+	tests := []struct {
+		description string
+		query       string
+		mockTimes   int
+		studentID   uuid.UUID
+		statusCode  int
+	}{
+		{"Success case: all offers", "", 1, uuid.Nil, 200},
+		{"Success case: offers of a student", "?studentId=" + stuID.String(), 1, stuID, 200},
+		{"Error case: invalid student id", "?studentId=abc", 0, uuid.Nil, 400},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/offers"+tc.query, http.NoBody)
+		resRec := httptest.NewRecorder()
+
+		mockOffer.EXPECT().Get(gomock.Any(), tc.studentID).Return([]entities.Offer{}, nil).Times(tc.mockTimes)
+		New(mockOffer).Get(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	mockOffer := initializeTest(t)
+	stuID := uuid.New()
+	cmpID := uuid.New()
+	valid := `{"studentId":"` + stuID.String() + `","comp":{"id":"` + cmpID.String() + `"},"role":"SDE","ctc":1200000,` +
+		`"joiningDate":"2024-07-01","expiresOn":"2023-08-01"}`
+
+	tests := []struct {
+		description string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+		expBody     string
+	}{
+		{"Success case", valid, 1, nil, 201, ""},
+		{"Error case: missing fields", `{"studentId":"` + stuID.String() + `"}`, 0, nil, 400,
+			"Missing Parameter: comp,role,ctc,joiningDate,expiresOn"},
+		{"Error case: student placed in a higher category", valid, 1,
+			errors.Ineligible{Criteria: []string{"already placed in a OPEN DREAM company"}}, 400, ""},
+		{"Error case: student not found", valid, 1, errors.EntityNotFound{Reason: "id not found"}, 404, ""},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/offers", strings.NewReader(tc.body))
+		resRec := httptest.NewRecorder()
+
+		mockOffer.EXPECT().Create(gomock.Any(), gomock.Any()).Return(entities.Offer{}, tc.mockErr).Times(tc.mockTimes)
+		New(mockOffer).Create(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expBody != "" {
+			assert.Equal(t, tc.expBody, resRec.Body.String(), "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestAccept(t *testing.T) {
+	mockOffer := initializeTest(t)
+	offerID := uuid.New()
+
+	tests := []struct {
+		description string
+		id          string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", offerID.String(), 1, nil, 200},
+		{"Error case: invalid offer id", "abc", 0, nil, 400},
+		{"Error case: offer expired", offerID.String(), 1, errors.Conflict{Reason: "offer expired on 2023-08-01"}, 409},
+		{"Error case: offer not found", offerID.String(), 1, errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/offers/{id}/accept", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+		resRec := httptest.NewRecorder()
+
+		mockOffer.EXPECT().Accept(gomock.Any(), offerID).Return(entities.Offer{}, tc.mockErr).Times(tc.mockTimes)
+		New(mockOffer).Accept(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDecline(t *testing.T) {
+	mockOffer := initializeTest(t)
+	offerID := uuid.New()
+
+	tests := []struct {
+		description string
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", nil, 200},
+		{"Error case: offer already accepted", errors.Conflict{Reason: "offer is already ACCEPTED"}, 409},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/offers/{id}/decline", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": offerID.String()})
+		resRec := httptest.NewRecorder()
+
+		mockOffer.EXPECT().Decline(gomock.Any(), offerID).Return(entities.Offer{}, tc.mockErr)
+		New(mockOffer).Decline(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestLetter(t *testing.T) {
+	mockOffer := initializeTest(t)
+	offerID := uuid.New()
+
+	tests := []struct {
+		description string
+		mockRes     []byte
+		mockErr     error
+		statusCode  int
+		expType     string
+	}{
+		{"Success case", []byte("%PDF-1.4"), nil, 200, "application/pdf"},
+		{"Error case: no template", nil, errors.EntityNotFound{Reason: "no letter template for Google and no default template"},
+			404, ""},
+		{"Error case: declined offer", nil, errors.Conflict{Reason: "offer is already DECLINED"}, 409, ""},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/offers/{id}/letter.pdf", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": offerID.String()})
+		resRec := httptest.NewRecorder()
+
+		mockOffer.EXPECT().Letter(gomock.Any(), offerID).Return(tc.mockRes, tc.mockErr)
+		New(mockOffer).Letter(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expType, resRec.Header().Get("Content-Type"), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.mockErr == nil {
+			assert.Equal(t, tc.mockRes, resRec.Body.Bytes(), "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
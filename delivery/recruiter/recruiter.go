@@ -0,0 +1,441 @@
+package recruiter
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+type handler struct {
+	service service.RecruiterSvc
+}
+
+//nolint:revive // it's a factory function
+func New(s service.RecruiterSvc) handler {
+	return handler{service: s}
+}
+
+// Authenticate lets requests through only with the API key of a recruiter in the X-API-KEY header
+// and stores that recruiter in the request context for the portal handlers. The request is scoped to
+// the recruiter's college.
+func (h handler) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec, err := h.service.Authenticate(r.Context(), r.Header.Get("X-API-KEY"))
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("authentication failed"))
+
+			return
+		}
+
+		ctx := auth.WithCollege(auth.WithRecruiter(r.Context(), &rec), rec.CollegeID)
+		ctx = auth.WithPrincipal(ctx, "recruiter:"+rec.ID.String())
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func (h handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	companyID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(companyID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: companyID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Get(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+// Create adds a recruiter to the company in the path. The response carries the recruiter's API key,
+// which cannot be retrieved again.
+func (h handler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	companyID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(companyID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: companyID}.Error()))
+
+		return
+	}
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var rec entities.Recruiter
+	if err = json.Unmarshal(req, &rec); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	rec.CompanyID = id
+
+	if err = validateBody(&rec); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Create(ctx, &rec)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	recruiterID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(recruiterID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: recruiterID}.Error()))
+
+		return
+	}
+
+	if err = h.service.Delete(ctx, id); err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Drives lists the drives of the recruiter's company.
+func (h handler) Drives(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rec, ok := auth.RecruiterFrom(ctx)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("authentication failed"))
+
+		return
+	}
+
+	resp, err := h.service.Drives(ctx, &rec)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+// Applicants lists the students registered for the company's drives, or for the drive given by the
+// driveId query parameter.
+func (h handler) Applicants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	rec, ok := auth.RecruiterFrom(ctx)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("authentication failed"))
+
+		return
+	}
+
+	var driveID uuid.UUID
+
+	if val := r.URL.Query().Get("driveId"); val != "" {
+		id, err := uuid.Parse(val)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(errors.InvalidParam{Param: "driveId"}.Error()))
+
+			return
+		}
+
+		driveID = id
+	}
+
+	resp, err := h.service.Applicants(ctx, &rec, driveID)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+// Shortlist marks the applicant in the path as SHORTLISTED with the recruiter's company.
+func (h handler) Shortlist(w http.ResponseWriter, r *http.Request) {
+	h.setStatus(w, r, entities.SHORTLISTED)
+}
+
+// SetStatus sets the status of the applicant in the path to the status in the body.
+func (h handler) SetStatus(w http.ResponseWriter, r *http.Request) {
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var body struct {
+		Status entities.Status `json:"status"`
+	}
+
+	if err = json.Unmarshal(req, &body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	if body.Status == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.MissingParam{Param: []string{"status"}}.Error()))
+
+		return
+	}
+
+	h.setStatus(w, r, body.Status)
+}
+
+// RecordResult records a round result for a drive of the recruiter's company. The interviewer
+// defaults to the recruiter.
+func (h handler) RecordResult(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+
+	rec, ok := auth.RecruiterFrom(ctx)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("authentication failed"))
+
+		return
+	}
+
+	var res entities.RoundResult
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	if err = json.Unmarshal(req, &res); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	if res.DriveID, err = uuid.Parse(vars["id"]); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: vars["id"]}.Error()))
+
+		return
+	}
+
+	if res.StudentID, err = uuid.Parse(vars["studentId"]); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: vars["studentId"]}.Error()))
+
+		return
+	}
+
+	if res.Round, err = strconv.Atoi(vars["round"]); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: vars["round"]}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.RecordResult(ctx, &rec, &res)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+// setStatus sets the status of the applicant in the path and writes the updated student.
+func (h handler) setStatus(w http.ResponseWriter, r *http.Request, status entities.Status) {
+	ctx := r.Context()
+	studentID := mux.Vars(r)["studentId"]
+
+	rec, ok := auth.RecruiterFrom(ctx)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("authentication failed"))
+
+		return
+	}
+
+	id, err := uuid.Parse(studentID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: studentID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.SetStatus(ctx, &rec, id, status)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func validateBody(rec *entities.Recruiter) error {
+	var missingParams []string
+	if rec.Name == "" {
+		missingParams = append(missingParams, "name")
+	}
+
+	if rec.Email == "" {
+		missingParams = append(missingParams, "email")
+	}
+
+	if len(missingParams) != 0 {
+		return errors.MissingParam{Param: missingParams}
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package recruiter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockRecruiterSvc {
+	ctrl := gomock.NewController(t)
+	mockRecruiter := service.NewMockRecruiterSvc(ctrl)
+
+	return mockRecruiter
+}
+
+func TestAuthenticate(t *testing.T) {
+	mockRecruiter := initializeTest(t)
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: uuid.New(), CollegeID: uuid.New(), Name: "Ravi"}
+
+	tests := []struct {
+		description string
+		key         string
+		mockRes     entities.Recruiter
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", "secret", rec, nil, 200},
+		{"Error case: unknown key", "guess", entities.Recruiter{}, errors.EntityNotFound{Reason: "unknown api key"}, 401},
+		{"Error case: no key", "", entities.Recruiter{}, errors.MissingParam{Param: []string{"X-API-KEY"}}, 401},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/recruiter/drives", http.NoBody)
+		req.Header.Set("X-API-KEY", tc.key)
+		resRec := httptest.NewRecorder()
+
+		var (
+			seen          entities.Recruiter
+			seenCollege   uuid.UUID
+			seenPrincipal string
+		)
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen, _ = auth.RecruiterFrom(r.Context())
+			seenCollege, _ = auth.CollegeFrom(r.Context())
+			seenPrincipal, _ = auth.PrincipalFrom(r.Context())
+		})
+
+		mockRecruiter.EXPECT().Authenticate(gomock.Any(), tc.key).Return(tc.mockRes, tc.mockErr)
+		New(mockRecruiter).Authenticate(next).ServeHTTP(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.mockRes, seen, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.mockRes.CollegeID, seenCollege, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.statusCode == 200 {
+			assert.Equal(t, "recruiter:"+rec.ID.String(), seenPrincipal, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	mockRecruiter := initializeTest(t)
+	cmpID := uuid.New()
+
+	tests := []struct {
+		description string
+		id          string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+		expBody     string
+	}{
+		{"Success case", cmpID.String(), `{"name":"Ravi","email":"ravi@wipro.com"}`, 1, nil, 201, ""},
+		{"Error case: missing fields", cmpID.String(), `{}`, 0, nil, 400, "Missing Parameter: name,email"},
+		{"Error case: invalid company id", "abc", `{"name":"Ravi","email":"ravi@wipro.com"}`, 0, nil, 400, ""},
+		{"Error case: company not found", cmpID.String(), `{"name":"Ravi","email":"ravi@wipro.com"}`, 1,
+			errors.EntityNotFound{Reason: "id not found"}, 404, ""},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/companies/{id}/recruiters", strings.NewReader(tc.body))
+		req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+		resRec := httptest.NewRecorder()
+
+		mockRecruiter.EXPECT().Create(gomock.Any(), &entities.Recruiter{CompanyID: cmpID, Name: "Ravi", Email: "ravi@wipro.com"}).
+			Return(entities.Recruiter{}, tc.mockErr).Times(tc.mockTimes)
+		New(mockRecruiter).Create(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expBody != "" {
+			assert.Equal(t, tc.expBody, resRec.Body.String(), "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestApplicants(t *testing.T) {
+	mockRecruiter := initializeTest(t)
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: uuid.New()}
+	driveID := uuid.New()
+
+	tests := []struct {
+		description string
+		query       string
+		driveID     uuid.UUID
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case: every drive", "", uuid.Nil, 1, nil, 200},
+		{"Success case: one drive", "?driveId=" + driveID.String(), driveID, 1, nil, 200},
+		{"Error case: invalid drive id", "?driveId=abc", uuid.Nil, 0, nil, 400},
+		{"Error case: drive of another company", "?driveId=" + driveID.String(), driveID, 1,
+			errors.EntityNotFound{Reason: "id not found: " + driveID.String()}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/recruiter/applicants"+tc.query, http.NoBody)
+		req = req.WithContext(auth.WithRecruiter(req.Context(), &rec))
+		resRec := httptest.NewRecorder()
+
+		mockRecruiter.EXPECT().Applicants(gomock.Any(), &rec, tc.driveID).Return([]entities.Applicant{}, tc.mockErr).
+			Times(tc.mockTimes)
+		New(mockRecruiter).Applicants(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestShortlist(t *testing.T) {
+	mockRecruiter := initializeTest(t)
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: uuid.New()}
+	stuID := uuid.New()
+
+	tests := []struct {
+		description string
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", nil, 200},
+		{"Error case: not an applicant", errors.EntityNotFound{Reason: "applicant not found: " + stuID.String()}, 404},
+		{"Error case: placed with another company", errors.Conflict{Reason: "student is already placed with another company"}, 409},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/recruiter/applicants/{studentId}/shortlist", http.NoBody)
+		req = mux.SetURLVars(req.WithContext(auth.WithRecruiter(req.Context(), &rec)), map[string]string{"studentId": stuID.String()})
+		resRec := httptest.NewRecorder()
+
+		mockRecruiter.EXPECT().SetStatus(gomock.Any(), &rec, stuID, entities.SHORTLISTED).Return(entities.Student{}, tc.mockErr)
+		New(mockRecruiter).Shortlist(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	mockRecruiter := initializeTest(t)
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: uuid.New()}
+	stuID := uuid.New()
+
+	tests := []struct {
+		description string
+		body        string
+		authed      bool
+		mockTimes   int
+		statusCode  int
+	}{
+		{"Success case", `{"status":"REJECTED"}`, true, 1, 200},
+		{"Error case: missing status", `{}`, true, 0, 400},
+		{"Error case: not authenticated", `{"status":"REJECTED"}`, false, 0, 401},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("PUT", "/recruiter/applicants/{studentId}/status", strings.NewReader(tc.body))
+		if tc.authed {
+			req = req.WithContext(auth.WithRecruiter(req.Context(), &rec))
+		}
+
+		req = mux.SetURLVars(req, map[string]string{"studentId": stuID.String()})
+		resRec := httptest.NewRecorder()
+
+		mockRecruiter.EXPECT().SetStatus(gomock.Any(), &rec, stuID, entities.REJECTED).Return(entities.Student{}, nil).
+			Times(tc.mockTimes)
+		New(mockRecruiter).SetStatus(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,55 @@
+package report
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+const defaultTop = 5
+
+type handler struct {
+	service service.ReportSvc
+}
+
+//nolint:revive // it's a factory function
+func New(s service.ReportSvc) handler {
+	return handler{service: s}
+}
+
+func (h handler) Summary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	top := defaultTop
+
+	if val := r.URL.Query().Get("top"); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(errors.InvalidParam{Param: "top"}.Error()))
+
+			return
+		}
+
+		top = n
+	}
+
+	resp, err := h.service.Summary(ctx, top)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
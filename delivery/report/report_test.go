@@ -0,0 +1,60 @@
+package report
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockReportSvc {
+	ctrl := gomock.NewController(t)
+	mockReport := service.NewMockReportSvc(ctrl)
+
+	return mockReport
+}
+
+func TestSummary(t *testing.T) {
+	mockReport := initializeTest(t)
+
+	tests := []struct {
+		description string
+		query       string
+		mockTop     int
+		mockTimes   int
+		mockRes     entities.PlacementSummary
+		mockErr     error
+		expRes      string
+		statusCode  int
+	}{
+		{"Success case: default top", "", 5, 1,
+			entities.PlacementSummary{TotalStudents: 2, PlacedStudents: 1, PlacementPercentage: 50}, nil,
+			`{"totalStudents":2,"placedStudents":1,"placementPercentage":50,"byBranch":null,"byBranchStatus":null,` +
+				`"byCategoryStatus":null,"topRecruiters":null,"ctcByBranch":null}`, 200,
+		},
+		{"Error case: top is not a number", "?top=abc", 0, 0, entities.PlacementSummary{}, nil,
+			"Invalid Parameter: top", 400,
+		},
+		{"Error case: service error", "?top=100", 100, 1, entities.PlacementSummary{},
+			errors.InvalidParam{Param: "top should be between 1 and 50"}, "Invalid Parameter: top should be between 1 and 50", 400,
+		},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/reports/summary"+tc.query, http.NoBody)
+		resRec := httptest.NewRecorder()
+		h := New(mockReport)
+
+		mockReport.EXPECT().Summary(gomock.Any(), tc.mockTop).Return(tc.mockRes, tc.mockErr).Times(tc.mockTimes)
+		h.Summary(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
+		assert.Equal(t, tc.expRes, resRec.Body.String(), "Test[%v] failed\n(%v)", i, tc.description)
+	}
+}
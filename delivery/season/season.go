@@ -0,0 +1,192 @@
+package season
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+type handler struct {
+	service service.SeasonSvc
+}
+
+//nolint:revive // it's a factory function
+func New(s service.SeasonSvc) handler {
+	return handler{service: s}
+}
+
+// Scope scopes the request context to the season in the season query parameter, or to the current
+// season of the college without one. Archived seasons can only be read.
+func (h handler) Scope(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			season entities.Season
+			err    error
+		)
+
+		if param := r.URL.Query().Get("season"); param != "" {
+			id, parseErr := uuid.Parse(param)
+			if parseErr != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(errors.InvalidParam{Param: param}.Error()))
+
+				return
+			}
+
+			season, err = h.service.GetByID(r.Context(), id)
+		} else {
+			season, err = h.service.Current(r.Context())
+		}
+
+		if err != nil {
+			if _, ok := err.(errors.EntityNotFound); ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(err.Error()))
+
+				return
+			}
+
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if season.Archived() && r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(errors.Conflict{Reason: "season " + season.Name + " is archived"}.Error()))
+
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.WithSeason(r.Context(), season.ID)))
+	})
+}
+
+func (h handler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resp, err := h.service.Get(ctx)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func (h handler) GetByID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	seasonID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(seasonID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: seasonID}.Error()))
+
+		return
+	}
+
+	resp, err := h.service.GetByID(ctx, id)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+// Create starts the first season of the college.
+func (h handler) Create(w http.ResponseWriter, r *http.Request) {
+	h.create(w, r, h.service.Create)
+}
+
+// Rollover archives the current season and starts the one in the body, carrying the companies
+// forward.
+func (h handler) Rollover(w http.ResponseWriter, r *http.Request) {
+	h.create(w, r, h.service.Rollover)
+}
+
+// create runs a create or rollover with the season in the body and writes the new season.
+func (h handler) create(w http.ResponseWriter, r *http.Request,
+	action func(ctx context.Context, season *entities.Season) (entities.Season, error)) {
+	ctx := r.Context()
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	var season entities.Season
+	if err = json.Unmarshal(req, &season); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	resp, err := action(ctx, &season)
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write(respBody)
+}
@@ -0,0 +1,100 @@
+package season
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockSeasonSvc {
+	ctrl := gomock.NewController(t)
+	mockSeason := service.NewMockSeasonSvc(ctrl)
+
+	return mockSeason
+}
+
+func TestScope(t *testing.T) {
+	mockSeason := initializeTest(t)
+	archived := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	current := entities.Season{ID: uuid.New(), Name: "2023-24"}
+	old := entities.Season{ID: uuid.New(), Name: "2022-23", ArchivedAt: &archived}
+
+	tests := []struct {
+		description string
+		method      string
+		query       string
+		mock        func()
+		statusCode  int
+		expSeason   uuid.UUID
+	}{
+		{"Success case: current season by default", "GET", "", func() {
+			mockSeason.EXPECT().Current(gomock.Any()).Return(current, nil)
+		}, 200, current.ID},
+		{"Success case: archived season is readable", "GET", "?season=" + old.ID.String(), func() {
+			mockSeason.EXPECT().GetByID(gomock.Any(), old.ID).Return(old, nil)
+		}, 200, old.ID},
+		{"Error case: archived season is read only", "POST", "?season=" + old.ID.String(), func() {
+			mockSeason.EXPECT().GetByID(gomock.Any(), old.ID).Return(old, nil)
+		}, 409, uuid.Nil},
+		{"Error case: invalid season", "GET", "?season=abc", func() {}, 400, uuid.Nil},
+		{"Error case: no current season", "GET", "", func() {
+			mockSeason.EXPECT().Current(gomock.Any()).Return(entities.Season{}, errors.EntityNotFound{Reason: "no current season"})
+		}, 404, uuid.Nil},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(tc.method, "/students"+tc.query, http.NoBody)
+		resRec := httptest.NewRecorder()
+
+		var seen uuid.UUID
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			seen, _ = auth.SeasonFrom(r.Context())
+		})
+
+		tc.mock()
+		New(mockSeason).Scope(next).ServeHTTP(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expSeason, seen, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRollover(t *testing.T) {
+	mockSeason := initializeTest(t)
+
+	tests := []struct {
+		description string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", `{"name":"2024-25"}`, 1, nil, 201},
+		{"Error case: invalid body", `{"name":`, 0, nil, 400},
+		{"Error case: no current season", `{"name":"2024-25"}`, 1, errors.EntityNotFound{Reason: "no current season"}, 404},
+		{"Error case: name taken", `{"name":"2024-25"}`, 1, errors.Conflict{Reason: "exists"}, 409},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/seasons/rollover", strings.NewReader(tc.body))
+		resRec := httptest.NewRecorder()
+
+		mockSeason.EXPECT().Rollover(gomock.Any(), &entities.Season{Name: "2024-25"}).Return(entities.Season{}, tc.mockErr).
+			Times(tc.mockTimes)
+		New(mockSeason).Rollover(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
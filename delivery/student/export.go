@@ -0,0 +1,172 @@
+package student
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/xlsx"
+)
+
+type exporter interface {
+	Write(st entities.Student) error
+	Close() error
+}
+
+// Export streams the students matching the name, branch and includeCompany filters as CSV, XLSX
+// or JSON Lines. Rows are written as they are read from the store. When the store fails after the
+// first row went out, the file is not finished and the connection is cut, so the client sees a
+// failed download rather than a file that looks complete.
+func (h handler) Export(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	name := r.URL.Query().Get("name")
+	branch := r.URL.Query().Get("branch")
+	includeCompany := r.URL.Query().Get("includeCompany")
+	format := r.URL.Query().Get("format")
+
+	if format == "" {
+		format = "csv"
+	}
+
+	contType, ok := exportContentType(format)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: "format should be one of csv, xlsx, jsonl"}.Error()))
+
+		return
+	}
+
+	var exp exporter
+
+	start := func() error {
+		w.Header().Set("Content-Type", contType)
+		w.Header().Set("Content-Disposition", `attachment; filename="students.`+format+`"`)
+		w.WriteHeader(http.StatusOK)
+
+		var err error
+
+		exp, err = newExporter(w, format)
+
+		return err
+	}
+
+	err := h.service.Export(ctx, name, branch, includeCompany, func(st entities.Student) error {
+		if exp == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+
+		return exp.Write(st)
+	})
+
+	if err != nil && exp == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	if err != nil {
+		log.Printf("student export: %v", err)
+		panic(http.ErrAbortHandler)
+	}
+
+	// an export without matching students is still a valid file with just the header row
+	if exp == nil {
+		if start() != nil {
+			return
+		}
+	}
+
+	_ = exp.Close()
+}
+
+func exportContentType(format string) (string, bool) {
+	switch format {
+	case "csv":
+		return csvType, true
+	case "xlsx":
+		return xlsxType, true
+	case "jsonl":
+		return "application/jsonl", true
+	default:
+		return "", false
+	}
+}
+
+func newExporter(w io.Writer, format string) (exporter, error) {
+	switch format {
+	case "xlsx":
+		xw, err := xlsx.NewWriter(w, "Students")
+		if err != nil {
+			return nil, err
+		}
+
+		return xlsxExporter{w: xw}, xw.WriteRow(exportHeader())
+	case "jsonl":
+		return jsonlExporter{enc: json.NewEncoder(w)}, nil
+	default:
+		cw := csv.NewWriter(w)
+
+		return csvExporter{w: cw}, cw.Write(exportHeader())
+	}
+}
+
+func exportHeader() []string {
+	return []string{"id", "name", "phone", "dob", "branch", "status", "company_id", "company_name", "company_category"}
+}
+
+func exportRecord(st *entities.Student) []string {
+	companyID := ""
+	if st.Comp.ID != uuid.Nil {
+		companyID = st.Comp.ID.String()
+	}
+
+	return []string{st.ID.String(), st.Name, st.Phone, st.DOB.String(), string(st.Branch), string(st.Status),
+		companyID, st.Comp.Name, string(st.Comp.Category)}
+}
+
+type csvExporter struct {
+	w *csv.Writer
+}
+
+func (e csvExporter) Write(st entities.Student) error {
+	return e.w.Write(exportRecord(&st))
+}
+
+func (e csvExporter) Close() error {
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+type xlsxExporter struct {
+	w *xlsx.Writer
+}
+
+func (e xlsxExporter) Write(st entities.Student) error {
+	return e.w.WriteRow(exportRecord(&st))
+}
+
+func (e xlsxExporter) Close() error {
+	return e.w.Close()
+}
+
+type jsonlExporter struct {
+	enc *json.Encoder
+}
+
+func (e jsonlExporter) Write(st entities.Student) error {
+	return e.enc.Encode(st)
+}
+
+func (e jsonlExporter) Close() error {
+	return nil
+}
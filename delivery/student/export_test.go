@@ -0,0 +1,142 @@
+package student
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/xlsx"
+)
+
+func TestExport(t *testing.T) {
+	mockStudent := initializeTest(t)
+	id, err := uuid.Parse("71bbdbb9-6bde-11ed-aaff-64bc589051b4")
+
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	cmpID, err := uuid.Parse(valID)
+
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	students := []entities.Student{
+		{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+			Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"},
+		{ID: id, Name: "Aditi, Jaiswal", Phone: "6388768119", DOB: entities.NewDate(2000, 3, 2), Branch: "CSE", Status: "PENDING"},
+	}
+
+	stream := func(res []entities.Student, err error) func(context.Context, string, string, string, func(entities.Student) error) error {
+		return func(_ context.Context, _, _, _ string, fn func(entities.Student) error) error {
+			for _, st := range res {
+				if err := fn(st); err != nil {
+					return err
+				}
+			}
+
+			return err
+		}
+	}
+
+	tests := []struct {
+		description string
+		query       string
+		mockTimes   int
+		mockRes     []entities.Student
+		mockErr     error
+		expType     string
+		expRes      string
+		statusCode  int
+	}{
+		{"Success case: csv by default", "?branch=ECE", 1, students, nil, "text/csv",
+			"id,name,phone,dob,branch,status,company_id,company_name,company_category\n" +
+				"71bbdbb9-6bde-11ed-aaff-64bc589051b4,Monika Jaiswal,6388768118,2000-07-02,ECE,ACCEPTED," + valID + ",Wipro,MASS\n" +
+				"71bbdbb9-6bde-11ed-aaff-64bc589051b4,\"Aditi, Jaiswal\",6388768119,2000-03-02,CSE,PENDING,,,\n", 200,
+		},
+		{"Success case: json lines", "?format=jsonl", 1, students[1:], nil, "application/jsonl",
+			`{"id":"71bbdbb9-6bde-11ed-aaff-64bc589051b4","name":"Aditi, Jaiswal","phone":"6388768119","dob":"2000-03-02",` +
+				`"branch":"CSE","comp":{"id":"00000000-0000-0000-0000-000000000000"},"status":"PENDING"}` + "\n", 200,
+		},
+		{"Success case: no students still has a header", "?format=csv", 1, nil, nil, "text/csv",
+			"id,name,phone,dob,branch,status,company_id,company_name,company_category\n", 200,
+		},
+		{"Error case: invalid format", "?format=pdf", 0, nil, nil, "",
+			"Invalid Parameter: format should be one of csv, xlsx, jsonl", 400,
+		},
+		{"Error case: invalid query", "?branch=ABC", 1, nil, errors.InvalidParam{Param: "this branch is not allowed"}, "",
+			"Invalid Parameter: this branch is not allowed", 400,
+		},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/students/export"+tc.query, http.NoBody)
+		resRec := httptest.NewRecorder()
+		h := New(mockStudent)
+
+		mockStudent.EXPECT().Export(gomock.Any(), req.URL.Query().Get("name"), req.URL.Query().Get("branch"),
+			req.URL.Query().Get("includeCompany"), gomock.Any()).DoAndReturn(stream(tc.mockRes, tc.mockErr)).Times(tc.mockTimes)
+
+		h.Export(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
+		assert.Equal(t, tc.expType, resRec.Header().Get("Content-Type"), "Test[%v] failed\n(%v)", i, tc.description)
+		assert.Equal(t, tc.expRes, resRec.Body.String(), "Test[%v] failed\n(%v)", i, tc.description)
+	}
+}
+
+func TestExportXLSX(t *testing.T) {
+	mockStudent := initializeTest(t)
+	id := uuid.New()
+
+	mockStudent.EXPECT().Export(gomock.Any(), "", "", "", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _, _, _ string, fn func(entities.Student) error) error {
+			return fn(entities.Student{ID: id, Name: "Aditi", Phone: "6388768119", DOB: entities.NewDate(2000, 3, 2), Branch: "CSE", Status: "PENDING"})
+		})
+
+	req := httptest.NewRequest(http.MethodGet, "/students/export?format=xlsx", http.NoBody)
+	resRec := httptest.NewRecorder()
+
+	New(mockStudent).Export(resRec, req)
+
+	rows, err := xlsx.ReadRows(bytes.NewReader(resRec.Body.Bytes()), int64(resRec.Body.Len()))
+
+	assert.NoError(t, err)
+	assert.Equal(t, `attachment; filename="students.xlsx"`, resRec.Header().Get("Content-Disposition"))
+	assert.Equal(t, [][]string{exportHeader(), {id.String(), "Aditi", "6388768119", "2000-03-02", "CSE", "PENDING", "", "", ""}}, rows)
+}
+
+func TestExportFailsMidStream(t *testing.T) {
+	mockStudent := initializeTest(t)
+	id := uuid.New()
+
+	for _, format := range []string{"csv", "xlsx", "jsonl"} {
+		mockStudent.EXPECT().Export(gomock.Any(), "", "", "", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, _, _ string, fn func(entities.Student) error) error {
+				if err := fn(entities.Student{ID: id, Name: "Aditi", Phone: "6388768119", Branch: "CSE", Status: "PENDING"}); err != nil {
+					return err
+				}
+
+				return errors.DB{Reason: "scan error"}
+			})
+
+		req := httptest.NewRequest(http.MethodGet, "/students/export?format="+format, http.NoBody)
+		resRec := httptest.NewRecorder()
+
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() { New(mockStudent).Export(resRec, req) }, format)
+
+		if format == "xlsx" {
+			_, err := xlsx.ReadRows(bytes.NewReader(resRec.Body.Bytes()), int64(resRec.Body.Len()))
+			assert.Error(t, err, "an aborted xlsx export should not be a readable workbook")
+		}
+	}
+}
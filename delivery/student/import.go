@@ -0,0 +1,297 @@
+package student
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/xlsx"
+)
+
+// MaxImportSize is the largest sheet Import accepts.
+const MaxImportSize = 10 << 20
+
+const (
+	csvType  = "text/csv"
+	xlsxType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+//nolint:gochecknoglobals // sentinel compared against in Import
+var errUnsupportedFormat = errors.InvalidParam{Param: "file format, expected CSV or XLSX"}
+
+// Import accepts a CSV or XLSX sheet, either as the raw request body or as the "file" field of a
+// multipart form, and returns a per-row report. With dryRun=true nothing is stored.
+func (h handler) Import(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	dryRun, err := parseDryRun(r.URL.Query().Get("dryRun"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, MaxImportSize)
+
+	records, err := readSheet(r)
+	if err != nil {
+		if err == errUnsupportedFormat {
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	rows, err := parseImportRows(records)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	resp, err := h.service.Import(ctx, rows, dryRun)
+	if err != nil {
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
+func parseDryRun(val string) (bool, error) {
+	switch val {
+	case "", "false":
+		return false, nil
+	case "true":
+		return true, nil
+	default:
+		return false, errors.InvalidParam{Param: "dryRun"}
+	}
+}
+
+func readSheet(r *http.Request) ([][]string, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	var (
+		data     []byte
+		fileName string
+		err      error
+	)
+
+	if mediaType == "multipart/form-data" {
+		file, header, formErr := r.FormFile("file")
+		if formErr != nil {
+			return nil, errors.MissingParam{Param: []string{"file"}}
+		}
+
+		defer file.Close()
+
+		fileName = header.Filename
+		mediaType, _, _ = mime.ParseMediaType(header.Header.Get("Content-Type"))
+
+		if data, err = io.ReadAll(file); err != nil {
+			return nil, err
+		}
+	} else if data, err = io.ReadAll(r.Body); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case mediaType == csvType || strings.EqualFold(filepath.Ext(fileName), ".csv"):
+		reader := csv.NewReader(bytes.NewReader(data))
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		return reader.ReadAll()
+	case mediaType == xlsxType || strings.EqualFold(filepath.Ext(fileName), ".xlsx"):
+		return xlsx.ReadRows(bytes.NewReader(data), int64(len(data)))
+	default:
+		return nil, errUnsupportedFormat
+	}
+}
+
+// parseImportRows maps the data rows of a sheet onto students using the header row, and runs
+// validateBody on each of them so the report lists missing values per row.
+func parseImportRows(records [][]string) ([]entities.ImportRow, error) {
+	if len(records) == 0 {
+		return nil, errors.InvalidParam{Param: "file has no header row"}
+	}
+
+	columns := make(map[string]int)
+
+	for i, name := range records[0] {
+		key := strings.ToLower(strings.TrimSpace(name))
+		key = strings.NewReplacer(" ", "_", "-", "_").Replace(key)
+
+		switch key {
+		case "company", "comp":
+			key = "company_id"
+		case "backlogs":
+			key = "active_backlogs"
+		}
+
+		columns[key] = i
+	}
+
+	var missing []string
+
+	for _, name := range []string{"name", "phone", "dob", "branch", "company_id", "status"} {
+		if _, ok := columns[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) != 0 {
+		return nil, errors.MissingParam{Param: missing}
+	}
+
+	rows := make([]entities.ImportRow, 0, len(records)-1)
+
+	for i, record := range records[1:] {
+		cell := func(name string) string {
+			if idx := columns[name]; idx < len(record) {
+				return strings.TrimSpace(record[idx])
+			}
+
+			return ""
+		}
+
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		row := entities.ImportRow{Row: i + 2}
+		row.Student = entities.Student{
+			Name:   cell("name"),
+			Phone:  cell("phone"),
+			Branch: entities.Branch(cell("branch")),
+			Status: entities.Status(cell("status")),
+		}
+
+		dob, err := importDOB(cell("dob"))
+		if err != nil {
+			row.Error = err.Error()
+			rows = append(rows, row)
+
+			continue
+		}
+
+		row.Student.DOB = dob
+
+		academic, err := importAcademic(optional(columns, cell, "cgpa"), optional(columns, cell, "active_backlogs"),
+			optional(columns, cell, "graduation_year"))
+		if err != nil {
+			row.Error = err.Error()
+			rows = append(rows, row)
+
+			continue
+		}
+
+		row.Student.Academic = academic
+
+		if companyID := cell("company_id"); companyID != "" {
+			id, err := uuid.Parse(companyID)
+			if err != nil {
+				row.Error = errors.InvalidParam{Param: "company id"}.Error()
+				rows = append(rows, row)
+
+				continue
+			}
+
+			row.Student.Comp.ID = id
+		}
+
+		if err := validateBody(&row.Student); err != nil {
+			row.Error = err.Error()
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// optional returns the cell of an optional column, or "" when the sheet does not have the column.
+func optional(columns map[string]int, cell func(string) string, name string) string {
+	if _, ok := columns[name]; !ok {
+		return ""
+	}
+
+	return cell(name)
+}
+
+// importAcademic builds the academic profile from its cells. Rows without any academic cell get no
+// profile; an empty backlogs cell counts as no backlogs.
+func importAcademic(cgpa, backlogs, year string) (*entities.AcademicProfile, error) {
+	if cgpa == "" && backlogs == "" && year == "" {
+		return nil, nil
+	}
+
+	var (
+		a   entities.AcademicProfile
+		err error
+	)
+
+	if a.CGPA, err = strconv.ParseFloat(cgpa, 64); err != nil {
+		return nil, errors.InvalidParam{Param: "cgpa"}
+	}
+
+	if backlogs != "" {
+		if a.ActiveBacklogs, err = strconv.Atoi(backlogs); err != nil {
+			return nil, errors.InvalidParam{Param: "active_backlogs"}
+		}
+	}
+
+	if a.GraduationYear, err = strconv.Atoi(year); err != nil {
+		return nil, errors.InvalidParam{Param: "graduation_year"}
+	}
+
+	return &a, nil
+}
+
+// importDOB parses a date of birth cell. XLSX stores date cells as serial numbers, which are
+// converted as well as the textual formats accepted by the API.
+func importDOB(val string) (entities.Date, error) {
+	if val == "" {
+		return entities.Date{}, nil
+	}
+
+	if serial, err := strconv.ParseFloat(val, 64); err == nil {
+		return entities.NewDate(xlsx.SerialToTime(serial).Date()), nil
+	}
+
+	return entities.ParseDate(val)
+}
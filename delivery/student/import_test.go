@@ -0,0 +1,170 @@
+package student
+
+import (
+	"archive/zip"
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+const importCSV = "Name,Phone,DOB,Branch,Company ID,Status\n" +
+	"Monika Jaiswal,6388768118,02/07/2000,ECE," + valID + ",PENDING\n" +
+	",,,,,\n" +
+	"Aditi Jaiswal,,02/07/2000,CSE," + valID + ",PENDING\n" +
+	"Utkarsh,6388768117,02/07/2000,CSE,abc,PENDING\n"
+
+func importRows(t *testing.T) []entities.ImportRow {
+	cmpID, err := uuid.Parse(valID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return []entities.ImportRow{
+		{Row: 2, Student: entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+			Comp: entities.Company{ID: cmpID}, Status: "PENDING"}},
+		{Row: 4, Student: entities.Student{Name: "Aditi Jaiswal", DOB: entities.NewDate(2000, 7, 2), Branch: "CSE",
+			Comp: entities.Company{ID: cmpID}, Status: "PENDING"}, Error: "Missing Parameter: phone"},
+		{Row: 5, Student: entities.Student{Name: "Utkarsh", Phone: "6388768117", DOB: entities.NewDate(2000, 7, 2), Branch: "CSE",
+			Status: "PENDING"}, Error: "Invalid Parameter: company id"},
+	}
+}
+
+func multipartBody(t *testing.T, fileName string, content []byte) (body *bytes.Buffer, contType string) {
+	body = &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+
+	fw, err := mw.CreateFormFile("file", fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _ = fw.Write(content)
+	_ = mw.Close()
+
+	return body, mw.FormDataContentType()
+}
+
+func xlsxBody(t *testing.T) []byte {
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("xl/worksheets/sheet1.xml")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cells := func(r string, values ...string) string {
+		var b strings.Builder
+
+		b.WriteString(`<row r="` + r + `">`)
+
+		for _, v := range values {
+			b.WriteString(`<c t="inlineStr"><is><t>` + v + `</t></is></c>`)
+		}
+
+		b.WriteString(`</row>`)
+
+		return b.String()
+	}
+
+	_, _ = w.Write([]byte(`<worksheet><sheetData>` +
+		cells("1", "name", "phone", "dob", "branch", "company", "status") +
+		`<row r="2"><c t="inlineStr"><is><t>Monika Jaiswal</t></is></c><c><v>6388768118</v></c><c><v>36709</v></c>` +
+		`<c t="inlineStr"><is><t>ECE</t></is></c><c t="inlineStr"><is><t>` + valID + `</t></is></c>` +
+		`<c t="inlineStr"><is><t>PENDING</t></is></c></row>` +
+		`</sheetData></worksheet>`))
+
+	_ = zw.Close()
+
+	return buf.Bytes()
+}
+
+func TestImport(t *testing.T) {
+	mockStudent := initializeTest(t)
+	rows := importRows(t)
+	report := entities.ImportReport{DryRun: true, Total: 3, Succeeded: 1, Failed: 2, Rows: rows}
+
+	csvForm, csvFormType := multipartBody(t, "students.csv", []byte(importCSV))
+	xlsxForm, xlsxFormType := multipartBody(t, "students.xlsx", xlsxBody(t))
+
+	tests := []struct {
+		description string
+		query       string
+		contType    string
+		body        *bytes.Buffer
+		mockTimes   int
+		mockDryRun  bool
+		mockInput   []entities.ImportRow
+		mockRes     entities.ImportReport
+		mockErr     error
+		expRes      string
+		statusCode  int
+	}{
+		{"Success case: raw CSV body", "?dryRun=true", "text/csv", bytes.NewBufferString(importCSV), 1, true, rows, report, nil,
+			`{"dryRun":true,"total":3,"succeeded":1,"failed":2,"rows":[{"row":2},` +
+				`{"row":4,"error":"Missing Parameter: phone"},{"row":5,"error":"Invalid Parameter: company id"}]}`, 200,
+		},
+		{"Success case: CSV uploaded as a form file", "", csvFormType, csvForm, 1, false, rows, entities.ImportReport{Total: 3},
+			nil, `{"dryRun":false,"total":3,"succeeded":0,"failed":0,"rows":null}`, 200,
+		},
+		{"Success case: XLSX uploaded as a form file", "", xlsxFormType, xlsxForm, 1, false, rows[:1],
+			entities.ImportReport{Total: 1}, nil, `{"dryRun":false,"total":1,"succeeded":0,"failed":0,"rows":null}`, 200,
+		},
+		{"Error case: invalid dryRun", "?dryRun=yes", "text/csv", bytes.NewBufferString(importCSV), 0, false, nil,
+			entities.ImportReport{}, nil, "Invalid Parameter: dryRun", 400,
+		},
+		{"Error case: unsupported format", "", "application/json", bytes.NewBufferString(`[]`), 0, false, nil,
+			entities.ImportReport{}, nil, "Invalid Parameter: file format, expected CSV or XLSX", 415,
+		},
+		{"Error case: missing columns", "", "text/csv", bytes.NewBufferString("name,phone\n"), 0, false, nil,
+			entities.ImportReport{}, nil, "Missing Parameter: dob,branch,company_id,status", 400,
+		},
+		{"Error case: empty file", "", "text/csv", bytes.NewBufferString(""), 0, false, nil,
+			entities.ImportReport{}, nil, "Invalid Parameter: file has no header row", 400,
+		},
+		{"Error case: db error", "", "text/csv", bytes.NewBufferString(importCSV), 1, false, rows, entities.ImportReport{},
+			errors.DB{Reason: "server error"}, "DB Error: server error", 400,
+		},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/students/import"+tc.query, tc.body)
+		req.Header.Set("Content-Type", tc.contType)
+
+		resRec := httptest.NewRecorder()
+		h := New(mockStudent)
+
+		mockStudent.EXPECT().Import(gomock.Any(), tc.mockInput, tc.mockDryRun).Return(tc.mockRes, tc.mockErr).Times(tc.mockTimes)
+		h.Import(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
+		assert.Equal(t, tc.expRes, resRec.Body.String(), "Test[%v] failed\n(%v)", i, tc.description)
+	}
+}
+
+func TestParseImportRowsAcademic(t *testing.T) {
+	records := [][]string{
+		{"name", "phone", "dob", "branch", "company", "status", "CGPA", "Backlogs", "Graduation Year"},
+		{"Monika Jaiswal", "6388768118", "2000-07-02", "ECE", valID, "PENDING", "8.25", "", "2023"},
+		{"Aditi Jaiswal", "6388768119", "2000-07-02", "CSE", valID, "PENDING", "", "", ""},
+		{"Utkarsh", "6388768117", "2000-07-02", "CSE", valID, "PENDING", "eight", "", "2023"},
+	}
+
+	rows, err := parseImportRows(records)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &entities.AcademicProfile{CGPA: 8.25, GraduationYear: 2023}, rows[0].Student.Academic)
+	assert.Nil(t, rows[1].Student.Academic)
+	assert.Equal(t, "Invalid Parameter: cgpa", rows[2].Error)
+}
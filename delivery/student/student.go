@@ -10,6 +10,7 @@ import (
 
 	"github.com/aditi-zs/Placement-API/entities"
 	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/mergepatch"
 	"github.com/aditi-zs/Placement-API/service"
 )
 
@@ -98,7 +99,7 @@ func (h handler) Create(w http.ResponseWriter, r *http.Request) {
 	err = json.Unmarshal(req, &stu)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write([]byte("invalid body"))
+		_, _ = w.Write([]byte(unmarshalError(err)))
 
 		return
 	}
@@ -113,6 +114,21 @@ func (h handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.service.Create(ctx, &stu)
 	if err != nil {
+		if e, ok := err.(errors.Duplicate); ok {
+			w.Header().Set("Location", "/students/"+e.ID)
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
 		w.WriteHeader(http.StatusBadRequest)
 		_, _ = w.Write([]byte(err.Error()))
 
@@ -154,7 +170,7 @@ func (h handler) Update(w http.ResponseWriter, r *http.Request) {
 	err = json.Unmarshal(req, &stu)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		_, _ = w.Write([]byte("invalid body"))
+		_, _ = w.Write([]byte(unmarshalError(err)))
 
 		return
 	}
@@ -169,6 +185,20 @@ func (h handler) Update(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.service.Update(ctx, id, &stu)
 	if err != nil {
+		if _, ok := err.(errors.Duplicate); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
 		if _, ok := err.(errors.EntityNotFound); ok {
 			w.WriteHeader(http.StatusNotFound)
 			_, _ = w.Write([]byte(err.Error()))
@@ -192,6 +222,79 @@ func (h handler) Update(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write(respBody)
 }
 
+func (h handler) Patch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	studentID := mux.Vars(r)["id"]
+
+	id, err := uuid.Parse(studentID)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(errors.InvalidParam{Param: studentID}.Error()))
+
+		return
+	}
+
+	if !mergepatch.IsContentType(r.Header.Get("Content-Type")) {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		_, _ = w.Write([]byte("Header Content-Type incorrect"))
+
+		return
+	}
+
+	req, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	if !json.Valid(req) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("invalid body"))
+
+		return
+	}
+
+	resp, err := h.service.Patch(ctx, id, req)
+	if err != nil {
+		if _, ok := err.(errors.Duplicate); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.Conflict); ok {
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
 func (h handler) Delete(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -225,6 +328,50 @@ func (h handler) Delete(w http.ResponseWriter, r *http.Request) {
 	_, _ = w.Write([]byte("Data deleted Successfully"))
 }
 
+// Merge merges the student otherId into the student id and returns the merged student.
+func (h handler) Merge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var ids [2]uuid.UUID
+
+	for i, name := range []string{"id", "otherId"} {
+		val := mux.Vars(r)[name]
+
+		id, err := uuid.Parse(val)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(errors.InvalidParam{Param: val}.Error()))
+
+			return
+		}
+
+		ids[i] = id
+	}
+
+	resp, err := h.service.Merge(ctx, ids[0], ids[1])
+	if err != nil {
+		if _, ok := err.(errors.EntityNotFound); ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	respBody, err := json.Marshal(resp)
+	if err != nil {
+		_, _ = w.Write([]byte("error in marshaling"))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(respBody)
+}
+
 func validateBody(s *entities.Student) error {
 	var missingParams []string
 	if s.Name == "" {
@@ -235,7 +382,7 @@ func validateBody(s *entities.Student) error {
 		missingParams = append(missingParams, "phone")
 	}
 
-	if s.DOB == "" {
+	if s.DOB.IsZero() {
 		missingParams = append(missingParams, "dob")
 	}
 
@@ -257,3 +404,13 @@ func validateBody(s *entities.Student) error {
 
 	return nil
 }
+
+// unmarshalError keeps the message of field level validation errors, such as an unparsable dob,
+// and hides the details of any other decoding error.
+func unmarshalError(err error) string {
+	if e, ok := err.(errors.InvalidParam); ok {
+		return e.Error()
+	}
+
+	return "invalid body"
+}
@@ -46,7 +46,7 @@ func TestGet(t *testing.T) {
 		statusCode     int
 	}{
 		{"Success case: All entries are present", "Monika", "ECE", "true",
-			[]entities.Student{{ID: id, Name: "Monika", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			[]entities.Student{{ID: id, Name: "Monika", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil, 200,
 		},
 		{"Error case: server error", "Aditi", "CSE", "true",
@@ -95,8 +95,8 @@ func TestGetByID(t *testing.T) {
 		statusCode  int
 	}{
 		{"Success case: for valid id", id, 1, entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118",
-			DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, nil,
-			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, nil,
+			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 200,
 		},
 		{"Error case: when id is valid but id is not present in db", id, 1, entities.Student{},
@@ -150,26 +150,44 @@ func TestCreate(t *testing.T) {
 		statusCode  int
 	}{
 		{"Success case: All entries are present",
-			`{"name":"Monika Jaiswal","phone":"6388768118","dob":"02/07/2000","branch":"ECE",` +
+			`{"name":"Monika Jaiswal","phone":"6388768118","dob":"2000-07-02","branch":"ECE",` +
 				`"comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`,
 			1,
-			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID},
+			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID},
 				Status: "ACCEPTED"},
-			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 				Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, nil,
-			`{"id":"71bbdbb9-6bde-11ed-aaff-64bc589051b4","name":"Monika Jaiswal","phone":"6388768118","dob":"02/07/2000","branch":"ECE",` +
+			`{"id":"71bbdbb9-6bde-11ed-aaff-64bc589051b4","name":"Monika Jaiswal","phone":"6388768118","dob":"2000-07-02","branch":"ECE",` +
 				`"comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`, 201,
 		},
 		{"Error case: unmarshal error", ``, 0, entities.Student{},
 			entities.Student{}, nil, "invalid body", 400,
 		},
+		{"Error case: invalid dob", `{"name":"Monika Jaiswal","dob":"2000-13-45"}`, 0, entities.Student{},
+			entities.Student{}, nil, `Invalid Parameter: date "2000-13-45" should be in YYYY-MM-DD format`, 400,
+		},
 		{"Error case: Failure case: db error",
 			`{"name":"Monika Jaiswal","phone":"6388768118","dob":"02/07/2000","branch":"ECE",
 "comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`, 1,
-			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, entities.Student{},
 			errors.DB{Reason: "server error"}, "DB Error: server error", 400,
 		},
+		{"Error case: phone already registered",
+			`{"name":"Monika Jaiswal","phone":"6388768118","dob":"2000-07-02","branch":"ECE",` +
+				`"comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`, 1,
+			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+				Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, entities.Student{},
+			errors.Conflict{Reason: "phone number already registered"}, "Conflict: phone number already registered", 409,
+		},
+		{"Error case: duplicate student",
+			`{"name":"Monika Jaiswal","phone":"6388768118","dob":"2000-07-02","branch":"ECE",` +
+				`"comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`, 1,
+			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+				Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, entities.Student{},
+			errors.Duplicate{ID: "71bbdbb9-6bde-11ed-aaff-64bc589051b4", Key: "phone"},
+			"Duplicate: student 71bbdbb9-6bde-11ed-aaff-64bc589051b4 already exists with the same phone", 409,
+		},
 		{"Error case: missing parameters", `{}`, 0, entities.Student{},
 			entities.Student{}, nil, "Missing Parameter: name,phone,dob,branch,company id,status", 400,
 		},
@@ -188,6 +206,9 @@ func TestCreate(t *testing.T) {
 
 		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
 		assert.Equal(t, tc.expRes, resRec.Body.String(), "Test[%v] failed\n(%v)", i, tc.description)
+		if e, ok := tc.mockErr.(errors.Duplicate); ok {
+			assert.Equal(t, "/students/"+e.ID, resRec.Header().Get("Location"), "Test[%v] failed\n(%v)", i, tc.description)
+		}
 	}
 }
 
@@ -219,18 +240,18 @@ func TestUpdate(t *testing.T) {
 		{"Success case: for valid id", id,
 			`{"name":"Monika Jaiswal","phone":"6388768118","dob":"02/07/2000","branch":"ECE",
 "comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`, 1,
-			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"},
-			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 				Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, nil,
 			`{"id":"71bbdbb9-6bde-11ed-aaff-64bc589051b4","name":"Monika Jaiswal","phone":"6388768118",` +
-				`"dob":"02/07/2000","branch":"ECE","comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`,
+				`"dob":"2000-07-02","branch":"ECE","comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`,
 			201,
 		},
 		{"Error case: when id is valid but id is not present in db", id,
 			`{"name":"Monika Jaiswal","phone":"6388768119","dob":"02/07/2000","branch":"ECE",
 "comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`, 1,
-			entities.Student{Name: "Monika Jaiswal", Phone: "6388768119", DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID},
+			entities.Student{Name: "Monika Jaiswal", Phone: "6388768119", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID},
 				Status: "ACCEPTED"}, entities.Student{}, errors.DB{Reason: "server error"}, "DB Error: server error", 400,
 		},
 		{"Error case: missing parameters", id, `{}`, 0, entities.Student{},
@@ -258,6 +279,75 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestPatch(t *testing.T) {
+	mockStudent := initializeTest(t)
+	id, err := uuid.Parse("71bbdbb9-6bde-11ed-aaff-64bc589051b4")
+
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	cmpID, err := uuid.Parse(valID)
+
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	tests := []struct {
+		description string
+		inputID     string
+		contType    string
+		input       string
+		mockTimes   int
+		mockRes     entities.Student
+		mockErr     error
+		expRes      string
+		statusCode  int
+	}{
+		{"Success case: status only", id.String(), "application/merge-patch+json", `{"status":"REJECTED"}`, 1,
+			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "REJECTED"}, nil,
+			`{"id":"71bbdbb9-6bde-11ed-aaff-64bc589051b4","name":"Monika Jaiswal","phone":"6388768118","dob":"2000-07-02",` +
+				`"branch":"ECE","comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4","name":"Wipro","category":"MASS"},"status":"REJECTED"}`,
+			200,
+		},
+		{"Error case: invalid id", "abc", "application/merge-patch+json", `{"status":"REJECTED"}`, 0, entities.Student{}, nil,
+			"Invalid Parameter: abc", 400,
+		},
+		{"Error case: unsupported content type", id.String(), "application/json-patch+json", `[]`, 0, entities.Student{}, nil,
+			"Header Content-Type incorrect", 415,
+		},
+		{"Error case: invalid body", id.String(), "application/json", `{`, 0, entities.Student{}, nil, "invalid body", 400},
+		{"Error case: id not found", id.String(), "application/merge-patch+json", `{"status":"REJECTED"}`, 1, entities.Student{},
+			errors.EntityNotFound{Reason: "id not found"}, "Entity Not Found:id not found", 404,
+		},
+		{"Error case: validation error", id.String(), "", `{"status":"ABC"}`, 1, entities.Student{},
+			errors.InvalidParam{Param: "invalid status"}, "Invalid Parameter: invalid status", 400,
+		},
+	}
+
+	for i, tc := range tests {
+		req, err := http.NewRequest("PATCH", "/students/{id}", strings.NewReader(tc.input))
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+
+		req.Header.Set("Content-Type", tc.contType)
+
+		resRec := httptest.NewRecorder()
+
+		req = mux.SetURLVars(req, map[string]string{"id": tc.inputID})
+
+		h := New(mockStudent)
+		mockStudent.EXPECT().Patch(gomock.Any(), id, []byte(tc.input)).Return(tc.mockRes, tc.mockErr).Times(tc.mockTimes)
+
+		h.Patch(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
+		assert.Equal(t, tc.expRes, resRec.Body.String(), "Test[%v] failed\n(%v)", i, tc.description)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	mockStudent := initializeTest(t)
 	id := uuid.New()
@@ -290,3 +380,62 @@ func TestDelete(t *testing.T) {
 		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
 	}
 }
+
+func TestMerge(t *testing.T) {
+	mockStudent := initializeTest(t)
+	id, err := uuid.Parse("71bbdbb9-6bde-11ed-aaff-64bc589051b4")
+
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	otherID := uuid.New()
+	cmpID, err := uuid.Parse(valID)
+
+	if err != nil {
+		t.Errorf(err.Error())
+	}
+
+	tests := []struct {
+		description string
+		inputID     string
+		otherID     string
+		mockTimes   int
+		mockRes     entities.Student
+		mockErr     error
+		expRes      string
+		statusCode  int
+	}{
+		{"Success case: merged", id.String(), otherID.String(), 1,
+			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+				Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, nil,
+			`{"id":"71bbdbb9-6bde-11ed-aaff-64bc589051b4","name":"Monika Jaiswal","phone":"+916388768118","dob":"2000-07-02",` +
+				`"branch":"ECE","comp":{"id":"1fa46d13-6a50-11ed-90d1-64bc589051b4"},"status":"ACCEPTED"}`, 200,
+		},
+		{"Error case: invalid id", "abc", otherID.String(), 0, entities.Student{}, nil, "Invalid Parameter: abc", 400},
+		{"Error case: invalid other id", id.String(), "xyz", 0, entities.Student{}, nil, "Invalid Parameter: xyz", 400},
+		{"Error case: not found", id.String(), otherID.String(), 1, entities.Student{},
+			errors.EntityNotFound{Reason: "id not found"}, "Entity Not Found:id not found", 404,
+		},
+		{"Error case: same student", id.String(), id.String(), 1, entities.Student{},
+			errors.InvalidParam{Param: "a student cannot be merged with itself"},
+			"Invalid Parameter: a student cannot be merged with itself", 400,
+		},
+	}
+
+	for i, tc := range tests {
+		req, err := http.NewRequest("POST", "/students/{id}/merge/{otherId}", http.NoBody)
+		if err != nil {
+			t.Errorf(err.Error())
+		}
+
+		resRec := httptest.NewRecorder()
+		req = mux.SetURLVars(req, map[string]string{"id": tc.inputID, "otherId": tc.otherID})
+		h := New(mockStudent)
+		mockStudent.EXPECT().Merge(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.mockRes, tc.mockErr).Times(tc.mockTimes)
+		h.Merge(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%v] failed\n(%v)", i, tc.description)
+		assert.Equal(t, tc.expRes, resRec.Body.String(), "Test[%v] failed\n(%v)", i, tc.description)
+	}
+}
@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+func initializeTest(t *testing.T) *service.MockWebhookSvc {
+	ctrl := gomock.NewController(t)
+
+	return service.NewMockWebhookSvc(ctrl)
+}
+
+func TestCreate(t *testing.T) {
+	mockWebhook := initializeTest(t)
+	input := entities.Webhook{URL: "https://erp.example.com/hooks", Events: []entities.EventType{entities.StudentCreated}}
+
+	tests := []struct {
+		description string
+		body        string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", `{"url":"https://erp.example.com/hooks","events":["student.created"]}`, 1, nil, 201},
+		{"Error case: invalid body", `{"url":`, 0, nil, 400},
+		{"Error case: invalid webhook", `{"url":"https://erp.example.com/hooks","events":["student.created"]}`, 1,
+			errors.InvalidParam{Param: "url"}, 400},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/webhooks", strings.NewReader(tc.body))
+		resRec := httptest.NewRecorder()
+
+		mockWebhook.EXPECT().Create(gomock.Any(), &input).Return(entities.Webhook{}, tc.mockErr).Times(tc.mockTimes)
+		New(mockWebhook).Create(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRedeliver(t *testing.T) {
+	mockWebhook := initializeTest(t)
+	webhookID, deliveryID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		description string
+		webhookID   string
+		deliveryID  string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", webhookID.String(), deliveryID.String(), 1, nil, 201},
+		{"Error case: invalid webhook id", "abc", deliveryID.String(), 0, nil, 400},
+		{"Error case: invalid delivery id", webhookID.String(), "abc", 0, nil, 400},
+		{"Error case: delivery not found", webhookID.String(), deliveryID.String(), 1,
+			errors.EntityNotFound{Reason: "delivery not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("POST", "/webhooks/"+tc.webhookID+"/deliveries/"+tc.deliveryID+"/redeliver", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.webhookID, "deliveryId": tc.deliveryID})
+		resRec := httptest.NewRecorder()
+
+		mockWebhook.EXPECT().Redeliver(gomock.Any(), webhookID, deliveryID).Return(entities.Delivery{}, tc.mockErr).
+			Times(tc.mockTimes)
+		New(mockWebhook).Redeliver(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetDeliveries(t *testing.T) {
+	mockWebhook := initializeTest(t)
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		id          string
+		mockTimes   int
+		mockErr     error
+		statusCode  int
+	}{
+		{"Success case", id.String(), 1, nil, 200},
+		{"Error case: invalid id", "abc", 0, nil, 400},
+		{"Error case: webhook not found", id.String(), 1, errors.EntityNotFound{Reason: "id not found"}, 404},
+	}
+
+	for i, tc := range tests {
+		req := httptest.NewRequest("GET", "/webhooks/"+tc.id+"/deliveries", http.NoBody)
+		req = mux.SetURLVars(req, map[string]string{"id": tc.id})
+		resRec := httptest.NewRecorder()
+
+		mockWebhook.EXPECT().GetDeliveries(gomock.Any(), id).Return([]entities.Delivery{}, tc.mockErr).Times(tc.mockTimes)
+		New(mockWebhook).GetDeliveries(resRec, req)
+
+		assert.Equal(t, tc.statusCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/api"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/openapi"
+	"github.com/aditi-zs/Placement-API/ratelimit"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+const operatorKey, collegeKey, recruiterKey = "operator-key", "college-key", "recruiter-key"
+
+// TestSpecDrift builds the routes of the server with mocked services and the spec middleware
+// checking responses. A route missing from api/openapi.json fails, and so does a documented
+// operation no route serves. Every operation is then requested through the router, so a handler
+// answering with an undocumented status or a body the spec does not describe fails too.
+func TestSpecDrift(t *testing.T) {
+	spec, err := openapi.Load(api.Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := gomock.NewController(t)
+	mocks := struct {
+		college   *service.MockCollegeSvc
+		season    *service.MockSeasonSvc
+		company   *service.MockCompanySvc
+		student   *service.MockStudentSvc
+		document  *service.MockDocumentSvc
+		drive     *service.MockDriveSvc
+		offer     *service.MockOfferSvc
+		letter    *service.MockLetterSvc
+		report    *service.MockReportSvc
+		recruiter *service.MockRecruiterSvc
+		webhook   *service.MockWebhookSvc
+		event     *service.MockEventSvc
+	}{service.NewMockCollegeSvc(ctrl), service.NewMockSeasonSvc(ctrl), service.NewMockCompanySvc(ctrl),
+		service.NewMockStudentSvc(ctrl), service.NewMockDocumentSvc(ctrl), service.NewMockDriveSvc(ctrl),
+		service.NewMockOfferSvc(ctrl), service.NewMockLetterSvc(ctrl), service.NewMockReportSvc(ctrl),
+		service.NewMockRecruiterSvc(ctrl), service.NewMockWebhookSvc(ctrl), service.NewMockEventSvc(ctrl)}
+
+	router := mux.NewRouter()
+	addRoutes(router, &services{College: mocks.college, Season: mocks.season, Company: mocks.company, Student: mocks.student,
+		Document: mocks.document, Drive: mocks.drive, Offer: mocks.offer, Letter: mocks.letter, Report: mocks.report,
+		Recruiter: mocks.recruiter, Webhook: mocks.webhook, Event: mocks.event,
+	}, &routeConfig{
+		OperatorKey:     operatorKey,
+		MaxDocumentSize: 1 << 20,
+		Limiter:         ratelimit.NewMemory(),
+		Validate:        spec.Middleware(openapi.Config{Responses: true}),
+		Idempotent:      func(next http.Handler) http.Handler { return next },
+	})
+
+	registered := map[string]bool{}
+
+	err = router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil
+		}
+
+		path, _ := route.GetPathTemplate()
+
+		for _, method := range methods {
+			registered[method+" "+path] = true
+
+			op, _ := spec.Find(method, path)
+			assert.NotNil(t, op, "%s %s is registered but not documented", method, path)
+		}
+
+		return nil
+	})
+	assert.NoError(t, err)
+
+	spec.Operations(func(method, path string, _ *openapi.Operation) {
+		assert.True(t, registered[method+" "+path], "%s %s is documented but not registered", method, path)
+	})
+
+	now := time.Date(2023, 1, 10, 9, 30, 0, 0, time.UTC)
+	college := entities.College{ID: uuid.New(), Name: "RVCE", CreatedAt: now, APIKey: collegeKey}
+	season := entities.Season{ID: uuid.New(), Name: "2023-24", CreatedAt: now}
+	archived := entities.Season{ID: uuid.New(), Name: "2022-23", CreatedAt: now, ArchivedAt: &now}
+
+	minCGPA := entities.EligibilityCriteria{MinCGPA: 7.5, AllowedBranches: []entities.Branch{entities.CSE}}
+	company := entities.Company{ID: uuid.New(), Name: "Wipro", Category: entities.MASS, Criteria: &minCGPA}
+	student := entities.Student{ID: uuid.New(), Name: "Aditi", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2),
+		Branch: entities.ECE, Comp: company, Status: entities.PENDING,
+		Academic: &entities.AcademicProfile{CGPA: 8.2, GraduationYear: 2023}, Email: "aditi@example.com"}
+	rounds := []entities.Round{{Number: 1, Name: "Aptitude"}}
+	drive := entities.Drive{ID: uuid.New(), Comp: company, Date: entities.NewDate(2023, 2, 15), RegistrationOpens: now,
+		RegistrationCloses: now.Add(24 * time.Hour), Rounds: rounds, Branches: []entities.Branch{entities.ECE}}
+	registration := entities.Registration{DriveID: drive.ID, StudentID: student.ID, RegisteredAt: now}
+	score := 72.5
+	result := entities.RoundResult{DriveID: drive.ID, Round: 1, StudentID: student.ID, Score: &score, Passed: true, RecordedAt: now}
+	offer := entities.Offer{ID: uuid.New(), StudentID: student.ID, Comp: company, Role: "SDE", CTC: 650000,
+		JoiningDate: entities.NewDate(2023, 8, 1), ExpiresOn: entities.NewDate(2023, 3, 1), Status: entities.OfferMade, CreatedAt: now}
+	document := entities.Document{ID: uuid.New(), StudentID: student.ID, Kind: entities.Resume, FileName: "resume.pdf",
+		ContentType: "application/pdf", Size: 8, Checksum: "0f", UploadedAt: now}
+	template := entities.LetterTemplate{ID: uuid.New(), Name: "Default", Title: "Offer", Body: "Dear {{.Student.Name}}", UpdatedAt: now}
+	recruiter := entities.Recruiter{ID: uuid.New(), CompanyID: company.ID, CollegeID: college.ID, Name: "Priya",
+		Email: "priya@wipro.com", CreatedAt: now, APIKey: recruiterKey}
+	webhook := entities.Webhook{ID: uuid.New(), URL: "https://example.com/hooks", Events: []entities.EventType{entities.OfferIssued},
+		CreatedAt: now}
+	delivery := entities.Delivery{ID: uuid.New(), WebhookID: webhook.ID, EventID: uuid.New(), EventType: entities.OfferIssued,
+		Payload: "{}", Status: entities.DeliveryPending, NextAttemptAt: now, CreatedAt: now}
+	event := entities.Event{ID: uuid.New(), Type: entities.OfferIssued, CollegeID: college.ID, SeasonID: season.ID, OccurredAt: now,
+		Offer: &offer}
+	imported := entities.ImportReport{Total: 1, Succeeded: 1, Rows: []entities.ImportRow{{Row: 2, ID: &student.ID}}}
+	summary := entities.PlacementSummary{TotalStudents: 1, PlacedStudents: 1, PlacementPercentage: 100,
+		TopRecruiters: []entities.RecruiterCount{{Company: company, Placed: 1}}}
+	notFound := errors.EntityNotFound{Reason: "id not found"}
+
+	mocks.college.EXPECT().Authenticate(gomock.Any(), collegeKey).Return(college, nil).AnyTimes()
+	mocks.recruiter.EXPECT().Authenticate(gomock.Any(), recruiterKey).Return(recruiter, nil).AnyTimes()
+	mocks.season.EXPECT().Current(gomock.Any()).Return(season, nil).AnyTimes()
+	mocks.season.EXPECT().GetByID(gomock.Any(), archived.ID).Return(archived, nil).AnyTimes()
+
+	companyBody := `{"name":"Wipro","category":"MASS","criteria":{"minCgpa":7.5}}`
+	studentBody := `{"name":"Aditi","phone":"+916388768118","dob":"02/07/2000","branch":"ECE","comp":{"id":"` + company.ID.String() +
+		`"},"status":"PENDING"}`
+	driveBody := `{"comp":{"id":"` + company.ID.String() + `"},"date":"2023-02-15","registrationOpens":"2023-01-10T09:30:00Z",` +
+		`"registrationCloses":"2023-02-10T18:00:00Z"}`
+	offerBody := `{"studentId":"` + student.ID.String() + `","comp":{"id":"` + company.ID.String() + `"},"role":"SDE","ctc":650000,` +
+		`"joiningDate":"2023-08-01","expiresOn":"2023-03-01"}`
+	importBody := "name,phone,dob,branch,company_id,status\nAditi,+916388768118,2000-07-02,ECE," + company.ID.String() + ",PENDING\n"
+	uploadBody, uploadType := multipartFile(t, "resume.pdf", "%PDF-1.4")
+
+	collegePath, seasonPath, webhookPath := "/colleges/"+college.ID.String(), "/seasons/"+season.ID.String(), "/webhooks/"+webhook.ID.String()
+	companyPath, studentPath := "/companies/"+company.ID.String(), "/students/"+student.ID.String()
+	drivePath, offerPath, templatePath := "/drives/"+drive.ID.String(), "/offers/"+offer.ID.String(), "/letter-templates/"+template.ID.String()
+	documentPath := studentPath + "/documents/" + document.ID.String()
+	resultPath := drivePath + "/rounds/1/results/" + student.ID.String()
+
+	tests := []struct {
+		method   string
+		path     string
+		body     string
+		contType string
+		mock     func()
+		expCode  int
+	}{
+		{"GET", "/colleges", "", "", func() { mocks.college.EXPECT().Get(gomock.Any()).Return([]entities.College{college}, nil) }, 200},
+		{"POST", "/colleges", `{"name":"RVCE"}`, "", func() {
+			mocks.college.EXPECT().Create(gomock.Any(), gomock.Any()).Return(college, nil)
+		}, 201},
+		{"POST", "/colleges", `{}`, "", func() {}, 400},
+		{"GET", collegePath, "", "", func() { mocks.college.EXPECT().GetByID(gomock.Any(), college.ID).Return(college, nil) }, 200},
+		{"POST", collegePath + "/key", "", "", func() { mocks.college.EXPECT().RotateKey(gomock.Any(), college.ID).Return(college, nil) }, 200},
+
+		{"GET", "/seasons", "", "", func() { mocks.season.EXPECT().Get(gomock.Any()).Return([]entities.Season{season}, nil) }, 200},
+		{"POST", "/seasons", `{"name":"2023-24"}`, "", func() {
+			mocks.season.EXPECT().Create(gomock.Any(), gomock.Any()).Return(season, nil)
+		}, 201},
+		{"POST", "/seasons/rollover", `{"name":"2023-24"}`, "", func() {
+			mocks.season.EXPECT().Rollover(gomock.Any(), gomock.Any()).Return(season, nil)
+		}, 201},
+		{"GET", seasonPath, "", "", func() { mocks.season.EXPECT().GetByID(gomock.Any(), season.ID).Return(season, nil) }, 200},
+
+		{"GET", "/webhooks", "", "", func() { mocks.webhook.EXPECT().Get(gomock.Any()).Return([]entities.Webhook{webhook}, nil) }, 200},
+		{"POST", "/webhooks", `{"url":"https://example.com/hooks","events":["offer.issued"]}`, "", func() {
+			mocks.webhook.EXPECT().Create(gomock.Any(), gomock.Any()).Return(webhook, nil)
+		}, 201},
+		{"GET", webhookPath, "", "", func() { mocks.webhook.EXPECT().GetByID(gomock.Any(), webhook.ID).Return(webhook, nil) }, 200},
+		{"DELETE", webhookPath, "", "", func() { mocks.webhook.EXPECT().Delete(gomock.Any(), webhook.ID).Return(nil) }, 204},
+		{"GET", webhookPath + "/deliveries", "", "", func() {
+			mocks.webhook.EXPECT().GetDeliveries(gomock.Any(), webhook.ID).Return([]entities.Delivery{delivery}, nil)
+		}, 200},
+		{"POST", webhookPath + "/deliveries/" + delivery.ID.String() + "/redeliver", "", "", func() {
+			mocks.webhook.EXPECT().Redeliver(gomock.Any(), webhook.ID, delivery.ID).Return(delivery, nil)
+		}, 201},
+
+		{"GET", "/companies", "", "", func() { mocks.company.EXPECT().Get(gomock.Any()).Return([]entities.Company{company}, nil) }, 200},
+		{"GET", "/companies?season=" + uuid.Nil.String(), "", "", func() {
+			mocks.season.EXPECT().GetByID(gomock.Any(), uuid.Nil).Return(entities.Season{}, notFound)
+		}, 404},
+		{"POST", "/companies", companyBody, "", func() { mocks.company.EXPECT().Create(gomock.Any(), gomock.Any()).Return(company, nil) }, 201},
+		{"POST", "/companies", `{"name":"Wipro"}`, "", func() {}, 400},
+		{"POST", "/companies?season=" + archived.ID.String(), companyBody, "", func() {}, 409},
+		{"GET", companyPath, "", "", func() { mocks.company.EXPECT().GetByID(gomock.Any(), company.ID).Return(company, nil) }, 200},
+		{"GET", companyPath, "", "", func() {
+			mocks.company.EXPECT().GetByID(gomock.Any(), company.ID).Return(entities.Company{}, notFound)
+		}, 404},
+		{"GET", "/companies/abc", "", "", func() {}, 400},
+		{"PUT", companyPath, companyBody, "", func() {
+			mocks.company.EXPECT().Update(gomock.Any(), company.ID, gomock.Any()).Return(company, nil)
+		}, 201},
+		{"PATCH", companyPath, `{"name":"Wipro"}`, "application/merge-patch+json", func() {
+			mocks.company.EXPECT().Patch(gomock.Any(), company.ID, gomock.Any()).Return(company, nil)
+		}, 200},
+		{"PATCH", companyPath, `{"name":"Wipro"}`, "text/plain", func() {}, 415},
+		{"DELETE", companyPath, "", "", func() { mocks.company.EXPECT().Delete(gomock.Any(), company.ID).Return(nil) }, 204},
+		{"DELETE", companyPath, "", "", func() { mocks.company.EXPECT().Delete(gomock.Any(), company.ID).Return(notFound) }, 404},
+		{"GET", companyPath + "/rounds", "", "", func() { mocks.company.EXPECT().GetRounds(gomock.Any(), company.ID).Return(rounds, nil) }, 200},
+		{"PUT", companyPath + "/rounds", `[{"name":"Aptitude"}]`, "", func() {
+			mocks.company.EXPECT().SetRounds(gomock.Any(), company.ID, gomock.Any()).Return(rounds, nil)
+		}, 200},
+		{"GET", companyPath + "/recruiters", "", "", func() {
+			mocks.recruiter.EXPECT().Get(gomock.Any(), company.ID).Return([]entities.Recruiter{recruiter}, nil)
+		}, 200},
+		{"POST", companyPath + "/recruiters", `{"name":"Priya","email":"priya@wipro.com"}`, "", func() {
+			mocks.recruiter.EXPECT().Create(gomock.Any(), gomock.Any()).Return(recruiter, nil)
+		}, 201},
+		{"DELETE", "/recruiters/" + recruiter.ID.String(), "", "", func() {
+			mocks.recruiter.EXPECT().Delete(gomock.Any(), recruiter.ID).Return(nil)
+		}, 204},
+
+		{"GET", "/students?branch=ECE&includeCompany=true", "", "", func() {
+			mocks.student.EXPECT().Get(gomock.Any(), "", "ECE", "true").Return([]entities.Student{student}, nil)
+		}, 200},
+		{"GET", "/students?branch=ARTS", "", "", func() {}, 400},
+		{"GET", "/students/export?format=csv", "", "", func() {
+			mocks.student.EXPECT().Export(gomock.Any(), "", "", "", gomock.Any()).DoAndReturn(
+				func(_, _, _, _ interface{}, fn func(entities.Student) error) error { return fn(student) })
+		}, 200},
+		{"POST", "/students", studentBody, "", func() { mocks.student.EXPECT().Create(gomock.Any(), gomock.Any()).Return(student, nil) }, 201},
+		{"POST", "/students", studentBody, "", func() {
+			mocks.student.EXPECT().Create(gomock.Any(), gomock.Any()).Return(entities.Student{}, errors.Conflict{Reason: "phone already registered"})
+		}, 409},
+		{"POST", "/students/import", importBody, "text/csv", func() {
+			mocks.student.EXPECT().Import(gomock.Any(), gomock.Any(), false).Return(imported, nil)
+		}, 200},
+		{"POST", "/students/import", importBody, "text/plain", func() {}, 415},
+		{"GET", studentPath, "", "", func() { mocks.student.EXPECT().GetByID(gomock.Any(), student.ID).Return(student, nil) }, 200},
+		{"GET", studentPath, "", "", func() {
+			mocks.student.EXPECT().GetByID(gomock.Any(), student.ID).Return(entities.Student{}, notFound)
+		}, 404},
+		{"PUT", studentPath, studentBody, "", func() {
+			mocks.student.EXPECT().Update(gomock.Any(), student.ID, gomock.Any()).Return(student, nil)
+		}, 201},
+		{"PUT", studentPath, studentBody, "", func() {
+			mocks.student.EXPECT().Update(gomock.Any(), student.ID, gomock.Any()).Return(entities.Student{}, notFound)
+		}, 404},
+		{"PATCH", studentPath, `{"status":"PENDING"}`, "application/merge-patch+json", func() {
+			mocks.student.EXPECT().Patch(gomock.Any(), student.ID, gomock.Any()).Return(student, nil)
+		}, 200},
+		{"DELETE", studentPath, "", "", func() { mocks.student.EXPECT().Delete(gomock.Any(), student.ID).Return(nil) }, 204},
+		{"DELETE", studentPath, "", "", func() { mocks.student.EXPECT().Delete(gomock.Any(), student.ID).Return(notFound) }, 404},
+		{"POST", studentPath + "/merge/" + uuid.Nil.String(), "", "", func() {
+			mocks.student.EXPECT().Merge(gomock.Any(), student.ID, uuid.Nil).Return(student, nil)
+		}, 200},
+		{"GET", studentPath + "/documents", "", "", func() {
+			mocks.document.EXPECT().Get(gomock.Any(), student.ID).Return([]entities.Document{document}, nil)
+		}, 200},
+		{"POST", studentPath + "/documents", uploadBody, uploadType, func() {
+			mocks.document.EXPECT().Upload(gomock.Any(), gomock.Any(), []byte("%PDF-1.4")).Return(document, nil)
+		}, 201},
+		{"GET", documentPath, "", "", func() {
+			file := io.NopCloser(strings.NewReader("%PDF-1.4"))
+			mocks.document.EXPECT().Download(gomock.Any(), student.ID, document.ID).Return(document, file, nil)
+		}, 200},
+		{"DELETE", documentPath, "", "", func() { mocks.document.EXPECT().Delete(gomock.Any(), student.ID, document.ID).Return(nil) }, 204},
+
+		{"GET", "/drives?companyId=" + company.ID.String(), "", "", func() {
+			mocks.drive.EXPECT().Get(gomock.Any(), company.ID).Return([]entities.Drive{drive}, nil)
+		}, 200},
+		{"POST", "/drives", driveBody, "", func() { mocks.drive.EXPECT().Create(gomock.Any(), gomock.Any()).Return(drive, nil) }, 201},
+		{"POST", "/drives", `{"date":"2023-02-15"}`, "", func() {}, 400},
+		{"GET", drivePath, "", "", func() { mocks.drive.EXPECT().GetByID(gomock.Any(), drive.ID).Return(drive, nil) }, 200},
+		{"PUT", drivePath, driveBody, "", func() { mocks.drive.EXPECT().Update(gomock.Any(), drive.ID, gomock.Any()).Return(drive, nil) }, 201},
+		{"DELETE", drivePath, "", "", func() { mocks.drive.EXPECT().Delete(gomock.Any(), drive.ID).Return(nil) }, 204},
+		{"GET", drivePath + "/registrations", "", "", func() {
+			mocks.drive.EXPECT().GetRegistrations(gomock.Any(), drive.ID).Return([]entities.Registration{registration}, nil)
+		}, 200},
+		{"POST", drivePath + "/registrations", `{"studentId":"` + student.ID.String() + `"}`, "", func() {
+			mocks.drive.EXPECT().Register(gomock.Any(), drive.ID, student.ID).Return(registration, nil)
+		}, 201},
+		{"GET", drivePath + "/results?studentId=" + student.ID.String(), "", "", func() {
+			mocks.drive.EXPECT().GetResults(gomock.Any(), drive.ID, student.ID).Return([]entities.RoundResult{result}, nil)
+		}, 200},
+		{"PUT", resultPath, `{"passed":true,"score":72.5}`, "", func() {
+			mocks.drive.EXPECT().RecordResult(gomock.Any(), gomock.Any()).Return(result, nil)
+		}, 200},
+		{"PUT", resultPath, `{"passed":true,"score":-1}`, "", func() {}, 400},
+
+		{"GET", "/offers", "", "", func() { mocks.offer.EXPECT().Get(gomock.Any(), uuid.Nil).Return([]entities.Offer{offer}, nil) }, 200},
+		{"POST", "/offers", offerBody, "", func() { mocks.offer.EXPECT().Create(gomock.Any(), gomock.Any()).Return(offer, nil) }, 201},
+		{"GET", offerPath, "", "", func() { mocks.offer.EXPECT().GetByID(gomock.Any(), offer.ID).Return(offer, nil) }, 200},
+		{"POST", offerPath + "/accept", "", "", func() { mocks.offer.EXPECT().Accept(gomock.Any(), offer.ID).Return(offer, nil) }, 200},
+		{"POST", offerPath + "/accept", "", "", func() {
+			mocks.offer.EXPECT().Accept(gomock.Any(), offer.ID).Return(entities.Offer{}, errors.Conflict{Reason: "offer has expired"})
+		}, 409},
+		{"POST", offerPath + "/decline", "", "", func() { mocks.offer.EXPECT().Decline(gomock.Any(), offer.ID).Return(offer, nil) }, 200},
+		{"GET", offerPath + "/letter.pdf", "", "", func() {
+			mocks.offer.EXPECT().Letter(gomock.Any(), offer.ID).Return([]byte("%PDF-1.4"), nil)
+		}, 200},
+
+		{"GET", "/letter-templates", "", "", func() {
+			mocks.letter.EXPECT().Get(gomock.Any()).Return([]entities.LetterTemplate{template}, nil)
+		}, 200},
+		{"POST", "/letter-templates", `{"name":"Default","body":"Dear {{.Student.Name}}"}`, "", func() {
+			mocks.letter.EXPECT().Create(gomock.Any(), gomock.Any()).Return(template, nil)
+		}, 201},
+		{"GET", templatePath, "", "", func() { mocks.letter.EXPECT().GetByID(gomock.Any(), template.ID).Return(template, nil) }, 200},
+		{"PUT", templatePath, `{"name":"Default","body":"Dear {{.Student.Name}}"}`, "", func() {
+			mocks.letter.EXPECT().Update(gomock.Any(), template.ID, gomock.Any()).Return(template, nil)
+		}, 201},
+		{"DELETE", templatePath, "", "", func() { mocks.letter.EXPECT().Delete(gomock.Any(), template.ID).Return(nil) }, 204},
+
+		{"GET", "/reports/summary?top=3", "", "", func() { mocks.report.EXPECT().Summary(gomock.Any(), 3).Return(summary, nil) }, 200},
+		{"GET", "/events/stream?branch=ECE", "", "", func() {
+			updates := make(chan entities.Event)
+			close(updates)
+			mocks.event.EXPECT().Subscribe(gomock.Any(), uuid.Nil).Return([]entities.Event{event}, updates, func() {})
+		}, 200},
+
+		{"GET", "/recruiter/drives", "", "", func() {
+			mocks.recruiter.EXPECT().Drives(gomock.Any(), gomock.Any()).Return([]entities.Drive{drive}, nil)
+		}, 200},
+		{"GET", "/recruiter/applicants?driveId=" + drive.ID.String(), "", "", func() {
+			mocks.recruiter.EXPECT().Applicants(gomock.Any(), gomock.Any(), drive.ID).Return([]entities.Applicant{
+				{Student: student, DriveID: drive.ID, RegisteredAt: now}}, nil)
+		}, 200},
+		{"POST", "/recruiter/applicants/" + student.ID.String() + "/shortlist", "", "", func() {
+			mocks.recruiter.EXPECT().SetStatus(gomock.Any(), gomock.Any(), student.ID, entities.SHORTLISTED).Return(student, nil)
+		}, 200},
+		{"PUT", "/recruiter/applicants/" + student.ID.String() + "/status", `{"status":"REJECTED"}`, "", func() {
+			mocks.recruiter.EXPECT().SetStatus(gomock.Any(), gomock.Any(), student.ID, entities.REJECTED).Return(student, nil)
+		}, 200},
+		{"PUT", "/recruiter" + resultPath, `{"passed":false}`, "", func() {
+			mocks.recruiter.EXPECT().RecordResult(gomock.Any(), gomock.Any(), gomock.Any()).Return(result, nil)
+		}, 200},
+	}
+
+	exercised := map[*openapi.Operation]bool{}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+		req.Header.Set("X-API-KEY", keyOf(tc.path))
+		req.Header.Set("Content-Type", tc.contType)
+		resRec := httptest.NewRecorder()
+
+		router.ServeHTTP(resRec, req)
+
+		assert.Equal(t, tc.expCode, resRec.Code, "Test[%d] failed\n(%s %s): %s", i, tc.method, tc.path, resRec.Body.String())
+
+		op, _ := spec.Find(tc.method, strings.Split(tc.path, "?")[0])
+		exercised[op] = true
+	}
+
+	spec.Operations(func(method, path string, op *openapi.Operation) {
+		assert.True(t, exercised[op], "%s %s is documented but not tested against its handler", method, path)
+	})
+}
+
+// keyOf returns the API key the route group of path is authenticated with.
+func keyOf(path string) string {
+	switch {
+	case strings.HasPrefix(path, "/colleges"):
+		return operatorKey
+	case strings.HasPrefix(path, "/recruiter/"):
+		return recruiterKey
+	default:
+		return collegeKey
+	}
+}
+
+// multipartFile returns a multipart/form-data body with content in its file field, and its type.
+func multipartFile(t *testing.T, name, content string) (body, contType string) {
+	var buf bytes.Buffer
+
+	form := multipart.NewWriter(&buf)
+
+	part, err := form.CreateFormFile("file", name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _ = part.Write([]byte(content))
+	_ = form.Close()
+
+	return buf.String(), form.FormDataContentType()
+}
@@ -0,0 +1,18 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// College is a tenant of the deployment. Students, companies and everything hanging off them
+// belong to exactly one college and are never visible to another.
+type College struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	// APIKey is only set in the responses that create the college or issue it a new key. Only its
+	// hash is stored.
+	APIKey string `json:"apiKey,omitempty"`
+}
@@ -1,11 +1,17 @@
 package entities
 
-import "github.com/google/uuid"
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
 
 type Company struct {
 	ID       uuid.UUID `json:"id,omitempty"`
 	Name     string    `json:"name,omitempty"`
 	Category Category  `json:"category,omitempty"`
+	// Criteria is nil for companies that accept any student of an allowed branch.
+	Criteria *EligibilityCriteria `json:"criteria,omitempty"`
 }
 
 type Category string
@@ -24,3 +30,41 @@ func IsValidCategory(c Category) bool {
 
 	return false
 }
+
+// AllowsBranch reports whether students of branch b may be placed with companies of category c: core
+// companies hire civil and mechanical students, dream IT companies computer science and information
+// science students and open dream companies those and electronics students. Mass recruiters hire
+// from every branch.
+func (c Category) AllowsBranch(b Branch) bool {
+	switch c {
+	case CORE:
+		return b == CIVIL || b == MECH
+	case OPENDREAM:
+		return b == CSE || b == ISE || b == ECE || b == EEE
+	case DREAMIT:
+		return b == CSE || b == ISE
+	default:
+		return true
+	}
+}
+
+// Check returns a description of every requirement of the company st fails: the branches allowed for
+// its category, its eligibility criteria and the placement policy. It returns nil when the company may
+// hire st.
+func (c *Company) Check(st *Student) []string {
+	var failed []string
+
+	if !c.Category.AllowsBranch(st.Branch) {
+		failed = append(failed, fmt.Sprintf("branch %s is not allowed for %s companies", st.Branch, c.Category))
+	}
+
+	if c.Criteria != nil {
+		failed = append(failed, c.Criteria.Check(st)...)
+	}
+
+	if reason := PlacementPolicy(st, c); reason != "" {
+		failed = append(failed, reason)
+	}
+
+	return failed
+}
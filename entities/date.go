@@ -0,0 +1,119 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+const (
+	DateFormat       = "2006-01-02"
+	LegacyDateFormat = "02/01/2006"
+)
+
+// Date is a calendar date without a time of day. It is written as ISO-8601 (YYYY-MM-DD) and
+// read from either ISO-8601 or the legacy DD/MM/YYYY format.
+type Date struct {
+	time.Time
+}
+
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func ParseDate(s string) (Date, error) {
+	for _, layout := range []string{DateFormat, LegacyDateFormat} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Date{Time: t}, nil
+		}
+	}
+
+	return Date{}, errors.InvalidParam{Param: fmt.Sprintf("date %q should be in YYYY-MM-DD format", s)}
+}
+
+// AgeOn returns the age in completed years on the given day.
+func (d Date) AgeOn(day time.Time) int {
+	age := day.Year() - d.Year()
+
+	if day.Month() < d.Month() || (day.Month() == d.Month() && day.Day() < d.Day()) {
+		age--
+	}
+
+	return age
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.Format(DateFormat)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.InvalidParam{Param: "date should be a string in YYYY-MM-DD format"}
+	}
+
+	if s == nil || *s == "" {
+		*d = Date{}
+		return nil
+	}
+
+	parsed, err := ParseDate(*s)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+func (d *Date) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*d = Date{}
+		return nil
+	case time.Time:
+		*d = NewDate(v.Date())
+		return nil
+	case []byte:
+		return d.scanString(string(v))
+	case string:
+		return d.scanString(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Date", src)
+	}
+}
+
+func (d *Date) scanString(s string) error {
+	parsed, err := ParseDate(s)
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+
+	return d.String(), nil
+}
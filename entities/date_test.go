@@ -0,0 +1,92 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+func TestDateUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expRes      Date
+		expErr      error
+	}{
+		{"Success case: ISO-8601", `"2000-07-02"`, NewDate(2000, 7, 2), nil},
+		{"Success case: legacy DD/MM/YYYY", `"02/07/2000"`, NewDate(2000, 7, 2), nil},
+		{"Success case: null", `null`, Date{}, nil},
+		{"Success case: empty string", `""`, Date{}, nil},
+		{"Error case: garbage", `"yesterday"`, Date{},
+			errors.InvalidParam{Param: `date "yesterday" should be in YYYY-MM-DD format`}},
+		{"Error case: impossible day", `"2000-02-30"`, Date{},
+			errors.InvalidParam{Param: `date "2000-02-30" should be in YYYY-MM-DD format`}},
+		{"Error case: not a string", `20000702`, Date{},
+			errors.InvalidParam{Param: "date should be a string in YYYY-MM-DD format"}},
+	}
+
+	for i, tc := range tests {
+		var d Date
+
+		err := json.Unmarshal([]byte(tc.input), &d)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, d, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDateMarshalJSON(t *testing.T) {
+	out, err := json.Marshal(struct {
+		DOB   Date `json:"dob"`
+		Empty Date `json:"empty"`
+	}{DOB: NewDate(2000, 7, 2)})
+
+	assert.Nil(t, err)
+	assert.Equal(t, `{"dob":"2000-07-02","empty":null}`, string(out))
+}
+
+func TestDateScan(t *testing.T) {
+	tests := []struct {
+		description string
+		input       interface{}
+		expRes      Date
+		expErr      bool
+	}{
+		{"Success case: DATE column", time.Date(2000, 7, 2, 0, 0, 0, 0, time.Local), NewDate(2000, 7, 2), false},
+		{"Success case: text column", []byte("2000-07-02"), NewDate(2000, 7, 2), false},
+		{"Success case: legacy text column", "02/07/2000", NewDate(2000, 7, 2), false},
+		{"Success case: NULL", nil, Date{}, false},
+		{"Error case: unsupported type", 42, Date{}, true},
+	}
+
+	for i, tc := range tests {
+		var d Date
+
+		err := d.Scan(tc.input)
+
+		assert.Equal(t, tc.expErr, err != nil, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, d, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDateAgeOn(t *testing.T) {
+	dob := NewDate(2000, 7, 2)
+	tests := []struct {
+		description string
+		day         time.Time
+		expAge      int
+	}{
+		{"day before birthday", time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC), 21},
+		{"on birthday", time.Date(2022, time.July, 2, 0, 0, 0, 0, time.UTC), 22},
+		{"earlier month", time.Date(2022, time.March, 30, 0, 0, 0, 0, time.UTC), 21},
+		{"later month", time.Date(2022, time.December, 1, 0, 0, 0, 0, time.UTC), 22},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expAge, dob.AgeOn(tc.day), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
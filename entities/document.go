@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Document is a file attached to a student. The contents live in blob storage under Checksum, the
+// hex encoded SHA-256 of the file, so identical files are stored once.
+type Document struct {
+	ID          uuid.UUID    `json:"id"`
+	StudentID   uuid.UUID    `json:"studentId"`
+	Kind        DocumentKind `json:"kind"`
+	FileName    string       `json:"fileName"`
+	ContentType string       `json:"contentType"`
+	Size        int64        `json:"size"`
+	Checksum    string       `json:"checksum"`
+	UploadedAt  time.Time    `json:"uploadedAt"`
+}
+
+type DocumentKind string
+
+const (
+	Resume    DocumentKind = "RESUME"
+	MarkSheet DocumentKind = "MARKSHEET"
+	OtherDoc  DocumentKind = "OTHER"
+)
+
+func IsValidDocumentKind(k DocumentKind) bool {
+	switch k {
+	case Resume, MarkSheet, OtherDoc:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,45 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Drive is a company's visit to campus. Students register for it between RegistrationOpens and
+// RegistrationCloses and go through its rounds on Date.
+type Drive struct {
+	ID                 uuid.UUID `json:"id"`
+	Comp               Company   `json:"comp"`
+	Date               Date      `json:"date"`
+	RegistrationOpens  time.Time `json:"registrationOpens"`
+	RegistrationCloses time.Time `json:"registrationCloses"`
+	Rounds             []Round   `json:"rounds,omitempty"`
+	// Branches is empty for drives open to every branch.
+	Branches []Branch `json:"branches,omitempty"`
+}
+
+// Round is one stage of a drive. Rounds are numbered from 1 in the order they are held.
+type Round struct {
+	Number int    `json:"number"`
+	Name   string `json:"name"`
+}
+
+type Registration struct {
+	DriveID      uuid.UUID `json:"driveId"`
+	StudentID    uuid.UUID `json:"studentId"`
+	RegisteredAt time.Time `json:"registeredAt"`
+}
+
+// Check returns a description of every requirement of the drive and its company st fails, or nil
+// when st may register.
+func (d *Drive) Check(st *Student) []string {
+	var failed []string
+
+	if len(d.Branches) != 0 && !containsBranch(d.Branches, st.Branch) {
+		failed = append(failed, fmt.Sprintf("branch %s is not one of %s", st.Branch, joinBranches(d.Branches)))
+	}
+
+	return append(failed, d.Comp.Check(st)...)
+}
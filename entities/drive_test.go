@@ -0,0 +1,32 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriveCheck(t *testing.T) {
+	core := Company{ID: uuid.New(), Name: "L&T", Category: CORE}
+	google := Company{ID: uuid.New(), Name: "Google", Category: DREAMIT}
+
+	tests := []struct {
+		description string
+		drive       Drive
+		student     Student
+		expRes      []string
+	}{
+		{"eligible student", Drive{Comp: core}, Student{Branch: MECH, Status: PENDING}, nil},
+		{"branch not allowed for the category", Drive{Comp: core}, Student{Branch: CSE, Status: PENDING},
+			[]string{"branch CSE is not allowed for CORE companies"}},
+		{"branch of the drive and category", Drive{Comp: google, Branches: []Branch{CSE}}, Student{Branch: ECE, Status: PENDING},
+			[]string{"branch ECE is not one of CSE", "branch ECE is not allowed for DREAM IT companies"}},
+		{"mass recruiters take every branch", Drive{Comp: Company{ID: uuid.New(), Category: MASS}},
+			Student{Branch: CIVIL, Status: PENDING}, nil},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expRes, tc.drive.Check(&tc.student), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
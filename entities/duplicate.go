@@ -0,0 +1,39 @@
+package entities
+
+import "strings"
+
+// DuplicateKey names the fields two students are compared on to decide they are the same person.
+type DuplicateKey string
+
+const (
+	PhoneKey   DuplicateKey = "phone"
+	NameDOBKey DuplicateKey = "name_dob"
+)
+
+func IsValidDuplicateKey(k DuplicateKey) bool {
+	switch k {
+	case PhoneKey, NameDOBKey:
+		return true
+	default:
+		return false
+	}
+}
+
+// Label returns the key in words, for error messages.
+func (k DuplicateKey) Label() string {
+	if k == NameDOBKey {
+		return "name and dob"
+	}
+
+	return string(k)
+}
+
+// Value returns what st is compared on for the key. Names are compared case-insensitively, as the
+// database collation does.
+func (k DuplicateKey) Value(st *Student) string {
+	if k == NameDOBKey {
+		return strings.ToLower(strings.TrimSpace(st.Name)) + "|" + st.DOB.String()
+	}
+
+	return st.Phone
+}
@@ -0,0 +1,166 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+const maxCGPA, minGraduationYear, maxGraduationYear = 10, 1950, 2100
+
+// AcademicProfile is the part of a student's record recruiters filter on.
+type AcademicProfile struct {
+	CGPA           float64 `json:"cgpa"`
+	ActiveBacklogs int     `json:"activeBacklogs"`
+	GraduationYear int     `json:"graduationYear"`
+}
+
+func (a AcademicProfile) Validate() error {
+	switch {
+	case a.CGPA < 0 || a.CGPA > maxCGPA:
+		return errors.InvalidParam{Param: "cgpa should be between 0 and 10"}
+	case a.ActiveBacklogs < 0:
+		return errors.InvalidParam{Param: "active backlogs cannot be negative"}
+	case a.GraduationYear < minGraduationYear || a.GraduationYear > maxGraduationYear:
+		return errors.InvalidParam{Param: "invalid graduation year"}
+	default:
+		return nil
+	}
+}
+
+func (a AcademicProfile) Value() (driver.Value, error) {
+	return json.Marshal(a)
+}
+
+func (a *AcademicProfile) Scan(src interface{}) error {
+	return scanJSON(src, a)
+}
+
+// EligibilityCriteria are the requirements a company sets for students. Zero values mean no
+// requirement, except for MaxBacklogs where nil means no limit and 0 means no backlogs allowed.
+type EligibilityCriteria struct {
+	MinCGPA         float64  `json:"minCgpa,omitempty"`
+	MaxBacklogs     *int     `json:"maxBacklogs,omitempty"`
+	AllowedYears    []int    `json:"allowedYears,omitempty"`
+	AllowedBranches []Branch `json:"allowedBranches,omitempty"`
+}
+
+func (c EligibilityCriteria) Validate() error {
+	if c.MinCGPA < 0 || c.MinCGPA > maxCGPA {
+		return errors.InvalidParam{Param: "minCgpa should be between 0 and 10"}
+	}
+
+	if c.MaxBacklogs != nil && *c.MaxBacklogs < 0 {
+		return errors.InvalidParam{Param: "maxBacklogs cannot be negative"}
+	}
+
+	for _, y := range c.AllowedYears {
+		if y < minGraduationYear || y > maxGraduationYear {
+			return errors.InvalidParam{Param: "invalid graduation year " + strconv.Itoa(y)}
+		}
+	}
+
+	for _, b := range c.AllowedBranches {
+		if !IsValidBranch(b) {
+			return errors.InvalidParam{Param: "this branch is not allowed"}
+		}
+	}
+
+	return nil
+}
+
+// Check returns a description of every criterion st fails, or nil when st is eligible.
+func (c EligibilityCriteria) Check(st *Student) []string {
+	var failed []string
+
+	if len(c.AllowedBranches) != 0 && !containsBranch(c.AllowedBranches, st.Branch) {
+		failed = append(failed, fmt.Sprintf("branch %s is not one of %s", st.Branch, joinBranches(c.AllowedBranches)))
+	}
+
+	needsProfile := c.MinCGPA > 0 || c.MaxBacklogs != nil || len(c.AllowedYears) != 0
+	if !needsProfile {
+		return failed
+	}
+
+	if st.Academic == nil {
+		return append(failed, "academic profile is required")
+	}
+
+	a := st.Academic
+
+	if a.CGPA < c.MinCGPA {
+		failed = append(failed, fmt.Sprintf("cgpa %.2f is below the minimum of %.2f", a.CGPA, c.MinCGPA))
+	}
+
+	if c.MaxBacklogs != nil && a.ActiveBacklogs > *c.MaxBacklogs {
+		failed = append(failed, fmt.Sprintf("%d active backlogs, at most %d allowed", a.ActiveBacklogs, *c.MaxBacklogs))
+	}
+
+	if len(c.AllowedYears) != 0 && !containsYear(c.AllowedYears, a.GraduationYear) {
+		failed = append(failed, fmt.Sprintf("graduation year %d is not one of %s", a.GraduationYear, joinYears(c.AllowedYears)))
+	}
+
+	return failed
+}
+
+func (c EligibilityCriteria) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (c *EligibilityCriteria) Scan(src interface{}) error {
+	return scanJSON(src, c)
+}
+
+// scanJSON reads a JSON column into v.
+func scanJSON(src, v interface{}) error {
+	switch data := src.(type) {
+	case []byte:
+		return json.Unmarshal(data, v)
+	case string:
+		return json.Unmarshal([]byte(data), v)
+	default:
+		return fmt.Errorf("cannot scan %T into %T", src, v)
+	}
+}
+
+func containsBranch(branches []Branch, b Branch) bool {
+	for _, v := range branches {
+		if v == b {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsYear(years []int, y int) bool {
+	for _, v := range years {
+		if v == y {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinBranches(branches []Branch) string {
+	s := make([]string, len(branches))
+	for i, b := range branches {
+		s[i] = string(b)
+	}
+
+	return strings.Join(s, ", ")
+}
+
+func joinYears(years []int) string {
+	s := make([]string, len(years))
+	for i, y := range years {
+		s[i] = strconv.Itoa(y)
+	}
+
+	return strings.Join(s, ", ")
+}
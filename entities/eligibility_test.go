@@ -0,0 +1,78 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+func TestEligibilityCheck(t *testing.T) {
+	noBacklogs := 0
+	criteria := EligibilityCriteria{MinCGPA: 7.5, MaxBacklogs: &noBacklogs, AllowedYears: []int{2023, 2024},
+		AllowedBranches: []Branch{CSE, ISE}}
+
+	tests := []struct {
+		description string
+		criteria    EligibilityCriteria
+		student     Student
+		expRes      []string
+	}{
+		{"eligible", criteria, Student{Branch: CSE, Academic: &AcademicProfile{CGPA: 8, GraduationYear: 2023}}, nil},
+		{"every criterion fails", criteria,
+			Student{Branch: MECH, Academic: &AcademicProfile{CGPA: 6.5, ActiveBacklogs: 2, GraduationYear: 2022}},
+			[]string{
+				"branch MECH is not one of CSE, ISE",
+				"cgpa 6.50 is below the minimum of 7.50",
+				"2 active backlogs, at most 0 allowed",
+				"graduation year 2022 is not one of 2023, 2024",
+			},
+		},
+		{"missing academic profile", criteria, Student{Branch: CSE}, []string{"academic profile is required"}},
+		{"branch only criteria do not need a profile", EligibilityCriteria{AllowedBranches: []Branch{ECE}},
+			Student{Branch: ECE}, nil},
+		{"no backlog limit", EligibilityCriteria{MinCGPA: 6}, Student{Academic: &AcademicProfile{CGPA: 6, ActiveBacklogs: 3}}, nil},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expRes, tc.criteria.Check(&tc.student), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestEligibilityValidate(t *testing.T) {
+	negative := -1
+	tests := []struct {
+		description string
+		criteria    EligibilityCriteria
+		expErr      error
+	}{
+		{"valid", EligibilityCriteria{MinCGPA: 7, AllowedYears: []int{2024}, AllowedBranches: []Branch{CSE}}, nil},
+		{"cgpa out of range", EligibilityCriteria{MinCGPA: 11}, errors.InvalidParam{Param: "minCgpa should be between 0 and 10"}},
+		{"negative backlogs", EligibilityCriteria{MaxBacklogs: &negative}, errors.InvalidParam{Param: "maxBacklogs cannot be negative"}},
+		{"invalid year", EligibilityCriteria{AllowedYears: []int{24}}, errors.InvalidParam{Param: "invalid graduation year 24"}},
+		{"invalid branch", EligibilityCriteria{AllowedBranches: []Branch{"ABC"}}, errors.InvalidParam{Param: "this branch is not allowed"}},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expErr, tc.criteria.Validate(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestAcademicProfileValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		profile     AcademicProfile
+		expErr      error
+	}{
+		{"valid", AcademicProfile{CGPA: 8.2, ActiveBacklogs: 1, GraduationYear: 2024}, nil},
+		{"cgpa out of range", AcademicProfile{CGPA: 10.5, GraduationYear: 2024}, errors.InvalidParam{Param: "cgpa should be between 0 and 10"}},
+		{"negative backlogs", AcademicProfile{CGPA: 8, ActiveBacklogs: -1, GraduationYear: 2024},
+			errors.InvalidParam{Param: "active backlogs cannot be negative"}},
+		{"missing graduation year", AcademicProfile{CGPA: 8}, errors.InvalidParam{Param: "invalid graduation year"}},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expErr, tc.profile.Validate(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
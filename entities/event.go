@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType names something that happened in the domain.
+type EventType string
+
+const (
+	StudentCreated       EventType = "student.created"
+	StudentStatusChanged EventType = "student.status_changed"
+	CompanyCreated       EventType = "company.created"
+	DriveScheduled       EventType = "drive.scheduled"
+	OfferIssued          EventType = "offer.issued"
+)
+
+// IsValidEventType reports whether t is one of the event types published.
+func IsValidEventType(t EventType) bool {
+	switch t {
+	case StudentCreated, StudentStatusChanged, CompanyCreated, DriveScheduled, OfferIssued:
+		return true
+	default:
+		return false
+	}
+}
+
+// Event records a change in the domain for the subscribers interested in it, such as notifications.
+// Only the payload fields of its type are set. Its ID stays the same across redeliveries, so
+// subscribers can use it to drop duplicates.
+type Event struct {
+	ID         uuid.UUID `json:"id"`
+	Type       EventType `json:"type"`
+	CollegeID  uuid.UUID `json:"collegeId"`
+	SeasonID   uuid.UUID `json:"seasonId"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Student    *Student  `json:"student,omitempty"`
+	Company    *Company  `json:"company,omitempty"`
+	// PreviousStatus is the status a student had before a status change.
+	PreviousStatus Status `json:"previousStatus,omitempty"`
+	Drive          *Drive `json:"drive,omitempty"`
+	Offer          *Offer `json:"offer,omitempty"`
+}
+
+// EventFilter selects the events of a college and season for a live stream, optionally only those
+// concerning a company or the students of a branch. The zero CompanyID and Branch match everything.
+type EventFilter struct {
+	CollegeID uuid.UUID
+	SeasonID  uuid.UUID
+	CompanyID uuid.UUID
+	Branch    Branch
+}
+
+// Match reports whether e passes f. The company of a status change is the student's company and
+// the company of an offer the offering one.
+func (f *EventFilter) Match(e *Event) bool {
+	if e.CollegeID != f.CollegeID || e.SeasonID != f.SeasonID {
+		return false
+	}
+
+	if f.Branch != "" && (e.Student == nil || e.Student.Branch != f.Branch) {
+		return false
+	}
+
+	if f.CompanyID == uuid.Nil {
+		return true
+	}
+
+	switch {
+	case e.Offer != nil:
+		return e.Offer.Comp.ID == f.CompanyID
+	case e.Student != nil:
+		return e.Student.Comp.ID == f.CompanyID
+	default:
+		return false
+	}
+}
@@ -0,0 +1,18 @@
+package entities
+
+import "time"
+
+// IdempotentRequest is a request sent with an idempotency key and, once handled, the response it
+// got. A retry with the same key gets the response again instead of repeating the request.
+type IdempotentRequest struct {
+	Key string
+	// RequestHash identifies the method, URI and body of the request, so a key reused for another
+	// request is told apart from a retry.
+	RequestHash string
+	// Status is the status code of the response, 0 while the request is being handled.
+	Status      int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
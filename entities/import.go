@@ -0,0 +1,20 @@
+package entities
+
+import "github.com/google/uuid"
+
+// ImportRow is one data row of a bulk student import. Row is the 1-based row number in the
+// uploaded sheet so the report can be matched against the source file.
+type ImportRow struct {
+	Row     int        `json:"row"`
+	Student Student    `json:"-"`
+	ID      *uuid.UUID `json:"id,omitempty"`
+	Error   string     `json:"error,omitempty"`
+}
+
+type ImportReport struct {
+	DryRun    bool        `json:"dryRun"`
+	Total     int         `json:"total"`
+	Succeeded int         `json:"succeeded"`
+	Failed    int         `json:"failed"`
+	Rows      []ImportRow `json:"rows"`
+}
@@ -0,0 +1,132 @@
+package entities
+
+import (
+	"bytes"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+// LetterTemplate is the text of an offer letter. Title and Body are Go text/template sources
+// executed against LetterData, for example "Dear {{.StudentName}},". A template with a CompanyID
+// is used for that company's offers; the template without one is the default for every other
+// company.
+type LetterTemplate struct {
+	ID        uuid.UUID  `json:"id"`
+	Name      string     `json:"name"`
+	CompanyID *uuid.UUID `json:"companyId,omitempty"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+}
+
+// LetterData holds the offer details available to letter templates, formatted for print.
+type LetterData struct {
+	StudentName string
+	Branch      Branch
+	CompanyName string
+	Role        string
+	CTC         string
+	Location    string
+	JoiningDate string
+	ExpiresOn   string
+	IssuedOn    string
+}
+
+const letterDateLayout = "2 January 2006"
+
+func NewLetterData(o *Offer, st *Student, issuedOn time.Time) LetterData {
+	return LetterData{
+		StudentName: st.Name,
+		Branch:      st.Branch,
+		CompanyName: o.Comp.Name,
+		Role:        o.Role,
+		CTC:         FormatRupees(o.CTC),
+		Location:    o.Location,
+		JoiningDate: o.JoiningDate.Format(letterDateLayout),
+		ExpiresOn:   o.ExpiresOn.Format(letterDateLayout),
+		IssuedOn:    issuedOn.Format(letterDateLayout),
+	}
+}
+
+// Validate checks that the template has a name and a body and that both title and body execute
+// against LetterData, which catches unknown fields as well as syntax errors.
+func (t *LetterTemplate) Validate() error {
+	var missing []string
+	if t.Name == "" {
+		missing = append(missing, "name")
+	}
+
+	if t.Body == "" {
+		missing = append(missing, "body")
+	}
+
+	if len(missing) != 0 {
+		return errors.MissingParam{Param: missing}
+	}
+
+	_, _, err := t.Render(&LetterData{})
+
+	return err
+}
+
+// Render executes the title and body of the template against data.
+func (t *LetterTemplate) Render(data *LetterData) (title, body string, err error) {
+	if title, err = execute("title", t.Title, data); err != nil {
+		return "", "", err
+	}
+
+	if body, err = execute("body", t.Body, data); err != nil {
+		return "", "", err
+	}
+
+	return title, body, nil
+}
+
+func execute(name, text string, data *LetterData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", errors.InvalidParam{Param: err.Error()}
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return "", errors.InvalidParam{Param: err.Error()}
+	}
+
+	return buf.String(), nil
+}
+
+// FormatRupees formats an amount with Indian digit grouping, for example 1200000 as
+// "Rs. 12,00,000".
+func FormatRupees(amount int64) string {
+	digits := strconv.FormatInt(amount, 10)
+	sign := ""
+
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	if len(digits) > 3 {
+		head, tail := digits[:len(digits)-3], digits[len(digits)-3:]
+
+		var groups []string
+		for len(head) > 2 {
+			groups = append([]string{head[len(head)-2:]}, groups...)
+			head = head[:len(head)-2]
+		}
+
+		digits = head
+		for _, g := range groups {
+			digits += "," + g
+		}
+
+		digits += "," + tail
+	}
+
+	return "Rs. " + sign + digits
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+func TestLetterTemplateValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		tmpl        LetterTemplate
+		expErr      error
+	}{
+		{"valid template", LetterTemplate{Name: "default", Title: "Offer of employment",
+			Body: "Dear {{.StudentName}},\n{{.CompanyName}} offers you {{.CTC}}."}, nil},
+		{"missing name and body", LetterTemplate{Title: "Offer"}, errors.MissingParam{Param: []string{"name", "body"}}},
+		{"unknown field", LetterTemplate{Name: "default", Body: "Dear {{.FirstName}},"},
+			errors.InvalidParam{Param: `template: body:1:7: executing "body" at <.FirstName>: ` +
+				`can't evaluate field FirstName in type *entities.LetterData`}},
+		{"syntax error", LetterTemplate{Name: "default", Title: "{{.Role", Body: "Dear"},
+			errors.InvalidParam{Param: `template: title:1: unclosed action`}},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expErr, tc.tmpl.Validate(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestLetterRender(t *testing.T) {
+	offer := Offer{Comp: Company{Name: "Google"}, Role: "SDE", CTC: 2400000, JoiningDate: NewDate(2024, 7, 1),
+		ExpiresOn: NewDate(2023, 8, 1)}
+	st := Student{Name: "Aditi", Branch: CSE}
+	tmpl := LetterTemplate{Title: "{{.CompanyName}} - {{.Role}}",
+		Body: "Dear {{.StudentName}} ({{.Branch}}), your CTC is {{.CTC}} from {{.JoiningDate}}. Reply by {{.ExpiresOn}}."}
+
+	data := NewLetterData(&offer, &st, time.Date(2023, 7, 20, 10, 0, 0, 0, time.UTC))
+	title, body, err := tmpl.Render(&data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Google - SDE", title)
+	assert.Equal(t, "Dear Aditi (CSE), your CTC is Rs. 24,00,000 from 1 July 2024. Reply by 1 August 2023.", body)
+	assert.Equal(t, "20 July 2023", data.IssuedOn)
+}
+
+func TestFormatRupees(t *testing.T) {
+	tests := map[int64]string{0: "Rs. 0", 999: "Rs. 999", 1000: "Rs. 1,000", 350000: "Rs. 3,50,000",
+		1200000: "Rs. 12,00,000", 123456789: "Rs. 12,34,56,789", -45000: "Rs. -45,000"}
+
+	for amount, exp := range tests {
+		assert.Equal(t, exp, FormatRupees(amount), "FormatRupees(%d)", amount)
+	}
+}
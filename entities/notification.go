@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Channel is the medium a notification is delivered through.
+type Channel string
+
+const (
+	EmailChannel Channel = "EMAIL"
+	SMSChannel   Channel = "SMS"
+)
+
+type NotificationStatus string
+
+// Notifications are PENDING until they are sent or run out of attempts and become FAILED.
+const (
+	NotificationPending NotificationStatus = "PENDING"
+	NotificationSent    NotificationStatus = "SENT"
+	NotificationFailed  NotificationStatus = "FAILED"
+)
+
+// Notification is a message queued for delivery to one recipient. Pending notifications are sent
+// once NextAttemptAt has passed and retried later when sending fails.
+type Notification struct {
+	ID            uuid.UUID          `json:"id"`
+	CollegeID     uuid.UUID          `json:"collegeId"`
+	Channel       Channel            `json:"channel"`
+	Recipient     string             `json:"recipient"`
+	Subject       string             `json:"subject,omitempty"`
+	Body          string             `json:"body"`
+	Status        NotificationStatus `json:"status"`
+	Attempts      int                `json:"attempts"`
+	NextAttemptAt time.Time          `json:"nextAttemptAt"`
+	LastError     string             `json:"lastError,omitempty"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	SentAt        *time.Time         `json:"sentAt,omitempty"`
+}
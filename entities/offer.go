@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Offer is a job offer made to a student by a company.
+type Offer struct {
+	ID        uuid.UUID `json:"id"`
+	StudentID uuid.UUID `json:"studentId"`
+	Comp      Company   `json:"comp"`
+	Role      string    `json:"role"`
+	// CTC is the annual cost to company in rupees.
+	CTC         int64       `json:"ctc"`
+	Location    string      `json:"location,omitempty"`
+	JoiningDate Date        `json:"joiningDate"`
+	ExpiresOn   Date        `json:"expiresOn"`
+	Status      OfferStatus `json:"status"`
+	CreatedAt   time.Time   `json:"createdAt"`
+	RespondedAt *time.Time  `json:"respondedAt,omitempty"`
+}
+
+type OfferStatus string
+
+// Offers are stored as OFFERED, ACCEPTED or DECLINED. EXPIRED is reported for OFFERED offers whose
+// expiry date has passed.
+const (
+	OfferMade     OfferStatus = "OFFERED"
+	OfferAccepted OfferStatus = "ACCEPTED"
+	OfferDeclined OfferStatus = "DECLINED"
+	OfferExpired  OfferStatus = "EXPIRED"
+)
+
+// IsExpired reports whether the offer can no longer be answered on the given day.
+func (o *Offer) IsExpired(day time.Time) bool {
+	return o.Status == OfferMade && !o.ExpiresOn.IsZero() && !day.Before(o.ExpiresOn.AddDate(0, 0, 1))
+}
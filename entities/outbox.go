@@ -0,0 +1,15 @@
+package entities
+
+import "time"
+
+// OutboxEntry is an event recorded in the outbox together with the change it describes, waiting to
+// be relayed to the sinks.
+type OutboxEntry struct {
+	Event         Event
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	// Delivered names the sinks the event has already been delivered to, which a retry skips.
+	Delivered   []string
+	PublishedAt *time.Time
+}
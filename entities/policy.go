@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+)
+
+// categoryTier ranks company categories for the placement policy. A placed student may only take
+// part in drives and accept offers of companies in a higher tier.
+//
+//nolint:gochecknoglobals // lookup table
+var categoryTier = map[Category]int{MASS: 1, CORE: 2, DREAMIT: 3, OPENDREAM: 4}
+
+func (c Category) Tier() int {
+	return categoryTier[c]
+}
+
+// PlacementPolicy returns why st may not take up an opportunity with company c, or an empty string
+// when the policy allows it. The company a student is placed with is always allowed.
+func PlacementPolicy(st *Student, c *Company) string {
+	if st.Status != ACCEPTED || st.Comp.ID == c.ID || c.Category.Tier() > st.Comp.Category.Tier() {
+		return ""
+	}
+
+	var higher []string
+
+	for _, cat := range []Category{MASS, CORE, DREAMIT, OPENDREAM} {
+		if cat.Tier() > st.Comp.Category.Tier() {
+			higher = append(higher, string(cat))
+		}
+	}
+
+	if len(higher) == 0 {
+		return fmt.Sprintf("already placed in a %s company", st.Comp.Category)
+	}
+
+	return fmt.Sprintf("already placed in a %s company, only %s companies are allowed", st.Comp.Category,
+		strings.Join(higher, ", "))
+}
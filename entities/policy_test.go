@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlacementPolicy(t *testing.T) {
+	wipro := Company{ID: uuid.New(), Name: "Wipro", Category: MASS}
+	google := Company{ID: uuid.New(), Name: "Google", Category: DREAMIT}
+	tesla := Company{ID: uuid.New(), Name: "Tesla", Category: OPENDREAM}
+
+	tests := []struct {
+		description string
+		student     Student
+		company     Company
+		expRes      string
+	}{
+		{"unplaced student", Student{Comp: tesla, Status: PENDING}, wipro, ""},
+		{"higher category", Student{Comp: wipro, Status: ACCEPTED}, google, ""},
+		{"company the student is placed with", Student{Comp: google, Status: ACCEPTED}, google, ""},
+		{"same category", Student{Comp: wipro, Status: ACCEPTED}, Company{ID: uuid.New(), Category: MASS},
+			"already placed in a MASS company, only CORE, DREAM IT, OPEN DREAM companies are allowed"},
+		{"lower category", Student{Comp: google, Status: ACCEPTED}, wipro,
+			"already placed in a DREAM IT company, only OPEN DREAM companies are allowed"},
+		{"highest category", Student{Comp: tesla, Status: ACCEPTED}, google, "already placed in a OPEN DREAM company"},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expRes, PlacementPolicy(&tc.student, &tc.company), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Recruiter is a company representative using the recruiter portal. Recruiters only see and act
+// on applicants of their own company.
+type Recruiter struct {
+	ID        uuid.UUID `json:"id"`
+	CompanyID uuid.UUID `json:"companyId"`
+	// CollegeID is the college of the company; portal requests are scoped to it.
+	CollegeID uuid.UUID `json:"collegeId"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"createdAt"`
+	// APIKey is only set in the response that creates the recruiter. Only its hash is stored.
+	APIKey string `json:"apiKey,omitempty"`
+}
+
+// Applicant is a student registered for one of a company's drives.
+type Applicant struct {
+	Student      Student   `json:"student"`
+	DriveID      uuid.UUID `json:"driveId"`
+	RegisteredAt time.Time `json:"registeredAt"`
+}
@@ -0,0 +1,51 @@
+package entities
+
+type BranchStatusCount struct {
+	Branch Branch `json:"branch"`
+	Status Status `json:"status"`
+	Count  int    `json:"count"`
+}
+
+type CategoryStatusCount struct {
+	Category Category `json:"category"`
+	Status   Status   `json:"status"`
+	Count    int      `json:"count"`
+}
+
+type BranchPlacement struct {
+	Branch     Branch  `json:"branch"`
+	Total      int     `json:"total"`
+	Placed     int     `json:"placed"`
+	Percentage float64 `json:"percentage"`
+}
+
+type RecruiterCount struct {
+	Company Company `json:"company"`
+	Placed  int     `json:"placed"`
+}
+
+// BranchCTC is the CTC of one accepted offer and the branch of the student who accepted it.
+type BranchCTC struct {
+	Branch Branch `json:"branch"`
+	CTC    int64  `json:"ctc"`
+}
+
+// CTCStats summarises the accepted offers of a branch. Average and Median are in rupees.
+type CTCStats struct {
+	Branch  Branch  `json:"branch"`
+	Offers  int     `json:"offers"`
+	Average float64 `json:"average"`
+	Median  float64 `json:"median"`
+}
+
+// PlacementSummary is the placement report. A student counts as placed when their status is ACCEPTED.
+type PlacementSummary struct {
+	TotalStudents       int                   `json:"totalStudents"`
+	PlacedStudents      int                   `json:"placedStudents"`
+	PlacementPercentage float64               `json:"placementPercentage"`
+	ByBranch            []BranchPlacement     `json:"byBranch"`
+	ByBranchStatus      []BranchStatusCount   `json:"byBranchStatus"`
+	ByCategoryStatus    []CategoryStatusCount `json:"byCategoryStatus"`
+	TopRecruiters       []RecruiterCount      `json:"topRecruiters"`
+	CTCByBranch         []CTCStats            `json:"ctcByBranch"`
+}
@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+// RoundResult is the outcome of one round of a drive for one student.
+type RoundResult struct {
+	DriveID     uuid.UUID `json:"driveId"`
+	Round       int       `json:"round"`
+	StudentID   uuid.UUID `json:"studentId"`
+	Score       *float64  `json:"score,omitempty"`
+	Passed      bool      `json:"passed"`
+	Remarks     string    `json:"remarks,omitempty"`
+	Interviewer string    `json:"interviewer,omitempty"`
+	RecordedAt  time.Time `json:"recordedAt"`
+}
+
+// NumberRounds checks every round has a name and numbers them from 1 in the given order.
+func NumberRounds(rounds []Round) error {
+	for i := range rounds {
+		if rounds[i].Name == "" {
+			return errors.MissingParam{Param: []string{"rounds.name"}}
+		}
+
+		rounds[i].Number = i + 1
+	}
+
+	return nil
+}
+
+// RoundStatus derives a student's status from their results: REJECTED once a round is failed,
+// ACCEPTED once every round is passed and PENDING while rounds remain.
+func RoundStatus(rounds []Round, results []RoundResult) Status {
+	passed := make(map[int]bool, len(results))
+	for _, r := range results {
+		passed[r.Round] = r.Passed
+	}
+
+	for _, round := range rounds {
+		ok, recorded := passed[round.Number]
+
+		switch {
+		case !recorded:
+			return PENDING
+		case !ok:
+			return REJECTED
+		}
+	}
+
+	return ACCEPTED
+}
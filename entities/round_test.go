@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+func TestRoundStatus(t *testing.T) {
+	rounds := []Round{{Number: 1, Name: "Aptitude"}, {Number: 2, Name: "Technical"}, {Number: 3, Name: "HR"}}
+
+	tests := []struct {
+		description string
+		results     []RoundResult
+		expRes      Status
+	}{
+		{"no results yet", nil, PENDING},
+		{"first round passed", []RoundResult{{Round: 1, Passed: true}}, PENDING},
+		{"second round failed", []RoundResult{{Round: 1, Passed: true}, {Round: 2}}, REJECTED},
+		{"every round passed", []RoundResult{{Round: 3, Passed: true}, {Round: 1, Passed: true}, {Round: 2, Passed: true}}, ACCEPTED},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.expRes, RoundStatus(rounds, tc.results), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestNumberRounds(t *testing.T) {
+	rounds := []Round{{Number: 7, Name: "Aptitude"}, {Name: "HR"}}
+
+	assert.NoError(t, NumberRounds(rounds))
+	assert.Equal(t, []Round{{Number: 1, Name: "Aptitude"}, {Number: 2, Name: "HR"}}, rounds)
+	assert.Equal(t, errors.MissingParam{Param: []string{"rounds.name"}}, NumberRounds([]Round{{Name: "HR"}, {}}))
+}
@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Season is a placement season of a college, usually one academic batch. Students and drives belong
+// to one season and companies take part in any number of them. The current season is the one not
+// archived yet; archived seasons stay readable but can no longer be changed.
+//
+// MinAge and AgeReferenceDate are the age students of the season must have reached and the day it
+// is computed on. A season that leaves them unset uses the defaults of the deployment.
+type Season struct {
+	ID               uuid.UUID  `json:"id"`
+	Name             string     `json:"name"`
+	MinAge           *int       `json:"minAge,omitempty"`
+	AgeReferenceDate *Date      `json:"ageReferenceDate,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	ArchivedAt       *time.Time `json:"archivedAt,omitempty"`
+}
+
+// Archived reports whether the season has been rolled over.
+func (s *Season) Archived() bool {
+	return s.ArchivedAt != nil
+}
@@ -6,10 +6,14 @@ type Student struct {
 	ID     uuid.UUID `json:"id"`
 	Name   string    `json:"name"`
 	Phone  string    `json:"phone"`
-	DOB    string    `json:"dob"`
+	DOB    Date      `json:"dob"`
 	Branch Branch    `json:"branch"`
 	Comp   Company   `json:"comp,omitempty"`
 	Status Status    `json:"status"`
+	// Academic is nil for students whose academic record has not been entered.
+	Academic *AcademicProfile `json:"academic,omitempty"`
+	// Email is where email notifications go; students without one only get SMS.
+	Email string `json:"email,omitempty"`
 }
 
 type Branch string
@@ -28,10 +32,12 @@ const (
 	ACCEPTED Status = "ACCEPTED"
 	REJECTED Status = "REJECTED"
 	PENDING  Status = "PENDING"
+	// SHORTLISTED marks an applicant a recruiter has picked for the next rounds.
+	SHORTLISTED Status = "SHORTLISTED"
 )
 
 func IsValidStatus(s Status) bool {
-	if s == ACCEPTED || s == REJECTED || s == PENDING {
+	if s == ACCEPTED || s == REJECTED || s == PENDING || s == SHORTLISTED {
 		return true
 	}
 
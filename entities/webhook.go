@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook is a subscription of an outside system, such as a college ERP, to events. Every event of
+// one of its types is POSTed to URL, signed with Secret.
+type Webhook struct {
+	ID        uuid.UUID   `json:"id"`
+	URL       string      `json:"url"`
+	Events    []EventType `json:"events"`
+	CreatedAt time.Time   `json:"createdAt"`
+	// Secret is only set in the response that creates the webhook.
+	Secret string `json:"secret,omitempty"`
+}
+
+type DeliveryStatus string
+
+// Deliveries are PENDING until the webhook answers with a 2xx status or they run out of attempts.
+const (
+	DeliveryPending   DeliveryStatus = "PENDING"
+	DeliveryDelivered DeliveryStatus = "DELIVERED"
+	DeliveryFailed    DeliveryStatus = "FAILED"
+)
+
+// Delivery is one event sent, or to be sent, to a webhook. Redelivering an event adds a delivery
+// with the same EventID, which receivers can use to drop events they already handled.
+type Delivery struct {
+	ID        uuid.UUID      `json:"id"`
+	WebhookID uuid.UUID      `json:"webhookId"`
+	EventID   uuid.UUID      `json:"eventId"`
+	EventType EventType      `json:"eventType"`
+	Payload   string         `json:"payload"`
+	Status    DeliveryStatus `json:"status"`
+	Attempts  int            `json:"attempts"`
+	// ResponseStatus is the HTTP status of the last attempt, 0 when no response was received.
+	ResponseStatus int        `json:"responseStatus,omitempty"`
+	LastError      string     `json:"lastError,omitempty"`
+	NextAttemptAt  time.Time  `json:"nextAttemptAt"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	// URL and Secret are those of the webhook, loaded for the dispatcher only.
+	URL    string `json:"-"`
+	Secret string `json:"-"`
+}
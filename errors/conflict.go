@@ -0,0 +1,9 @@
+package errors
+
+type Conflict struct {
+	Reason string `json:"reason"`
+}
+
+func (c Conflict) Error() string {
+	return "Conflict: " + c.Reason
+}
@@ -0,0 +1,13 @@
+package errors
+
+import "fmt"
+
+// Duplicate is returned when a student matches an existing one. ID is the existing student.
+type Duplicate struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+}
+
+func (d Duplicate) Error() string {
+	return fmt.Sprintf("Duplicate: student %s already exists with the same %s", d.ID, d.Key)
+}
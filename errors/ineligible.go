@@ -0,0 +1,13 @@
+package errors
+
+import "strings"
+
+// Ineligible is returned when a student does not meet the eligibility criteria of a company.
+// Criteria describes every criterion that failed.
+type Ineligible struct {
+	Criteria []string `json:"criteria"`
+}
+
+func (i Ineligible) Error() string {
+	return "Not Eligible: " + strings.Join(i.Criteria, "; ")
+}
@@ -0,0 +1,52 @@
+// Package events delivers domain events to the in-process subscribers interested in them. The bus is
+// one of the outbox sinks, so it receives every committed event at least once.
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/aditi-zs/Placement-API/entities"
+)
+
+// Handler reacts to an event. It runs on the goroutine relaying the outbox, in the college and season
+// scope of the change, so it should hand slow work off, e.g. to a queue.
+type Handler func(ctx context.Context, e *entities.Event) error
+
+// Bus is an in-process publisher delivering every event to every subscriber in subscription order.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// Subscribe registers h for every event published after it.
+func (b *Bus) Subscribe(h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers = append(b.handlers, h)
+}
+
+// Publish hands e to every subscriber, even after one fails, and returns the errors of the failing
+// ones. The outbox retries a failed publish, so subscribers may see e again and should drop events
+// whose ID they have already handled.
+func (b *Bus) Publish(ctx context.Context, e *entities.Event) error {
+	b.mu.RLock()
+	handlers := b.handlers
+	b.mu.RUnlock()
+
+	var errs []error
+
+	for _, h := range handlers {
+		if err := h(ctx, e); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,35 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+)
+
+func TestPublish(t *testing.T) {
+	bus := NewBus()
+
+	var got []string
+
+	bus.Subscribe(func(ctx context.Context, e *entities.Event) error {
+		got = append(got, "first")
+
+		return errors.New("unavailable")
+	})
+	bus.Subscribe(func(ctx context.Context, e *entities.Event) error {
+		got = append(got, "second")
+
+		return nil
+	})
+
+	e := entities.Event{ID: uuid.New(), Type: entities.DriveScheduled, Drive: &entities.Drive{ID: uuid.New()}}
+	err := bus.Publish(context.Background(), &e)
+
+	assert.Equal(t, []string{"first", "second"}, got, "a failing subscriber should not stop the others")
+	assert.EqualError(t, err, "unavailable")
+}
@@ -0,0 +1,120 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it is dropped.
+const subscriberBuffer = 64
+
+type subscriber struct {
+	filter  entities.EventFilter
+	updates chan entities.Event
+}
+
+// Stream keeps the latest student status changes and offers in a bounded buffer and fans them out
+// to live subscribers. It subscribes to a Bus with Handle. A subscriber that falls behind is dropped
+// rather than holding up the bus; it resumes from the buffer by the ID of the last event it got.
+type Stream struct {
+	mu          sync.Mutex
+	buffer      []entities.Event
+	size        int
+	subscribers map[*subscriber]struct{}
+}
+
+// NewStream returns a Stream buffering the last size events.
+func NewStream(size int) *Stream {
+	return &Stream{size: size, subscribers: make(map[*subscriber]struct{})}
+}
+
+// Handle buffers e and sends it to the subscribers it matches, if it is a status change or an offer.
+func (s *Stream) Handle(_ context.Context, e *entities.Event) error {
+	if e.Type != entities.StudentStatusChanged && e.Type != entities.OfferIssued {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.buffer) == s.size {
+		s.buffer = append(s.buffer[:0], s.buffer[1:]...)
+	}
+
+	s.buffer = append(s.buffer, *e)
+
+	for sub := range s.subscribers {
+		if !sub.filter.Match(e) {
+			continue
+		}
+
+		select {
+		case sub.updates <- *e:
+		default:
+			s.drop(sub)
+		}
+	}
+
+	return nil
+}
+
+// Subscribe returns the buffered events matching filter after the event lastID, and a channel of the
+// matching events handled from now on. Without lastID nothing is replayed; when lastID is no longer
+// buffered every buffered event is, so the subscriber may see events again and should skip the IDs it
+// has seen. The channel is closed when the subscriber falls behind or cancel is called.
+func (s *Stream) Subscribe(filter entities.EventFilter, lastID uuid.UUID) (replay []entities.Event,
+	updates <-chan entities.Event, cancel func()) {
+	sub := &subscriber{filter: filter, updates: make(chan entities.Event, subscriberBuffer)}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if lastID != uuid.Nil {
+		replay = s.since(&filter, lastID)
+	}
+
+	s.subscribers[sub] = struct{}{}
+
+	return replay, sub.updates, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.drop(sub)
+	}
+}
+
+// since returns the buffered events matching filter after lastID, or all of them when lastID is not
+// buffered.
+func (s *Stream) since(filter *entities.EventFilter, lastID uuid.UUID) []entities.Event {
+	start := 0
+
+	for i := range s.buffer {
+		if s.buffer[i].ID == lastID {
+			start = i + 1
+		}
+	}
+
+	var events []entities.Event
+
+	for i := start; i < len(s.buffer); i++ {
+		if filter.Match(&s.buffer[i]) {
+			events = append(events, s.buffer[i])
+		}
+	}
+
+	return events
+}
+
+// drop removes sub and closes its channel. It is called with mu held.
+func (s *Stream) drop(sub *subscriber) {
+	if _, ok := s.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(s.subscribers, sub)
+	close(sub.updates)
+}
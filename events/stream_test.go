@@ -0,0 +1,82 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+)
+
+func TestStreamSubscribe(t *testing.T) {
+	college, season, company := uuid.New(), uuid.New(), uuid.New()
+	cse := entities.Student{ID: uuid.New(), Branch: entities.CSE, Comp: entities.Company{ID: company}}
+	ece := entities.Student{ID: uuid.New(), Branch: entities.ECE}
+
+	changed := entities.Event{ID: uuid.New(), Type: entities.StudentStatusChanged, CollegeID: college, SeasonID: season, Student: &cse}
+	offered := entities.Event{ID: uuid.New(), Type: entities.OfferIssued, CollegeID: college, SeasonID: season, Student: &ece,
+		Offer: &entities.Offer{ID: uuid.New(), Comp: entities.Company{ID: company}}}
+	created := entities.Event{ID: uuid.New(), Type: entities.StudentCreated, CollegeID: college, SeasonID: season, Student: &cse}
+	other := entities.Event{ID: uuid.New(), Type: entities.StudentStatusChanged, CollegeID: uuid.New(), SeasonID: season, Student: &cse}
+
+	stream := NewStream(2)
+	for _, e := range []entities.Event{other, changed, created, offered} {
+		_ = stream.Handle(context.Background(), &e)
+	}
+
+	all := entities.EventFilter{CollegeID: college, SeasonID: season}
+	tests := []struct {
+		description string
+		filter      entities.EventFilter
+		lastID      uuid.UUID
+		expRes      []entities.Event
+	}{
+		{"Success case: no last event", all, uuid.Nil, nil},
+		{"Success case: resume after an event", all, changed.ID, []entities.Event{offered}},
+		{"Success case: last event no longer buffered", all, uuid.New(), []entities.Event{changed, offered}},
+		{"Success case: branch filter", entities.EventFilter{CollegeID: college, SeasonID: season, Branch: entities.ECE},
+			uuid.New(), []entities.Event{offered}},
+		{"Success case: company filter", entities.EventFilter{CollegeID: college, SeasonID: season, CompanyID: company},
+			uuid.New(), []entities.Event{changed, offered}},
+		{"Success case: other season", entities.EventFilter{CollegeID: college, SeasonID: uuid.New()}, uuid.New(), nil},
+	}
+
+	for i, tc := range tests {
+		replay, _, cancel := stream.Subscribe(tc.filter, tc.lastID)
+		cancel()
+
+		assert.Equal(t, tc.expRes, replay, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestStreamHandle(t *testing.T) {
+	college, season := uuid.New(), uuid.New()
+	cse := entities.Student{ID: uuid.New(), Branch: entities.CSE}
+	e := entities.Event{ID: uuid.New(), Type: entities.StudentStatusChanged, CollegeID: college, SeasonID: season, Student: &cse}
+
+	stream := NewStream(10)
+
+	_, updates, cancel := stream.Subscribe(entities.EventFilter{CollegeID: college, SeasonID: season}, uuid.Nil)
+	defer cancel()
+
+	_, ece, cancelECE := stream.Subscribe(entities.EventFilter{CollegeID: college, SeasonID: season, Branch: entities.ECE}, uuid.Nil)
+	defer cancelECE()
+
+	_ = stream.Handle(context.Background(), &e)
+
+	assert.Equal(t, e, <-updates, "a matching subscriber should get the event")
+	assert.Empty(t, ece, "a subscriber of another branch should not get the event")
+
+	for i := 0; i < subscriberBuffer+1; i++ {
+		_ = stream.Handle(context.Background(), &e)
+	}
+
+	received := 0
+	for range updates {
+		received++
+	}
+
+	assert.Equal(t, subscriberBuffer, received, "a subscriber that falls behind should be dropped")
+}
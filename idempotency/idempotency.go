@@ -0,0 +1,172 @@
+// Package idempotency lets clients retry POST requests safely: a request sent again with the same
+// Idempotency-Key header gets the response of the first one instead of being handled twice.
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+const (
+	Header = "Idempotency-Key"
+	// ReplayedHeader is set on responses replayed from an earlier request.
+	ReplayedHeader = "Idempotent-Replayed"
+
+	maxKeyLen = 255
+)
+
+// Config holds how long keys are kept and the largest body a request with a key may have.
+type Config struct {
+	TTL time.Duration
+	// MaxBody is the largest body hashed. Bodies are read whole before the request is handled, so it
+	// must not be smaller than the largest upload the handlers accept.
+	MaxBody int64
+}
+
+type middleware struct {
+	requests store.IdempotencyStore
+	cfg      Config
+	// now is replaced in tests to stamp a fixed time.
+	now func() time.Time
+}
+
+// Middleware makes the POST requests of a college scoped route group idempotent when they carry an
+// Idempotency-Key header. Keys are scoped to the college and to the client that sent them, so a
+// recruiter cannot replay the response to a request of the college or another recruiter. A key reused for another request gets
+// 422, and a retry while the first request is still being handled gets 409. Responses with a 5xx
+// status are not kept, so the request can be retried with the same key.
+func Middleware(requests store.IdempotencyStore, cfg Config) func(http.Handler) http.Handler {
+	m := middleware{requests: requests, cfg: cfg, now: time.Now}
+
+	return m.handle
+}
+
+func (m middleware) handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(Header)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if len(key) > maxKeyLen {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(errors.InvalidParam{Param: Header}.Error()))
+
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, m.cfg.MaxBody+1))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		if int64(len(body)) > m.cfg.MaxBody {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			_, _ = w.Write([]byte("request body too large"))
+
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := m.now().UTC().Truncate(time.Second)
+		req := entities.IdempotentRequest{Key: key, RequestHash: hash(r, body), CreatedAt: now, ExpiresAt: now.Add(m.cfg.TTL)}
+
+		if err = m.requests.Create(r.Context(), &req); err != nil {
+			m.replay(w, r, &req, err)
+
+			return
+		}
+
+		rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		if rec.status >= http.StatusInternalServerError {
+			err = m.requests.Delete(r.Context(), key)
+		} else {
+			req.Status, req.ContentType, req.Body = rec.status, w.Header().Get("Content-Type"), rec.body.Bytes()
+			err = m.requests.Update(r.Context(), &req)
+		}
+
+		if err != nil {
+			log.Printf("idempotency: %v", err)
+		}
+	})
+}
+
+// replay answers a request whose key could not be recorded because of err: with the response of
+// the earlier request sent with the key, or with why it cannot be replayed.
+func (m middleware) replay(w http.ResponseWriter, r *http.Request, req *entities.IdempotentRequest, err error) {
+	if _, ok := err.(errors.Conflict); !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+
+		return
+	}
+
+	stored, err := m.requests.GetByKey(r.Context(), req.Key)
+
+	// A key that cannot be read any more has expired or was freed by a failed request since then, so
+	// the client may retry it like one in progress.
+	switch {
+	case err == nil && stored.RequestHash != req.RequestHash:
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte("Idempotency-Key already used for a different request"))
+	case err != nil || stored.Status == 0:
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte("a request with this Idempotency-Key is in progress"))
+	default:
+		if stored.ContentType != "" {
+			w.Header().Set("Content-Type", stored.ContentType)
+		}
+
+		w.Header().Set(ReplayedHeader, "true")
+		w.WriteHeader(stored.Status)
+		_, _ = w.Write(stored.Body)
+	}
+}
+
+// hash returns the hex encoded SHA-256 of the client, method, URI and body of r. The URI carries the
+// season query parameter, so the same body sent to another season is another request.
+func hash(r *http.Request, body []byte) string {
+	principal, _ := auth.PrincipalFrom(r.Context())
+
+	h := sha256.New()
+	_, _ = io.WriteString(h, principal+"\n"+r.Method+" "+r.URL.RequestURI()+"\n")
+	_, _ = h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recorder passes a response through while keeping its status and body.
+type recorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	rec.body.Write(b)
+
+	return rec.ResponseWriter.Write(b)
+}
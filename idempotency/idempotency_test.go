@@ -0,0 +1,102 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func TestMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRequests := store.NewMockIdempotencyStore(ctrl)
+
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	body := `{"name":"Zopsmart","category":"DREAM IT"}`
+	// Requests are sent by the college. other is the request one of its recruiters sent with the key.
+	ctx := auth.WithPrincipal(context.TODO(), "college:1")
+	reqHash := hash(httptest.NewRequest("POST", "/companies", nil).WithContext(ctx), []byte(body))
+	pending := entities.IdempotentRequest{Key: "retry-1", RequestHash: reqHash, CreatedAt: now, ExpiresAt: now.Add(time.Hour)}
+	done := pending
+	done.Status, done.ContentType, done.Body = 201, "application/json", []byte(`{"id":"1"}`)
+	other := done
+	other.RequestHash = hash(httptest.NewRequest("POST", "/companies", nil).WithContext(auth.WithPrincipal(ctx, "recruiter:1")),
+		[]byte(body))
+	taken := errors.Conflict{Reason: "idempotency key already used"}
+
+	tests := []struct {
+		description string
+		method      string
+		key         string
+		body        string
+		status      int
+		mock        func()
+		expCode     int
+		expBody     string
+		expCalls    int
+	}{
+		{"Success case: first request", "POST", "retry-1", body, 201, func() {
+			mockRequests.EXPECT().Create(gomock.Any(), &pending).Return(nil)
+			mockRequests.EXPECT().Update(gomock.Any(), &done).Return(nil)
+		}, 201, `{"id":"1"}`, 1},
+		{"Success case: retry is replayed", "POST", "retry-1", body, 201, func() {
+			mockRequests.EXPECT().Create(gomock.Any(), &pending).Return(taken)
+			mockRequests.EXPECT().GetByKey(gomock.Any(), "retry-1").Return(done, nil)
+		}, 201, `{"id":"1"}`, 0},
+		{"Success case: no key", "POST", "", body, 201, func() {}, 201, `{"id":"1"}`, 1},
+		{"Success case: not a POST", "PUT", "retry-1", body, 200, func() {}, 200, `{"id":"1"}`, 1},
+		{"Success case: server error frees the key", "POST", "retry-1", body, 500, func() {
+			mockRequests.EXPECT().Create(gomock.Any(), &pending).Return(nil)
+			mockRequests.EXPECT().Delete(gomock.Any(), "retry-1").Return(nil)
+		}, 500, `{"id":"1"}`, 1},
+		{"Error case: key reused with another body", "POST", "retry-1", `{"name":"Other"}`, 201, func() {
+			mockRequests.EXPECT().Create(gomock.Any(), gomock.Any()).Return(taken)
+			mockRequests.EXPECT().GetByKey(gomock.Any(), "retry-1").Return(done, nil)
+		}, 422, "Idempotency-Key already used for a different request", 0},
+		{"Error case: key used by another client", "POST", "retry-1", body, 201, func() {
+			mockRequests.EXPECT().Create(gomock.Any(), &pending).Return(taken)
+			mockRequests.EXPECT().GetByKey(gomock.Any(), "retry-1").Return(other, nil)
+		}, 422, "Idempotency-Key already used for a different request", 0},
+		{"Error case: first request in progress", "POST", "retry-1", body, 201, func() {
+			mockRequests.EXPECT().Create(gomock.Any(), &pending).Return(taken)
+			mockRequests.EXPECT().GetByKey(gomock.Any(), "retry-1").Return(pending, nil)
+		}, 409, "a request with this Idempotency-Key is in progress", 0},
+		{"Error case: key too long", "POST", strings.Repeat("k", 256), body, 201, func() {}, 400,
+			"Invalid Parameter: Idempotency-Key", 0},
+		{"Error case: body too large", "POST", "retry-1", strings.Repeat("x", 65), 201, func() {}, 413, "request body too large", 0},
+	}
+
+	for i, tc := range tests {
+		calls := 0
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(tc.status)
+			_, _ = w.Write([]byte(`{"id":"1"}`))
+		})
+
+		tc.mock()
+
+		req := httptest.NewRequest(tc.method, "/companies", strings.NewReader(tc.body)).WithContext(ctx)
+		req.Header.Set(Header, tc.key)
+		resRec := httptest.NewRecorder()
+
+		m := middleware{requests: mockRequests, cfg: Config{TTL: time.Hour, MaxBody: 64}, now: func() time.Time { return now }}
+		m.handle(next).ServeHTTP(resRec, req)
+
+		assert.Equal(t, tc.expCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expBody, resRec.Body.String(), "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expCalls, calls, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
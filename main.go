@@ -1,52 +1,214 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"os"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/gorilla/mux"
 
-	companyHandler "github.com/aditi-zs/Placement-API/delivery/company"
+	"github.com/aditi-zs/Placement-API/api"
+	"github.com/aditi-zs/Placement-API/blob"
+	"github.com/aditi-zs/Placement-API/cache"
+	"github.com/aditi-zs/Placement-API/config"
+	documentHandler "github.com/aditi-zs/Placement-API/delivery/document"
 	studentHandler "github.com/aditi-zs/Placement-API/delivery/student"
 	"github.com/aditi-zs/Placement-API/driver"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/events"
+	idempotencyKeys "github.com/aditi-zs/Placement-API/idempotency"
+	"github.com/aditi-zs/Placement-API/notify"
+	"github.com/aditi-zs/Placement-API/openapi"
+	outboxRelay "github.com/aditi-zs/Placement-API/outbox"
+	"github.com/aditi-zs/Placement-API/ratelimit"
+	collegeService "github.com/aditi-zs/Placement-API/service/college"
 	companyService "github.com/aditi-zs/Placement-API/service/company"
+	documentService "github.com/aditi-zs/Placement-API/service/document"
+	driveService "github.com/aditi-zs/Placement-API/service/drive"
+	letterService "github.com/aditi-zs/Placement-API/service/letter"
+	offerService "github.com/aditi-zs/Placement-API/service/offer"
+	recruiterService "github.com/aditi-zs/Placement-API/service/recruiter"
+	reportService "github.com/aditi-zs/Placement-API/service/report"
+	seasonService "github.com/aditi-zs/Placement-API/service/season"
 	studentService "github.com/aditi-zs/Placement-API/service/student"
+	webhookService "github.com/aditi-zs/Placement-API/service/webhook"
+	"github.com/aditi-zs/Placement-API/store/college"
 	"github.com/aditi-zs/Placement-API/store/company"
+	"github.com/aditi-zs/Placement-API/store/document"
+	"github.com/aditi-zs/Placement-API/store/drive"
+	"github.com/aditi-zs/Placement-API/store/idempotency"
+	"github.com/aditi-zs/Placement-API/store/letter"
+	"github.com/aditi-zs/Placement-API/store/notification"
+	"github.com/aditi-zs/Placement-API/store/offer"
+	"github.com/aditi-zs/Placement-API/store/outbox"
+	"github.com/aditi-zs/Placement-API/store/recruiter"
+	"github.com/aditi-zs/Placement-API/store/report"
+	"github.com/aditi-zs/Placement-API/store/season"
 	"github.com/aditi-zs/Placement-API/store/student"
+	"github.com/aditi-zs/Placement-API/store/webhook"
+	"github.com/aditi-zs/Placement-API/webhooks"
 )
 
 func main() {
-	db, err := driver.DBConnection("mysql", "root:password@tcp(localhost:3306)/placement")
+	cfg, err := config.Load()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	db, err := driver.DBConnection("mysql", "root:password@tcp(localhost:3306)/placement?parseTime=true")
 
 	if err != nil {
 		log.Println(err)
 		return
 	}
 
-	companyStore := company.New(db)
-	studentStore := student.New(db)
+	collegeStore := college.New(db)
+	seasonStore := season.New(db)
+	// Companies are read on every student write and rarely change, so their lookups are cached. The
+	// hit and miss counts are published with the other runtime metrics on /debug/vars.
+	companyCache := cache.NewCompanies(cache.NewLRU(cfg.CompanyCache.Size), cfg.CompanyCache.TTL)
+	expvar.Publish("companyCache", expvar.Func(func() interface{} { return companyCache.Stats() }))
 
-	svcCmp := companyService.New(companyStore)
-	svcStu := studentService.New(studentStore)
+	companyStore := companyCache.CompanyStore(company.New(db))
+	studentStore := companyCache.StudentStore(student.New(db))
+	driveStore := drive.New(db)
+	offerStore := offer.New(db)
+	letterStore := letter.New(db)
+	documentStore := document.New(db)
+	recruiterStore := recruiter.New(db)
+	notificationStore := notification.New(db)
+	webhookStore := webhook.New(db)
+	outboxStore := outbox.New(db)
+	idempotencyStore := idempotency.New(db)
 
-	cmpHandler := companyHandler.New(svcCmp)
-	stuHandler := studentHandler.New(svcStu)
+	blobs, err := blob.NewDisk(cfg.DocumentDir)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	reportStore := report.New(db)
+
+	senders, err := newSenders(&cfg.Notify)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	// The stores write domain events to the outbox along with their changes and the relay hands them to
+	// the sinks: the notification queue, the webhook deliveries, in-process subscribers of the bus and
+	// optionally an event log. The live event stream subscribes to the bus.
+	bus := events.NewBus()
+	stream := events.NewStream(streamBufferSize)
+	bus.Subscribe(stream.Handle)
+
+	relay := outboxRelay.NewRelay(outboxStore, outboxRelay.RelayConfig{
+		Interval:  cfg.Outbox.PollInterval,
+		BatchSize: outboxBatchSize,
+		Backoff:   cfg.Outbox.Backoff,
+	})
+	relay.Register("notifications", outboxRelay.SinkFunc(notify.NewNotifier(notificationStore, studentStore).Handle))
+	relay.Register("webhooks", outboxRelay.SinkFunc(webhooks.NewPublisher(webhookStore).Handle))
+	relay.Register("bus", outboxRelay.SinkFunc(bus.Publish))
+
+	if cfg.Outbox.LogFile != "" {
+		f, err := os.OpenFile(cfg.Outbox.LogFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+
+		relay.Register("log", outboxRelay.NewLog(f))
+	}
+
+	go relay.Run(context.Background())
+
+	dispatcher := notify.NewDispatcher(notificationStore, senders, notify.DispatchConfig{
+		Interval:    cfg.Notify.PollInterval,
+		BatchSize:   notifyBatchSize,
+		Concurrency: dispatchConcurrency,
+		Lease:       dispatchLease,
+		MaxAttempts: cfg.Notify.MaxAttempts,
+		Backoff:     cfg.Notify.Backoff,
+	})
+	go dispatcher.Run(context.Background())
+
+	hookDispatcher := webhooks.NewDispatcher(webhookStore, webhooks.DispatchConfig{
+		Interval:    cfg.Webhooks.PollInterval,
+		BatchSize:   webhookBatchSize,
+		Concurrency: dispatchConcurrency,
+		Lease:       dispatchLease,
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		Backoff:     cfg.Webhooks.Backoff,
+	})
+	go hookDispatcher.Run(context.Background())
+
+	svcCollege := collegeService.New(collegeStore)
+	svcSeason := seasonService.New(seasonStore)
+	svcCmp := companyService.New(companyStore)
+	svcDocument := documentService.New(documentStore, studentStore, blobs, documentService.Config{MaxSize: cfg.MaxDocumentSize})
+	svcStu := studentService.New(studentStore, seasonStore, svcDocument, studentService.Config{
+		MinAge:           cfg.MinAge,
+		AgeReferenceDate: cfg.AgeReferenceDate,
+		PhoneRegion:      cfg.PhoneRegion,
+		DuplicateKeys:    cfg.DuplicateKeys,
+	})
+	svcDrive := driveService.New(driveStore, studentStore)
+	svcOffer := offerService.New(offerStore, studentStore, letterStore)
+	svcLetter := letterService.New(letterStore, studentStore)
+	svcReport := reportService.New(reportStore)
+	svcWebhook := webhookService.New(webhookStore)
+	svcRecruiter := recruiterService.New(recruiterStore, companyStore, driveStore, svcStu, svcDrive)
 
 	router := mux.NewRouter()
-	router.HandleFunc("/companies", cmpHandler.Get).Methods("GET")
-	router.HandleFunc("/companies/{id}", cmpHandler.GetByID).Methods("GET")
-	router.HandleFunc("/companies", cmpHandler.Create).Methods("POST")
-	router.HandleFunc("/companies/{id}", cmpHandler.Update).Methods("PUT")
-	router.HandleFunc("/companies/{id}", cmpHandler.Delete).Methods("DELETE")
-
-	router.HandleFunc("/students", stuHandler.Get).Methods("GET")
-	router.HandleFunc("/students/{id}", stuHandler.GetByID).Methods("GET")
-	router.HandleFunc("/students", stuHandler.Create).Methods("POST")
-	router.HandleFunc("/students/{id}", stuHandler.Update).Methods("PUT")
-	router.HandleFunc("/students/{id}", stuHandler.Delete).Methods("DELETE")
+	router.Handle("/debug/vars", expvar.Handler()).Methods("GET")
+
+	// Requests are checked against api/openapi.json before they are handled. It documents every route,
+	// which the drift test checks, and is served with Swagger UI on top.
+	spec, err := openapi.Load(api.Spec)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	router.Handle("/openapi.json", spec.Handler()).Methods("GET")
+	router.Handle("/docs", http.RedirectHandler("/docs/", http.StatusMovedPermanently)).Methods("GET")
+	router.PathPrefix("/docs/").Handler(http.StripPrefix("/docs/", openapi.Docs("/openapi.json"))).Methods("GET")
+
+	// POST requests of a college may carry an Idempotency-Key header to be retried safely. Bodies are
+	// hashed whole, so the middleware reads up to the largest upload the handlers accept.
+	maxBody := int64(studentHandler.MaxImportSize)
+	if docBody := cfg.MaxDocumentSize + documentHandler.FormOverhead; docBody > maxBody {
+		maxBody = docBody
+	}
+
+	addRoutes(router, &services{
+		College:   svcCollege,
+		Season:    svcSeason,
+		Company:   svcCmp,
+		Student:   svcStu,
+		Document:  svcDocument,
+		Drive:     svcDrive,
+		Offer:     svcOffer,
+		Letter:    svcLetter,
+		Report:    svcReport,
+		Recruiter: svcRecruiter,
+		Webhook:   svcWebhook,
+		Event:     stream,
+	}, &routeConfig{
+		OperatorKey:     cfg.OperatorKey,
+		RateLimit:       cfg.RateLimit,
+		MaxDocumentSize: cfg.MaxDocumentSize,
+		Limiter:         ratelimit.NewMemory(),
+		Validate:        spec.Middleware(openapi.Config{Responses: cfg.ValidateResponses}),
+		Idempotent:      idempotencyKeys.Middleware(idempotencyStore, idempotencyKeys.Config{TTL: cfg.IdempotencyTTL, MaxBody: maxBody}),
+	})
 
 	const timeoutVar = 3
 
@@ -59,3 +221,44 @@ func main() {
 	fmt.Println("server at port 8080")
 	log.Fatal(server.ListenAndServe())
 }
+
+const notifyBatchSize, webhookBatchSize, outboxBatchSize = 100, 100, 100
+
+// The dispatchers send to the same webhook URL or through the same notification channel at most
+// dispatchConcurrency at a time. A batch of 100 to one slow webhook takes 25 rounds of requests
+// timing out after 10s, well within the lease keeping claimed rows from other dispatchers.
+const dispatchConcurrency, dispatchLease = 4, 10 * time.Minute
+
+// streamBufferSize is how many recent events a reconnecting stream client can resume from.
+const streamBufferSize = 1000
+
+// newSenders returns the sender of each notification channel. Channels without a server configured
+// are written to the notification log.
+func newSenders(cfg *config.NotifyConfig) (map[entities.Channel]notify.Sender, error) {
+	var logOut io.Writer = os.Stdout
+
+	if cfg.LogFile != "" {
+		f, err := os.OpenFile(cfg.LogFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, err
+		}
+
+		logOut = f
+	}
+
+	senders := map[entities.Channel]notify.Sender{
+		entities.EmailChannel: notify.NewLog(logOut, string(entities.EmailChannel)),
+		entities.SMSChannel:   notify.NewLog(logOut, string(entities.SMSChannel)),
+	}
+
+	if cfg.SMTPHost != "" {
+		senders[entities.EmailChannel] = notify.NewSMTP(notify.SMTPConfig{Host: cfg.SMTPHost, Port: cfg.SMTPPort, From: cfg.SMTPFrom,
+			Username: cfg.SMTPUsername, Password: cfg.SMTPPassword})
+	}
+
+	if cfg.SMSURL != "" {
+		senders[entities.SMSChannel] = notify.NewSMS(cfg.SMSURL, cfg.SMSKey)
+	}
+
+	return senders, nil
+}
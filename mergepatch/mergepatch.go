@@ -0,0 +1,69 @@
+package mergepatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+)
+
+// Apply applies a JSON Merge Patch (RFC 7396) to doc and returns the merged document.
+func Apply(doc, patch []byte) ([]byte, error) {
+	var patchVal interface{}
+	if err := decode(patch, &patchVal); err != nil {
+		return nil, err
+	}
+
+	var docVal interface{}
+	if len(bytes.TrimSpace(doc)) != 0 {
+		if err := decode(doc, &docVal); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(merge(docVal, patchVal))
+}
+
+// IsContentType reports whether contType is acceptable for a JSON Merge Patch body. Plain
+// application/json is accepted as well since most clients send it by default.
+func IsContentType(contType string) bool {
+	if contType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contType)
+	if err != nil {
+		return false
+	}
+
+	return mediaType == "application/merge-patch+json" || mediaType == "application/json"
+}
+
+func merge(target, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = make(map[string]interface{})
+	}
+
+	for key, val := range patchObj {
+		if val == nil {
+			delete(targetObj, key)
+			continue
+		}
+
+		targetObj[key] = merge(targetObj[key], val)
+	}
+
+	return targetObj
+}
+
+func decode(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
@@ -0,0 +1,59 @@
+package mergepatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		description string
+		doc         string
+		patch       string
+		expRes      string
+		expErr      bool
+	}{
+		{"Success case: replace a member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`, false},
+		{"Success case: add a member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`, false},
+		{"Success case: null removes a member", `{"a":"b"}`, `{"a":null}`, `{}`, false},
+		{"Success case: null removes only that member", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`, false},
+		{"Success case: arrays are replaced", `{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`, false},
+		{"Success case: nested objects are merged", `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`, false},
+		{"Success case: non object target", `["c"]`, `{"a":"b"}`, `{"a":"b"}`, false},
+		{"Success case: non object patch", `{"a":"foo"}`, `"bar"`, `"bar"`, false},
+		{"Success case: numbers are preserved", `{"a":1}`, `{"b":12345678901234567890}`, `{"a":1,"b":12345678901234567890}`, false},
+		{"Success case: empty document", ``, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`, false},
+		{"Error case: invalid patch", `{"a":"b"}`, `{"a":`, ``, true},
+		{"Error case: invalid document", `{"a":`, `{"a":"b"}`, ``, true},
+	}
+
+	for i, tc := range tests {
+		output, err := Apply([]byte(tc.doc), []byte(tc.patch))
+
+		assert.Equal(t, tc.expErr, err != nil, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if !tc.expErr {
+			assert.JSONEq(t, tc.expRes, string(output), "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestIsContentType(t *testing.T) {
+	tests := []struct {
+		description string
+		contType    string
+		exp         bool
+	}{
+		{"Success case: merge patch", "application/merge-patch+json", true},
+		{"Success case: plain json with charset", "application/json; charset=utf-8", true},
+		{"Success case: none sent", "", true},
+		{"Error case: json patch", "application/json-patch+json", false},
+		{"Error case: form", "application/x-www-form-urlencoded", false},
+		{"Error case: unparsable", "application/json; charset", false},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.exp, IsContentType(tc.contType), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,4 @@
+-- Convert students.dob from DD/MM/YYYY text to a DATE column.
+UPDATE students SET dob = DATE_FORMAT(STR_TO_DATE(dob, '%d/%m/%Y'), '%Y-%m-%d') WHERE dob LIKE '__/__/____';
+
+ALTER TABLE students MODIFY dob DATE NOT NULL;
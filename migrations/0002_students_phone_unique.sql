@@ -0,0 +1,96 @@
+-- Store phone numbers in E.164 and allow a number to be registered only once.
+--
+-- Existing numbers are normalized with the rules of phone.Normalize. Numbers written without a
+-- country code are read as numbers of the region set below, which must be the PHONE_DEFAULT_REGION
+-- of the deployment (IN unless configured). No student is changed when a number cannot be normalized
+-- or two students end up with the same number: the migration lists them, keeps them in
+-- students_phone_conflicts and stops with an error. Correct or remove those students and run the
+-- migration again. It uses DELIMITER, so run it with the mysql client.
+SET @region_code = '91', @region_min_len = 10, @region_max_len = 10;
+
+DROP TABLE IF EXISTS students_phone, phone_plans, students_phone_conflicts;
+DROP PROCEDURE IF EXISTS check_students_phone;
+
+-- The numbering plans of phone.plans: numbers with one of these country codes must have a national
+-- number of an allowed length, numbers with other codes are accepted as is.
+CREATE TABLE phone_plans (
+    code    VARCHAR(3) NOT NULL PRIMARY KEY,
+    min_len INT        NOT NULL,
+    max_len INT        NOT NULL
+);
+
+INSERT INTO phone_plans VALUES ('91', 10, 10), ('1', 10, 10), ('44', 10, 10), ('61', 9, 9), ('65', 8, 8), ('971', 8, 9),
+    ('49', 6, 13);
+
+-- Numbers starting with + or 00 are international. Spaces, dashes, dots and brackets are dropped and
+-- any other character makes the number invalid.
+CREATE TABLE students_phone AS
+SELECT student_id, student_phone, international,
+       REGEXP_REPLACE(body, '[ .()-]', '') AS digits,
+       COALESCE(body REGEXP '^[0-9 .()-]*[0-9][0-9 .()-]*$', FALSE) AS well_formed
+FROM (
+    SELECT student_id, student_phone,
+           phone LIKE '+%' OR phone LIKE '00%' AS international,
+           CASE
+               WHEN phone LIKE '+%' THEN SUBSTRING(phone, 2)
+               WHEN phone LIKE '00%' THEN SUBSTRING(phone, 3)
+               ELSE phone
+           END AS body
+    FROM (SELECT student_id, student_phone, REGEXP_REPLACE(student_phone, '^[[:space:]]+|[[:space:]]+$', '') AS phone FROM students) s
+) split;
+
+ALTER TABLE students_phone ADD normalized VARCHAR(16) NULL;
+
+-- National numbers drop one leading 0 and the country code numbers were accepted with before, such
+-- as 916388768118, and then need the length of the region.
+UPDATE students_phone SET digits = SUBSTRING(digits, 2) WHERE well_formed AND NOT international AND digits LIKE '0%';
+
+UPDATE students_phone SET digits = SUBSTRING(digits, LENGTH(@region_code) + 1)
+WHERE well_formed AND NOT international AND LENGTH(digits) > @region_max_len AND digits LIKE CONCAT(@region_code, '%');
+
+UPDATE students_phone SET normalized = CONCAT('+', @region_code, digits)
+WHERE well_formed AND NOT international AND LENGTH(digits) BETWEEN @region_min_len AND @region_max_len;
+
+-- International numbers have 8 to 15 digits, and the length of their plan when the code is known.
+UPDATE students_phone SET normalized = CONCAT('+', digits)
+WHERE well_formed AND international AND LENGTH(digits) BETWEEN 8 AND 15
+  AND (EXISTS (SELECT 1 FROM phone_plans p
+               WHERE digits LIKE CONCAT(p.code, '%') AND LENGTH(digits) - LENGTH(p.code) BETWEEN p.min_len AND p.max_len)
+       OR NOT EXISTS (SELECT 1 FROM phone_plans p WHERE digits LIKE CONCAT(p.code, '%')));
+
+CREATE TABLE students_phone_conflicts AS
+SELECT student_id, student_phone, CAST('not a valid phone number' AS CHAR(64)) AS reason
+FROM students_phone
+WHERE normalized IS NULL
+UNION ALL
+SELECT p.student_id, p.student_phone, CONCAT('same number as another student: ', p.normalized)
+FROM students_phone p
+JOIN (SELECT normalized FROM students_phone WHERE normalized IS NOT NULL GROUP BY normalized HAVING COUNT(*) > 1) d
+    ON d.normalized = p.normalized;
+
+DELIMITER //
+CREATE PROCEDURE check_students_phone()
+BEGIN
+    DECLARE conflicts INT;
+    DECLARE message VARCHAR(128);
+
+    SELECT COUNT(*) INTO conflicts FROM students_phone_conflicts;
+
+    IF conflicts > 0 THEN
+        SELECT * FROM students_phone_conflicts ORDER BY reason, student_phone;
+
+        SET message = CONCAT(conflicts, ' students have invalid or shared phone numbers, see students_phone_conflicts');
+        SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = message;
+    END IF;
+END //
+DELIMITER ;
+
+CALL check_students_phone();
+
+UPDATE students s JOIN students_phone p ON p.student_id = s.student_id SET s.student_phone = p.normalized;
+
+DROP PROCEDURE check_students_phone;
+DROP TABLE students_phone, phone_plans, students_phone_conflicts;
+
+ALTER TABLE students MODIFY student_phone VARCHAR(16) NOT NULL;
+ALTER TABLE students ADD CONSTRAINT uq_students_phone UNIQUE (student_phone);
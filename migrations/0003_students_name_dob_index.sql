@@ -0,0 +1,3 @@
+-- Duplicate detection looks students up by name and dob. student_name uses the default
+-- case-insensitive collation, so names differing only in case match.
+CREATE INDEX idx_students_name_dob ON students (student_name, dob);
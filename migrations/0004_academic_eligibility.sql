@@ -0,0 +1,4 @@
+-- Academic profiles and eligibility criteria are small documents read and written as a
+-- whole, so they are stored as JSON. NULL means no profile / no criteria.
+ALTER TABLE students ADD COLUMN academic JSON NULL;
+ALTER TABLE companies ADD COLUMN eligibility JSON NULL;
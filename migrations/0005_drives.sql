@@ -0,0 +1,28 @@
+-- Placement drives, their rounds and student registrations. The connection must use
+-- parseTime=true so the registration window columns scan into time.Time.
+CREATE TABLE drives (
+    drive_id            VARCHAR(36) NOT NULL PRIMARY KEY,
+    company_id          VARCHAR(36) NOT NULL,
+    drive_date          DATE        NOT NULL,
+    registration_opens  DATETIME    NOT NULL,
+    registration_closes DATETIME    NOT NULL,
+    branches            VARCHAR(64) NOT NULL DEFAULT '',
+    FOREIGN KEY (company_id) REFERENCES companies (company_id)
+);
+
+CREATE TABLE drive_rounds (
+    drive_id     VARCHAR(36) NOT NULL,
+    round_number INT         NOT NULL,
+    round_name   VARCHAR(64) NOT NULL,
+    PRIMARY KEY (drive_id, round_number),
+    FOREIGN KEY (drive_id) REFERENCES drives (drive_id) ON DELETE CASCADE
+);
+
+CREATE TABLE drive_registrations (
+    drive_id      VARCHAR(36) NOT NULL,
+    student_id    VARCHAR(36) NOT NULL,
+    registered_at DATETIME    NOT NULL,
+    PRIMARY KEY (drive_id, student_id),
+    FOREIGN KEY (drive_id) REFERENCES drives (drive_id) ON DELETE CASCADE,
+    FOREIGN KEY (student_id) REFERENCES students (student_id) ON DELETE CASCADE
+);
@@ -0,0 +1,23 @@
+-- Default rounds per company, copied into drives created without rounds, and per-student round
+-- results. Results go with their round when a drive's rounds are trimmed.
+CREATE TABLE company_rounds (
+    company_id   VARCHAR(36) NOT NULL,
+    round_number INT         NOT NULL,
+    round_name   VARCHAR(64) NOT NULL,
+    PRIMARY KEY (company_id, round_number),
+    FOREIGN KEY (company_id) REFERENCES companies (company_id) ON DELETE CASCADE
+);
+
+CREATE TABLE round_results (
+    drive_id     VARCHAR(36)   NOT NULL,
+    round_number INT           NOT NULL,
+    student_id   VARCHAR(36)   NOT NULL,
+    score        DECIMAL(6, 2) NULL,
+    passed       BOOLEAN       NOT NULL,
+    remarks      TEXT          NULL,
+    interviewer  VARCHAR(100)  NULL,
+    recorded_at  DATETIME      NOT NULL,
+    PRIMARY KEY (drive_id, round_number, student_id),
+    FOREIGN KEY (drive_id, round_number) REFERENCES drive_rounds (drive_id, round_number) ON DELETE CASCADE,
+    FOREIGN KEY (student_id) REFERENCES students (student_id) ON DELETE CASCADE
+);
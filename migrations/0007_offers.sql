@@ -0,0 +1,18 @@
+-- Job offers. Only OFFERED, ACCEPTED and DECLINED are stored; an OFFERED offer past expires_on is
+-- reported as EXPIRED.
+CREATE TABLE offers (
+    offer_id     VARCHAR(36)  NOT NULL PRIMARY KEY,
+    student_id   VARCHAR(36)  NOT NULL,
+    company_id   VARCHAR(36)  NOT NULL,
+    role         VARCHAR(100) NOT NULL,
+    ctc          BIGINT       NOT NULL,
+    location     VARCHAR(100) NOT NULL DEFAULT '',
+    joining_date DATE         NOT NULL,
+    expires_on   DATE         NOT NULL,
+    status       VARCHAR(16)  NOT NULL,
+    created_at   DATETIME     NOT NULL,
+    responded_at DATETIME     NULL,
+    INDEX offers_student (student_id),
+    FOREIGN KEY (student_id) REFERENCES students (student_id) ON DELETE CASCADE,
+    FOREIGN KEY (company_id) REFERENCES companies (company_id)
+);
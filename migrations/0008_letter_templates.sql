@@ -0,0 +1,13 @@
+-- Offer letter templates. company_key makes the template of each company, and the default
+-- template with no company, unique.
+CREATE TABLE letter_templates (
+    template_id VARCHAR(36)  NOT NULL PRIMARY KEY,
+    name        VARCHAR(100) NOT NULL,
+    company_id  VARCHAR(36)  NULL,
+    title       VARCHAR(255) NOT NULL DEFAULT '',
+    body        TEXT         NOT NULL,
+    updated_at  DATETIME     NOT NULL,
+    company_key VARCHAR(36) AS (IFNULL(company_id, '')) STORED,
+    UNIQUE KEY letter_templates_company (company_key),
+    FOREIGN KEY (company_id) REFERENCES companies (company_id) ON DELETE CASCADE
+);
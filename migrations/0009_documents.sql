@@ -0,0 +1,15 @@
+-- Student documents. The file contents are kept in blob storage under checksum; a student cannot
+-- upload the same file twice.
+CREATE TABLE documents (
+    document_id  VARCHAR(36)  NOT NULL PRIMARY KEY,
+    student_id   VARCHAR(36)  NOT NULL,
+    kind         VARCHAR(16)  NOT NULL,
+    file_name    VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size         BIGINT       NOT NULL,
+    checksum     CHAR(64)     NOT NULL,
+    uploaded_at  DATETIME     NOT NULL,
+    UNIQUE KEY documents_student_checksum (student_id, checksum),
+    INDEX documents_checksum (checksum),
+    FOREIGN KEY (student_id) REFERENCES students (student_id) ON DELETE CASCADE
+);
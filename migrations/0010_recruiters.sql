@@ -0,0 +1,14 @@
+-- Company recruiters using the recruiter portal. Only the SHA-256 of each API key is stored.
+CREATE TABLE recruiters (
+    recruiter_id VARCHAR(36)  NOT NULL PRIMARY KEY,
+    company_id   VARCHAR(36)  NOT NULL,
+    name         VARCHAR(255) NOT NULL,
+    email        VARCHAR(255) NOT NULL,
+    key_hash     CHAR(64)     NOT NULL,
+    created_at   DATETIME     NOT NULL,
+    UNIQUE KEY recruiters_key_hash (key_hash),
+    FOREIGN KEY (company_id) REFERENCES companies (company_id) ON DELETE CASCADE
+);
+
+-- Recruiters can shortlist applicants; make room for the longer SHORTLISTED status.
+ALTER TABLE students MODIFY status VARCHAR(16) NOT NULL;
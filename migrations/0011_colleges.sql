@@ -0,0 +1,30 @@
+-- Colleges sharing the deployment. Students, companies and letter templates belong to a college;
+-- drives, offers, documents and recruiters belong to one through their company or student.
+CREATE TABLE colleges (
+    college_id VARCHAR(36)  NOT NULL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    created_at DATETIME     NOT NULL,
+    UNIQUE KEY colleges_name (name)
+);
+
+-- Existing data belongs to the college the deployment was serving so far.
+INSERT INTO colleges VALUES ('00000000-0000-0000-0000-000000000001', 'Default College', NOW());
+
+ALTER TABLE companies ADD college_id VARCHAR(36) NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+ALTER TABLE companies ALTER college_id DROP DEFAULT;
+ALTER TABLE companies ADD FOREIGN KEY (college_id) REFERENCES colleges (college_id);
+
+ALTER TABLE students ADD college_id VARCHAR(36) NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+ALTER TABLE students ALTER college_id DROP DEFAULT;
+ALTER TABLE students ADD FOREIGN KEY (college_id) REFERENCES colleges (college_id);
+
+-- A phone number is unique within a college; a student may be enrolled with two colleges.
+ALTER TABLE students DROP INDEX uq_students_phone;
+ALTER TABLE students ADD CONSTRAINT uq_students_phone UNIQUE (college_id, student_phone);
+
+-- Every college has its own default letter template.
+ALTER TABLE letter_templates ADD college_id VARCHAR(36) NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001';
+ALTER TABLE letter_templates ALTER college_id DROP DEFAULT;
+ALTER TABLE letter_templates ADD FOREIGN KEY (college_id) REFERENCES colleges (college_id);
+ALTER TABLE letter_templates DROP INDEX letter_templates_company;
+ALTER TABLE letter_templates ADD UNIQUE KEY letter_templates_company (college_id, company_key);
@@ -0,0 +1,36 @@
+-- Placement seasons partition a college's data by academic batch. A college has at most one current
+-- season, the one not archived yet; rolling over archives it and starts the next.
+CREATE TABLE seasons (
+    season_id   VARCHAR(36)  NOT NULL PRIMARY KEY,
+    college_id  VARCHAR(36)  NOT NULL,
+    name        VARCHAR(64)  NOT NULL,
+    created_at  DATETIME     NOT NULL,
+    archived_at DATETIME     NULL,
+    UNIQUE KEY seasons_name (college_id, name),
+    FOREIGN KEY (college_id) REFERENCES colleges (college_id)
+);
+
+-- Existing data belongs to a first season of every college.
+INSERT INTO seasons SELECT UUID(), college_id, 'Initial season', NOW(), NULL FROM colleges;
+
+ALTER TABLE students ADD season_id VARCHAR(36) NULL;
+UPDATE students s JOIN seasons se ON se.college_id=s.college_id SET s.season_id=se.season_id;
+ALTER TABLE students MODIFY season_id VARCHAR(36) NOT NULL;
+ALTER TABLE students ADD FOREIGN KEY (season_id) REFERENCES seasons (season_id);
+
+ALTER TABLE drives ADD season_id VARCHAR(36) NULL;
+UPDATE drives d JOIN companies c ON c.company_id=d.company_id JOIN seasons se ON se.college_id=c.college_id
+    SET d.season_id=se.season_id;
+ALTER TABLE drives MODIFY season_id VARCHAR(36) NOT NULL;
+ALTER TABLE drives ADD FOREIGN KEY (season_id) REFERENCES seasons (season_id);
+
+-- Companies are shared by the seasons of a college; a season lists the companies taking part in it.
+CREATE TABLE season_companies (
+    season_id  VARCHAR(36) NOT NULL,
+    company_id VARCHAR(36) NOT NULL,
+    PRIMARY KEY (season_id, company_id),
+    FOREIGN KEY (season_id) REFERENCES seasons (season_id),
+    FOREIGN KEY (company_id) REFERENCES companies (company_id) ON DELETE CASCADE
+);
+
+INSERT INTO season_companies SELECT se.season_id, c.company_id FROM companies c JOIN seasons se ON se.college_id=c.college_id;
@@ -0,0 +1,21 @@
+-- Students can be notified by email as well as SMS; the address is optional.
+ALTER TABLE students ADD email VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Notifications queued for delivery. Pending rows are sent once next_attempt_at has passed and retried
+-- with a backoff until they are sent or run out of attempts.
+CREATE TABLE notifications (
+    notification_id VARCHAR(36)   NOT NULL PRIMARY KEY,
+    college_id      VARCHAR(36)   NOT NULL,
+    channel         VARCHAR(8)    NOT NULL,
+    recipient       VARCHAR(255)  NOT NULL,
+    subject         VARCHAR(255)  NOT NULL,
+    body            TEXT          NOT NULL,
+    status          VARCHAR(8)    NOT NULL,
+    attempts        INT           NOT NULL,
+    next_attempt_at DATETIME      NOT NULL,
+    last_error      VARCHAR(1024) NOT NULL,
+    created_at      DATETIME      NOT NULL,
+    sent_at         DATETIME      NULL,
+    KEY notifications_due (status, next_attempt_at),
+    FOREIGN KEY (college_id) REFERENCES colleges (college_id)
+);
@@ -0,0 +1,30 @@
+-- Webhook subscriptions of a college. events is a comma separated list of event types; the secret is
+-- kept as is since deliveries are signed with it.
+CREATE TABLE webhooks (
+    webhook_id VARCHAR(36)   NOT NULL PRIMARY KEY,
+    college_id VARCHAR(36)   NOT NULL,
+    url        VARCHAR(2048) NOT NULL,
+    secret     VARCHAR(128)  NOT NULL,
+    events     VARCHAR(512)  NOT NULL,
+    created_at DATETIME      NOT NULL,
+    FOREIGN KEY (college_id) REFERENCES colleges (college_id)
+);
+
+-- Delivery history of the webhooks. Pending rows are sent once next_attempt_at has passed.
+CREATE TABLE webhook_deliveries (
+    delivery_id     VARCHAR(36)   NOT NULL PRIMARY KEY,
+    webhook_id      VARCHAR(36)   NOT NULL,
+    event_id        VARCHAR(36)   NOT NULL,
+    event_type      VARCHAR(64)   NOT NULL,
+    payload         MEDIUMTEXT    NOT NULL,
+    status          VARCHAR(16)   NOT NULL,
+    attempts        INT           NOT NULL,
+    response_status INT           NOT NULL,
+    last_error      VARCHAR(1024) NOT NULL,
+    next_attempt_at DATETIME      NOT NULL,
+    created_at      DATETIME      NOT NULL,
+    delivered_at    DATETIME      NULL,
+    KEY webhook_deliveries_due (status, next_attempt_at),
+    KEY webhook_deliveries_webhook (webhook_id, created_at),
+    FOREIGN KEY (webhook_id) REFERENCES webhooks (webhook_id) ON DELETE CASCADE
+);
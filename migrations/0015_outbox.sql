@@ -0,0 +1,23 @@
+-- Domain events written in the transaction of the change they describe. Rows are relayed to the
+-- sinks once next_attempt_at has passed and marked published when every sink has them.
+CREATE TABLE outbox (
+    event_id        VARCHAR(36)   NOT NULL PRIMARY KEY,
+    college_id      VARCHAR(36)   NOT NULL,
+    event_type      VARCHAR(64)   NOT NULL,
+    payload         MEDIUMTEXT    NOT NULL,
+    attempts        INT           NOT NULL,
+    last_error      VARCHAR(1024) NOT NULL,
+    next_attempt_at DATETIME      NOT NULL,
+    created_at      DATETIME      NOT NULL,
+    published_at    DATETIME      NULL,
+    KEY outbox_pending (published_at, next_attempt_at)
+);
+
+-- Sinks an outbox event has been delivered to, so a retry after a failing sink skips the others.
+CREATE TABLE outbox_deliveries (
+    event_id     VARCHAR(36) NOT NULL,
+    sink         VARCHAR(64) NOT NULL,
+    delivered_at DATETIME    NOT NULL,
+    PRIMARY KEY (event_id, sink),
+    FOREIGN KEY (event_id) REFERENCES outbox (event_id) ON DELETE CASCADE
+);
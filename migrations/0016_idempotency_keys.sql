@@ -0,0 +1,15 @@
+-- Requests sent with an Idempotency-Key header, with the response they got so a retry replays it.
+-- status is 0 while the request is being handled. Rows are removed once expires_at has passed.
+CREATE TABLE idempotency_keys (
+    college_id   VARCHAR(36)  NOT NULL,
+    idem_key     VARCHAR(255) NOT NULL,
+    request_hash CHAR(64)     NOT NULL,
+    status       INT          NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    body         MEDIUMBLOB   NOT NULL,
+    created_at   DATETIME     NOT NULL,
+    expires_at   DATETIME     NOT NULL,
+    PRIMARY KEY (college_id, idem_key),
+    KEY idempotency_keys_expiry (college_id, expires_at),
+    FOREIGN KEY (college_id) REFERENCES colleges (college_id)
+);
@@ -0,0 +1,4 @@
+-- Admin requests are authenticated with the API key of their college. Only the SHA-256 of each key
+-- is stored. Existing colleges have no key until an operator issues one.
+ALTER TABLE colleges ADD key_hash CHAR(64) NULL;
+ALTER TABLE colleges ADD UNIQUE KEY colleges_key_hash (key_hash);
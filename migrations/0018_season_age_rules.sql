@@ -0,0 +1,4 @@
+-- The age students must have reached is a rule of each placement season. NULL keeps the default of
+-- the deployment (MIN_AGE, AGE_REFERENCE_DATE).
+ALTER TABLE seasons ADD min_age INT NULL;
+ALTER TABLE seasons ADD age_reference_date DATE NULL;
@@ -0,0 +1,7 @@
+-- One row per file kept in blob storage. Uploads and deletes lock the row of the contents they store
+-- or remove, so a file is never removed while a document referring to it is being recorded.
+CREATE TABLE document_blobs (
+    checksum CHAR(64) NOT NULL PRIMARY KEY
+);
+
+INSERT INTO document_blobs SELECT DISTINCT checksum FROM documents;
@@ -0,0 +1,5 @@
+-- Idempotency keys are scoped to the client that sent them as well as the college, so a recruiter
+-- reusing a key the college or another recruiter sent gets its own request handled. Keys recorded
+-- before have no client and simply expire.
+ALTER TABLE idempotency_keys ADD principal VARCHAR(255) NOT NULL DEFAULT '' AFTER college_id;
+ALTER TABLE idempotency_keys DROP PRIMARY KEY, ADD PRIMARY KEY (college_id, principal, idem_key);
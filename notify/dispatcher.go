@@ -0,0 +1,217 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+const (
+	maxBackoff  = 6 * time.Hour
+	maxErrorLen = 1024
+)
+
+// errNoSender is recorded on notifications of a channel no Sender is configured for.
+//
+//nolint:gochecknoglobals // sentinel error
+var errNoSender = errors.New("no sender configured for the channel")
+
+// DispatchConfig holds how often the queue is polled and how failed sends are retried.
+type DispatchConfig struct {
+	// Interval is the time between two polls of the queue.
+	Interval time.Duration
+	// BatchSize is the most notifications sent in one poll.
+	BatchSize int
+	// Concurrency is the most notifications sent through the same channel at a time. Zero means one.
+	Concurrency int
+	// Lease is how long claimed notifications are kept from other dispatchers. It must exceed the time
+	// a batch takes to send, or a slow batch may be claimed and sent again.
+	Lease time.Duration
+	// MaxAttempts is the number of sends after which a notification is given up as failed.
+	MaxAttempts int
+	// Backoff is the wait before the first retry. It doubles with every further attempt, up to six hours.
+	Backoff time.Duration
+}
+
+// Dispatcher sends the notifications waiting in the queue. Several dispatchers may share the queue, as
+// each claims the notifications it sends. A notification whose send succeeded may be sent again if
+// recording the outcome fails, so delivery is at least once.
+type Dispatcher struct {
+	queue   store.NotificationStore
+	senders map[entities.Channel]Sender
+	cfg     DispatchConfig
+	// now is replaced in tests to control which notifications are due.
+	now func() time.Time
+}
+
+func NewDispatcher(queue store.NotificationStore, senders map[entities.Channel]Sender, cfg DispatchConfig) *Dispatcher {
+	return &Dispatcher{queue: queue, senders: senders, cfg: cfg, now: time.Now}
+}
+
+// Run dispatches the due notifications every Interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Dispatch(ctx); err != nil {
+			log.Printf("notify: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch claims the notifications due now, sends each once and records the outcome of every send.
+// Notifications of different channels are sent in parallel, and at most Concurrency at a time
+// through the same channel. Once recording an outcome fails no further notifications are sent; the
+// ones left are claimed again after the lease.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	now := d.now().UTC()
+
+	due, err := d.queue.Claim(ctx, now, now.Add(d.cfg.Lease), d.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	byChannel := map[entities.Channel][]*entities.Notification{}
+	for i := range due {
+		byChannel[due[i].Channel] = append(byChannel[due[i].Channel], &due[i])
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs firstError
+	)
+
+	for _, notifications := range byChannel {
+		queue := make(chan *entities.Notification, len(notifications))
+		for _, n := range notifications {
+			queue <- n
+		}
+
+		close(queue)
+
+		for w := 0; w < d.workers(len(notifications)); w++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				d.send(ctx, queue, &errs)
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	return errs.get()
+}
+
+// workers returns how many notifications of the n through the same channel are sent at a time.
+func (d *Dispatcher) workers(n int) int {
+	workers := d.cfg.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	if workers > n {
+		return n
+	}
+
+	return workers
+}
+
+// send sends the notifications of queue and records their outcome, until queue is drained or
+// recording an outcome fails in any worker.
+func (d *Dispatcher) send(ctx context.Context, queue <-chan *entities.Notification, errs *firstError) {
+	for n := range queue {
+		if errs.get() != nil {
+			return
+		}
+
+		d.deliver(ctx, n)
+
+		if err := d.queue.Update(ctx, n); err != nil {
+			errs.set(err)
+		}
+	}
+}
+
+// deliver sends n and updates it with the outcome: sent, retried after a backoff or, once out of
+// attempts, failed.
+func (d *Dispatcher) deliver(ctx context.Context, n *entities.Notification) {
+	err := errNoSender
+	if sender, ok := d.senders[n.Channel]; ok {
+		err = sender.Send(ctx, Message{To: n.Recipient, Subject: n.Subject, Body: n.Body})
+	}
+
+	now := d.now().UTC()
+	n.Attempts++
+
+	if err == nil {
+		n.Status = entities.NotificationSent
+		n.SentAt = &now
+		n.LastError = ""
+
+		return
+	}
+
+	n.LastError = err.Error()
+	if len(n.LastError) > maxErrorLen {
+		n.LastError = n.LastError[:maxErrorLen]
+	}
+
+	if n.Attempts >= d.cfg.MaxAttempts {
+		n.Status = entities.NotificationFailed
+
+		return
+	}
+
+	n.NextAttemptAt = now.Add(d.backoff(n.Attempts))
+}
+
+// backoff returns the wait after the given number of failed attempts.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	wait := d.cfg.Backoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+
+	return wait
+}
+
+// firstError keeps the first error the workers of a dispatch run into.
+type firstError struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (e *firstError) set(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *firstError) get() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.err
+}
@@ -0,0 +1,162 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+// fakeSender records the messages sent and fails with err. It takes delay to send a message and
+// keeps the most messages it was sending at once in maxSending.
+type fakeSender struct {
+	mu         sync.Mutex
+	sent       []Message
+	err        error
+	delay      time.Duration
+	sending    int
+	maxSending int
+}
+
+func (f *fakeSender) Send(_ context.Context, msg Message) error {
+	f.mu.Lock()
+	f.sent = append(f.sent, msg)
+	f.sending++
+
+	if f.sending > f.maxSending {
+		f.maxSending = f.sending
+	}
+	f.mu.Unlock()
+
+	time.Sleep(f.delay)
+
+	f.mu.Lock()
+	f.sending--
+	f.mu.Unlock()
+
+	return f.err
+}
+
+func TestDispatch(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	cfg := DispatchConfig{BatchSize: 10, Lease: 5 * time.Minute, MaxAttempts: 3, Backoff: time.Minute}
+	id := uuid.New()
+	queued := entities.Notification{ID: id, Channel: entities.SMSChannel, Recipient: "+916388768118", Body: "Drive on 15 Jul",
+		Status: entities.NotificationPending, NextAttemptAt: now}
+
+	after := func(attempts int, status entities.NotificationStatus, next time.Time, lastErr string,
+		sentAt *time.Time) entities.Notification {
+		n := queued
+		n.Attempts, n.Status, n.NextAttemptAt, n.LastError, n.SentAt = attempts, status, next, lastErr, sentAt
+
+		return n
+	}
+
+	tests := []struct {
+		description string
+		attempts    int
+		channel     entities.Channel
+		sendErr     error
+		exp         entities.Notification
+	}{
+		{"Success case: sent", 0, entities.SMSChannel, nil, after(1, entities.NotificationSent, now, "", &now)},
+		{"first failure is retried after the backoff", 0, entities.SMSChannel, errors.New("gateway down"),
+			after(1, entities.NotificationPending, now.Add(time.Minute), "gateway down", nil)},
+		{"backoff doubles with every attempt", 1, entities.SMSChannel, errors.New("gateway down"),
+			after(2, entities.NotificationPending, now.Add(2*time.Minute), "gateway down", nil)},
+		{"last attempt fails the notification", 2, entities.SMSChannel, errors.New("gateway down"),
+			after(3, entities.NotificationFailed, now, "gateway down", nil)},
+		{"channel without a sender is retried", 0, entities.EmailChannel, nil,
+			after(1, entities.NotificationPending, now.Add(time.Minute), errNoSender.Error(), nil)},
+	}
+
+	for i, tc := range tests {
+		ctrl := gomock.NewController(t)
+		queue := store.NewMockNotificationStore(ctrl)
+		sender := &fakeSender{err: tc.sendErr}
+
+		n := queued
+		n.Attempts, n.Channel = tc.attempts, tc.channel
+		exp := tc.exp
+		exp.Channel = tc.channel
+
+		queue.EXPECT().Claim(context.Background(), now, now.Add(5*time.Minute), cfg.BatchSize).Return([]entities.Notification{n}, nil)
+		queue.EXPECT().Update(context.Background(), &exp).Return(nil)
+
+		d := NewDispatcher(queue, map[entities.Channel]Sender{entities.SMSChannel: sender}, cfg)
+		d.now = func() time.Time { return now }
+
+		err := d.Dispatch(context.Background())
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDispatchConcurrency(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	sms := &fakeSender{delay: 20 * time.Millisecond}
+	email := &fakeSender{delay: 20 * time.Millisecond}
+
+	var due []entities.Notification
+
+	for i := 0; i < 12; i++ {
+		due = append(due, entities.Notification{ID: uuid.New(), Channel: []entities.Channel{entities.SMSChannel, entities.EmailChannel}[i%2],
+			Recipient: "+916388768118", Body: "Drive on 15 Jul", Status: entities.NotificationPending, NextAttemptAt: now})
+	}
+
+	ctrl := gomock.NewController(t)
+	queue := store.NewMockNotificationStore(ctrl)
+	queue.EXPECT().Claim(gomock.Any(), now, now.Add(time.Minute), 12).Return(due, nil)
+	queue.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(12)
+
+	d := NewDispatcher(queue, map[entities.Channel]Sender{entities.SMSChannel: sms, entities.EmailChannel: email},
+		DispatchConfig{BatchSize: 12, Concurrency: 2, Lease: time.Minute, MaxAttempts: 3})
+	d.now = func() time.Time { return now }
+
+	assert.NoError(t, d.Dispatch(context.Background()))
+	assert.Len(t, sms.sent, 6)
+	assert.Len(t, email.sent, 6)
+	assert.Equal(t, 2, sms.maxSending)
+	assert.Equal(t, 2, email.maxSending)
+}
+
+func TestDispatchStopsWhenNotRecorded(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	sms := &fakeSender{}
+
+	var due []entities.Notification
+
+	for i := 0; i < 3; i++ {
+		due = append(due, entities.Notification{ID: uuid.New(), Channel: entities.SMSChannel, Recipient: "+916388768118",
+			Body: "Drive on 15 Jul", Status: entities.NotificationPending, NextAttemptAt: now})
+	}
+
+	ctrl := gomock.NewController(t)
+	queue := store.NewMockNotificationStore(ctrl)
+	queue.EXPECT().Claim(gomock.Any(), now, now.Add(time.Minute), 10).Return(due, nil)
+	queue.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors2.DB{Reason: "server error"})
+
+	d := NewDispatcher(queue, map[entities.Channel]Sender{entities.SMSChannel: sms},
+		DispatchConfig{BatchSize: 10, Lease: time.Minute, MaxAttempts: 3})
+	d.now = func() time.Time { return now }
+
+	assert.Equal(t, errors2.DB{Reason: "server error"}, d.Dispatch(context.Background()))
+	assert.Len(t, sms.sent, 1)
+}
+
+func TestBackoff(t *testing.T) {
+	d := NewDispatcher(nil, nil, DispatchConfig{Backoff: time.Minute})
+
+	assert.Equal(t, time.Minute, d.backoff(1))
+	assert.Equal(t, 8*time.Minute, d.backoff(4))
+	assert.Equal(t, maxBackoff, d.backoff(20))
+}
@@ -0,0 +1,30 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Log is a Sender that writes messages to a writer, such as a file or standard output, instead of
+// delivering them. It stands in for channels that are not configured, e.g. in development.
+type Log struct {
+	mu      sync.Mutex
+	w       io.Writer
+	channel string
+}
+
+// NewLog returns a Sender writing the messages of the named channel to w.
+func NewLog(w io.Writer, channel string) *Log {
+	return &Log{w: w, channel: channel}
+}
+
+func (l *Log) Send(_ context.Context, msg Message) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, err := fmt.Fprintf(l.w, "%s to %s: %s\n%s\n\n", l.channel, msg.To, msg.Subject, msg.Body)
+
+	return err
+}
@@ -0,0 +1,18 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogSend(t *testing.T) {
+	var b bytes.Buffer
+
+	err := NewLog(&b, "SMS").Send(context.Background(), Message{To: "+916388768118", Body: "Drive on 15 Jul"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "SMS to +916388768118: \nDrive on 15 Jul\n\n", b.String())
+}
@@ -0,0 +1,131 @@
+package notify
+
+import (
+	"context"
+	"time"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+// errNoStudents is what the student store returns for a season without students.
+//
+//nolint:gochecknoglobals // sentinel error
+var errNoStudents = errors.DB{Reason: "no rows found"}
+
+// Notifier queues notifications for domain events. Every student concerned gets an email when they
+// have an address and an SMS on their phone.
+type Notifier struct {
+	queue    store.NotificationStore
+	students store.StudentStore
+	messages map[entities.EventType]message
+	// now is replaced in tests to stamp a fixed time.
+	now func() time.Time
+}
+
+func NewNotifier(queue store.NotificationStore, students store.StudentStore) *Notifier {
+	return &Notifier{queue: queue, students: students, messages: messages(), now: time.Now}
+}
+
+// Handle queues the notifications for e. It is an outbox sink and runs in the college and season
+// scope of the change.
+func (n *Notifier) Handle(ctx context.Context, e *entities.Event) error {
+	msg, ok := n.messages[e.Type]
+	if !ok {
+		return nil
+	}
+
+	recipients, err := n.recipients(ctx, e)
+	if err != nil {
+		return err
+	}
+
+	now := n.now().UTC()
+
+	var notifications []entities.Notification
+
+	for i := range recipients {
+		d := data{Event: e, Recipient: recipients[i]}
+
+		queued, err := compose(&msg, &d, now)
+		if err != nil {
+			return err
+		}
+
+		notifications = append(notifications, queued...)
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return n.queue.Enqueue(ctx, notifications)
+}
+
+// recipients returns the students concerned by e: the student of a status change or offer, and the
+// students who may register for a scheduled drive.
+func (n *Notifier) recipients(ctx context.Context, e *entities.Event) ([]entities.Student, error) {
+	if e.Type != entities.DriveScheduled {
+		if e.Student == nil {
+			return nil, nil
+		}
+
+		return []entities.Student{*e.Student}, nil
+	}
+
+	students, err := n.students.GetWithCompany(ctx, "", "")
+	if err == errNoStudents {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var eligible []entities.Student
+
+	for i := range students {
+		if len(e.Drive.Check(&students[i])) == 0 {
+			eligible = append(eligible, students[i])
+		}
+	}
+
+	return eligible, nil
+}
+
+// compose renders msg for the recipient of d on each of their channels.
+func compose(msg *message, d *data, now time.Time) ([]entities.Notification, error) {
+	var notifications []entities.Notification
+
+	pending := entities.Notification{Status: entities.NotificationPending, NextAttemptAt: now, CreatedAt: now}
+
+	if d.Recipient.Email != "" {
+		subject, err := execute(msg.subject, d)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := execute(msg.body, d)
+		if err != nil {
+			return nil, err
+		}
+
+		email := pending
+		email.Channel, email.Recipient, email.Subject, email.Body = entities.EmailChannel, d.Recipient.Email, subject, body
+		notifications = append(notifications, email)
+	}
+
+	if d.Recipient.Phone != "" {
+		body, err := execute(msg.sms, d)
+		if err != nil {
+			return nil, err
+		}
+
+		sms := pending
+		sms.Channel, sms.Recipient, sms.Body = entities.SMSChannel, d.Recipient.Phone, body
+		notifications = append(notifications, sms)
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func TestHandle(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	wipro := entities.Company{ID: uuid.New(), Name: "Wipro", Category: "MASS"}
+	aditi := entities.Student{Name: "Aditi", Phone: "+916388768118", Email: "aditi@example.com", Branch: "CSE",
+		Comp: wipro, Status: "ACCEPTED"}
+	ravi := entities.Student{Name: "Ravi", Phone: "+916388768119", Branch: "CSE", Status: "PENDING"}
+	placed := entities.Student{Name: "Kiran", Phone: "+916388768121", Branch: "CSE",
+		Comp: entities.Company{ID: uuid.New(), Name: "ZopSmart", Category: "OPEN DREAM"}, Status: "ACCEPTED"}
+	civil := entities.Student{Name: "Meena", Phone: "+916388768120", Branch: "CIVIL", Status: "PENDING"}
+	drive := entities.Drive{Comp: wipro, Date: entities.NewDate(2023, 7, 15),
+		RegistrationOpens:  time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC),
+		RegistrationCloses: time.Date(2023, 7, 10, 18, 0, 0, 0, time.UTC), Branches: []entities.Branch{"CSE"}}
+	offer := entities.Offer{Comp: wipro, Role: "SDE", CTC: 600000, ExpiresOn: entities.NewDate(2023, 7, 20)}
+	pending := entities.Notification{Status: entities.NotificationPending, NextAttemptAt: now, CreatedAt: now}
+
+	with := func(channel entities.Channel, to, subject, body string) entities.Notification {
+		n := pending
+		n.Channel, n.Recipient, n.Subject, n.Body = channel, to, subject, body
+
+		return n
+	}
+
+	tests := []struct {
+		description string
+		event       entities.Event
+		listTimes   int
+		expQueued   []entities.Notification
+	}{
+		{"status change notifies the student by email and SMS",
+			entities.Event{Type: entities.StudentStatusChanged, Student: &aditi, PreviousStatus: "SHORTLISTED"}, 0,
+			[]entities.Notification{
+				with(entities.EmailChannel, "aditi@example.com", "Your placement status is now ACCEPTED",
+					"Hi Aditi,\n\nYour placement status changed from SHORTLISTED to ACCEPTED with Wipro."),
+				with(entities.SMSChannel, "+916388768118", "", "Placement status changed to ACCEPTED with Wipro."),
+			}},
+		{"offer notifies a student without email by SMS only",
+			entities.Event{Type: entities.OfferIssued, Student: &ravi, Offer: &offer}, 0,
+			[]entities.Notification{
+				with(entities.SMSChannel, "+916388768119", "", "Offer from Wipro for SDE. Respond by 20 Jul."),
+			}},
+		{"scheduled drive notifies the students who may register",
+			entities.Event{Type: entities.DriveScheduled, Drive: &drive}, 1,
+			[]entities.Notification{
+				with(entities.SMSChannel, "+916388768119", "", "Wipro drive on 15 Jul. Register by 10 Jul 18:00."),
+			}},
+	}
+
+	for i, tc := range tests {
+		ctrl := gomock.NewController(t)
+		queue := store.NewMockNotificationStore(ctrl)
+		students := store.NewMockStudentStore(ctrl)
+
+		students.EXPECT().GetWithCompany(context.Background(), "", "").
+			Return([]entities.Student{placed, ravi, civil}, nil).Times(tc.listTimes)
+		queue.EXPECT().Enqueue(context.Background(), tc.expQueued).Return(nil)
+
+		n := NewNotifier(queue, students)
+		n.now = func() time.Time { return now }
+
+		err := n.Handle(context.Background(), &tc.event)
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestHandleListError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	queue := store.NewMockNotificationStore(ctrl)
+	students := store.NewMockStudentStore(ctrl)
+
+	students.EXPECT().GetWithCompany(context.Background(), "", "").Return(nil, errors.New("connection refused"))
+
+	err := NewNotifier(queue, students).Handle(context.Background(),
+		&entities.Event{Type: entities.DriveScheduled, Drive: &entities.Drive{}})
+
+	assert.EqualError(t, err, "connection refused")
+}
+
+func TestHandleNoStudents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	queue := store.NewMockNotificationStore(ctrl)
+	students := store.NewMockStudentStore(ctrl)
+
+	students.EXPECT().GetWithCompany(context.Background(), "", "").
+		Return([]entities.Student{}, errors2.DB{Reason: "no rows found"})
+
+	err := NewNotifier(queue, students).Handle(context.Background(),
+		&entities.Event{Type: entities.DriveScheduled, Drive: &entities.Drive{}})
+
+	assert.NoError(t, err, "a season without students has nobody to notify")
+}
@@ -0,0 +1,21 @@
+// Package notify tells students about changes to their placement by email and SMS. The Notifier turns
+// domain events into notifications on a persistent queue and the Dispatcher sends them through the
+// Sender of their channel, retrying failed sends with a backoff.
+package notify
+
+import (
+	"context"
+)
+
+// Message is a notification as handed to a Sender. Subject is empty for SMS.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers messages over one channel, such as an SMTP server or an SMS gateway. The dispatcher
+// may call Send from several goroutines at once.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
@@ -0,0 +1,55 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const smsTimeout = 10 * time.Second
+
+// SMS is a Sender delivering text messages through an HTTP gateway. Each message is POSTed as
+// {"to": ..., "message": ...} with the API key as a bearer token; any 2xx response means accepted.
+type SMS struct {
+	url    string
+	apiKey string
+	client *http.Client
+}
+
+func NewSMS(url, apiKey string) *SMS {
+	return &SMS{url: url, apiKey: apiKey, client: &http.Client{Timeout: smsTimeout}}
+}
+
+func (s *SMS) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(map[string]string{"to": msg.To, "message": msg.Body})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("sms gateway: %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSMSSend(t *testing.T) {
+	tests := []struct {
+		description string
+		status      int
+		expErr      bool
+	}{
+		{"Success case: gateway accepts the message", http.StatusAccepted, false},
+		{"Error case: gateway rejects the message", http.StatusBadGateway, true},
+	}
+
+	for i, tc := range tests {
+		var got map[string]string
+
+		var auth string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			auth = r.Header.Get("Authorization")
+			_ = json.NewDecoder(r.Body).Decode(&got)
+
+			w.WriteHeader(tc.status)
+		}))
+
+		err := NewSMS(server.URL, "secret").Send(context.Background(), Message{To: "+916388768118", Body: "Drive on 15 Jul"})
+
+		server.Close()
+
+		assert.Equal(t, tc.expErr, err != nil, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, "Bearer secret", auth, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, map[string]string{"to": "+916388768118", "message": "Drive on 15 Jul"}, got,
+			"Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
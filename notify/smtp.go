@@ -0,0 +1,59 @@
+package notify
+
+import (
+	"context"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+)
+
+// SMTPConfig holds the server email notifications are sent through. Username is empty for servers
+// accepting mail without authentication.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	From     string
+	Username string
+	Password string
+}
+
+// SMTP is a Sender delivering email through an SMTP server.
+type SMTP struct {
+	cfg SMTPConfig
+	// send is replaced in tests to capture the mail instead of connecting to a server.
+	send func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTP(cfg SMTPConfig) *SMTP {
+	return &SMTP{cfg: cfg, send: smtp.SendMail}
+}
+
+func (s *SMTP) Send(_ context.Context, msg Message) error {
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+
+	return s.send(addr, auth, s.cfg.From, []string{msg.To}, s.compose(msg))
+}
+
+// compose returns msg as a plain text mail. Header values come from student and company names, so
+// line breaks are dropped from them to keep them from adding headers.
+func (s *SMTP) compose(msg Message) []byte {
+	header := strings.NewReplacer("\r", "", "\n", " ")
+
+	var b strings.Builder
+
+	b.WriteString("From: " + header.Replace(s.cfg.From) + "\r\n")
+	b.WriteString("To: " + header.Replace(msg.To) + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", header.Replace(msg.Subject)) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	return []byte(b.String())
+}
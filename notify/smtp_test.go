@@ -0,0 +1,35 @@
+package notify
+
+import (
+	"context"
+	"net/smtp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSMTPSend(t *testing.T) {
+	s := NewSMTP(SMTPConfig{Host: "mail.example.com", Port: 587, From: "placements@example.com"})
+
+	var addr, from string
+
+	var to []string
+
+	var mail []byte
+
+	s.send = func(a string, _ smtp.Auth, f string, t []string, msg []byte) error {
+		addr, from, to, mail = a, f, t, msg
+
+		return nil
+	}
+
+	err := s.Send(context.Background(), Message{To: "aditi@example.com", Subject: "Offer from Wipro\r\nBcc: x@example.com",
+		Body: "Hi Aditi,\n\nCongratulations!"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "mail.example.com:587", addr)
+	assert.Equal(t, "placements@example.com", from)
+	assert.Equal(t, []string{"aditi@example.com"}, to)
+	assert.Equal(t, "From: placements@example.com\r\nTo: aditi@example.com\r\nSubject: Offer from Wipro Bcc: x@example.com\r\n"+
+		"MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\nHi Aditi,\r\n\r\nCongratulations!", string(mail))
+}
@@ -0,0 +1,74 @@
+package notify
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/aditi-zs/Placement-API/entities"
+)
+
+// message holds the templates of the notifications sent for one event type. They are executed with
+// a data value holding the event and the student notified.
+type message struct {
+	subject *template.Template
+	body    *template.Template
+	sms     *template.Template
+}
+
+// data is what the templates of a message are executed with.
+type data struct {
+	*entities.Event
+	Recipient entities.Student
+}
+
+const (
+	statusSubject = `Your placement status is now {{.Student.Status}}`
+	statusBody    = `Hi {{.Recipient.Name}},
+
+Your placement status changed from {{.PreviousStatus}} to {{.Student.Status}}{{with .Student.Comp.Name}} with {{.}}{{end}}.`
+	statusSMS = `Placement status changed to {{.Student.Status}}{{with .Student.Comp.Name}} with {{.}}{{end}}.`
+
+	driveSubject = `{{.Drive.Comp.Name}} placement drive on {{.Drive.Date.Format "02 Jan 2006"}}`
+	driveBody    = `Hi {{.Recipient.Name}},
+
+{{.Drive.Comp.Name}} visits campus on {{.Drive.Date.Format "02 Jan 2006"}} and you are eligible to take part.
+Registration is open from {{.Drive.RegistrationOpens.Format "02 Jan 2006 15:04"}} to ` +
+		`{{.Drive.RegistrationCloses.Format "02 Jan 2006 15:04"}}.`
+	driveSMS = `{{.Drive.Comp.Name}} drive on {{.Drive.Date.Format "02 Jan"}}. ` +
+		`Register by {{.Drive.RegistrationCloses.Format "02 Jan 15:04"}}.`
+
+	offerSubject = `Offer from {{.Offer.Comp.Name}}`
+	offerBody    = `Hi {{.Recipient.Name}},
+
+Congratulations! {{.Offer.Comp.Name}} has offered you the role of {{.Offer.Role}} at a CTC of Rs. {{.Offer.CTC}}` +
+		`{{with .Offer.Location}} in {{.}}{{end}}.
+Please respond by {{.Offer.ExpiresOn.Format "02 Jan 2006"}}.`
+	offerSMS = `Offer from {{.Offer.Comp.Name}} for {{.Offer.Role}}. Respond by {{.Offer.ExpiresOn.Format "02 Jan"}}.`
+)
+
+// messages returns the templates of the event types students are notified of.
+func messages() map[entities.EventType]message {
+	return map[entities.EventType]message{
+		entities.StudentStatusChanged: newMessage(entities.StudentStatusChanged, statusSubject, statusBody, statusSMS),
+		entities.DriveScheduled:       newMessage(entities.DriveScheduled, driveSubject, driveBody, driveSMS),
+		entities.OfferIssued:          newMessage(entities.OfferIssued, offerSubject, offerBody, offerSMS),
+	}
+}
+
+// newMessage parses the templates of a message. They are constants, so a parse error is a bug.
+func newMessage(name entities.EventType, subject, body, sms string) message {
+	return message{
+		subject: template.Must(template.New(string(name) + ".subject").Parse(subject)),
+		body:    template.Must(template.New(string(name) + ".body").Parse(body)),
+		sms:     template.Must(template.New(string(name) + ".sms").Parse(sms)),
+	}
+}
+
+func execute(t *template.Template, d *data) (string, error) {
+	var b strings.Builder
+	if err := t.Execute(&b, d); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,53 @@
+package openapi
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+// initializer starts Swagger UI on the spec; SPEC_URL is replaced with the URL it is served at.
+//
+//go:embed swagger-initializer.js
+var initializer []byte
+
+// Handler serves the spec as JSON.
+func (s *Spec) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("error in marshaling"))
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	})
+}
+
+// Docs serves Swagger UI on the spec served at specURL. The Swagger UI files are built into the
+// binary, so the docs work without access to a CDN. The page refers to its files by relative paths:
+// mount the handler on a directory, such as /docs/, with the directory stripped from the path.
+func Docs(specURL string) http.Handler {
+	url, _ := json.Marshal(specURL)
+	script := bytes.Replace(initializer, []byte("SPEC_URL"), url, 1)
+	files := http.FileServer(http.FS(swaggerFiles.FS))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "swagger-initializer.js" {
+			files.ServeHTTP(w, r)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(script)
+	})
+}
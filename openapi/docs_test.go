@@ -0,0 +1,57 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/api"
+)
+
+func TestHandler(t *testing.T) {
+	s, err := Load(api.Spec)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.json", http.NoBody))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	// The served spec holds everything in api/openapi.json.
+	var served, embedded interface{}
+
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &served))
+	assert.NoError(t, json.Unmarshal(api.Spec, &embedded))
+	assert.Equal(t, embedded, served)
+}
+
+func TestDocs(t *testing.T) {
+	docs := http.StripPrefix("/docs/", Docs("/openapi.json"))
+
+	tests := []struct {
+		description string
+		path        string
+		expType     string
+		expBody     string
+	}{
+		{"Swagger UI page", "/docs/", "text/html; charset=utf-8", `<script src="./swagger-initializer.js"`},
+		{"Swagger UI loads the spec", "/docs/swagger-initializer.js", "text/javascript; charset=utf-8", `url: "/openapi.json",`},
+		{"Swagger UI files are built in", "/docs/swagger-ui-bundle.js", "text/javascript; charset=utf-8", "SwaggerUIBundle"},
+	}
+
+	for i, tc := range tests {
+		w := httptest.NewRecorder()
+		docs.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.path, http.NoBody))
+
+		assert.Equal(t, http.StatusOK, w.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expType, w.Header().Get("Content-Type"), "Test[%d] failed\n(%s)", i, tc.description)
+		assert.True(t, strings.Contains(w.Body.String(), tc.expBody), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
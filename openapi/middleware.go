@@ -0,0 +1,282 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+// DefaultMaxBody is the largest JSON request body checked when Config.MaxBody is zero.
+const DefaultMaxBody = 1 << 20
+
+// Config holds what the middleware checks besides requests.
+type Config struct {
+	// Responses turns on checking responses, for tests and staging: a response with an undocumented
+	// status or a body that does not match the spec is replaced with 500 saying why. Responses the
+	// handler flushes, such as event streams, are sent as they are written and only their status is
+	// checked.
+	Responses bool
+	// MaxBody is the largest JSON request body read to be checked; larger requests get 413.
+	MaxBody int64
+}
+
+// Middleware rejects requests to documented operations that do not match the spec with 400, in the
+// format of the handlers: a missing parameter or property as errors.MissingParam and any other
+// violation as errors.InvalidParam. Requests to operations the spec does not describe, and bodies
+// that are not JSON, are left to the handlers.
+func (s *Spec) Middleware(cfg Config) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			op, vars := s.Find(r.Method, r.URL.Path)
+			if op == nil {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			if err := s.checkRequest(op, vars, w, r, cfg.maxBody()); err != nil {
+				var tooLarge *http.MaxBytesError
+				if stderrors.As(err, &tooLarge) {
+					w.WriteHeader(http.StatusRequestEntityTooLarge)
+					_, _ = w.Write([]byte("request body too large"))
+
+					return
+				}
+
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(err.Error()))
+
+				return
+			}
+
+			if !cfg.Responses {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			rec := &recorder{w: w, spec: s, op: op, header: http.Header{}, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			if !rec.flushed {
+				rec.send(rec.body.Bytes())
+			}
+		})
+	}
+}
+
+func (cfg Config) maxBody() int64 {
+	if cfg.MaxBody == 0 {
+		return DefaultMaxBody
+	}
+
+	return cfg.MaxBody
+}
+
+// checkRequest checks the parameters and JSON body of r against op, reading at most maxBody bytes of
+// the body. It leaves r with its body intact for the handler.
+func (s *Spec) checkRequest(op *Operation, vars map[string]string, w http.ResponseWriter, r *http.Request, maxBody int64) error {
+	for _, p := range op.Parameters {
+		p, err := s.parameter(p)
+		if err != nil {
+			return err
+		}
+
+		val, ok := paramValue(p, vars, r)
+		if !ok {
+			if p.Required {
+				return errors.MissingParam{Param: []string{p.Name}}
+			}
+
+			continue
+		}
+
+		if p.Schema != nil {
+			if err = s.Validate(p.Schema, s.parseParam(p.Schema, val), p.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if op.RequestBody == nil {
+		return nil
+	}
+
+	media, ok := op.RequestBody.Content["application/json"]
+	if !ok || !isJSON(r.Header.Get("Content-Type")) {
+		return nil
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBody))
+	if err != nil {
+		return err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		if op.RequestBody.Required {
+			return errors.MissingParam{Param: []string{"body"}}
+		}
+
+		return nil
+	}
+
+	v, err := decode(body)
+	if err != nil {
+		return nil
+	}
+
+	return s.Validate(media.Schema, v, "")
+}
+
+// CheckResponse reports whether status is documented for op and body matches its JSON schema.
+func (s *Spec) CheckResponse(op *Operation, status int, body []byte) error {
+	resp, ok := op.Responses[strconv.Itoa(status)]
+	if !ok {
+		if resp, ok = op.Responses["default"]; !ok {
+			return fmt.Errorf("status %d is not documented", status)
+		}
+	}
+
+	media, ok := resp.Content["application/json"]
+	if !ok || media.Schema == nil || len(body) == 0 {
+		return nil
+	}
+
+	v, err := decode(body)
+	if err != nil {
+		return fmt.Errorf("body is not JSON: %w", err)
+	}
+
+	return s.Validate(media.Schema, v, "")
+}
+
+func paramValue(p *Parameter, vars map[string]string, r *http.Request) (string, bool) {
+	var val string
+
+	switch p.In {
+	case "path":
+		val = vars[p.Name]
+	case "query":
+		val = r.URL.Query().Get(p.Name)
+	case "header":
+		val = r.Header.Get(p.Name)
+	}
+
+	return val, val != ""
+}
+
+func decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// isJSON reports whether a request body of contType is checked. Bodies without a type are read as
+// JSON by the handlers.
+func isJSON(contType string) bool {
+	if contType == "" {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contType)
+
+	return err == nil && mediaType == "application/json"
+}
+
+// recorder keeps a response so it can be checked before it is sent. Like a connection, it keeps
+// the first status written. Once the handler flushes, the response is checked by its status and the
+// rest of it is written through to w.
+type recorder struct {
+	w           http.ResponseWriter
+	spec        *Spec
+	op          *Operation
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+	flushed     bool
+	// failed is set when a flushed response did not match the spec; what the handler writes after
+	// is dropped.
+	failed bool
+}
+
+func (rec *recorder) Header() http.Header {
+	return rec.header
+}
+
+func (rec *recorder) WriteHeader(status int) {
+	if rec.wroteHeader {
+		return
+	}
+
+	rec.status, rec.wroteHeader = status, true
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	rec.wroteHeader = true
+
+	switch {
+	case rec.failed:
+		return len(b), nil
+	case rec.flushed:
+		return rec.w.Write(b)
+	default:
+		return rec.body.Write(b)
+	}
+}
+
+// Flush sends the response written so far when w can be flushed, so streamed responses are not held
+// back. The body is checked only when the response is complete, so a flushed one is checked by its
+// status alone.
+func (rec *recorder) Flush() {
+	flusher, ok := rec.w.(http.Flusher)
+	if !ok {
+		return
+	}
+
+	if !rec.flushed {
+		rec.flushed = true
+		rec.failed = !rec.send(nil)
+
+		if !rec.failed {
+			_, _ = rec.w.Write(rec.body.Bytes())
+		}
+	}
+
+	flusher.Flush()
+}
+
+// send writes the status and headers of the response followed by body when the response matches
+// the spec, and 500 saying why otherwise. It reports whether the response matched.
+func (rec *recorder) send(body []byte) bool {
+	for k, v := range rec.header {
+		rec.w.Header()[k] = v
+	}
+
+	if err := rec.spec.CheckResponse(rec.op, rec.status, body); err != nil {
+		rec.w.WriteHeader(http.StatusInternalServerError)
+		_, _ = fmt.Fprintf(rec.w, "response does not match the API spec: %v", err)
+
+		return false
+	}
+
+	rec.w.WriteHeader(rec.status)
+	_, _ = rec.w.Write(body)
+
+	return true
+}
@@ -0,0 +1,225 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+const testSpec = `{
+  "openapi": "3.0.3",
+  "info": {"title": "test", "version": "1"},
+  "paths": {
+    "/companies/{id}": {
+      "put": {
+        "parameters": [
+          {"in": "path", "name": "id", "required": true, "schema": {"type": "string", "format": "uuid"}},
+          {"$ref": "#/components/parameters/CollegeID"},
+          {"in": "query", "name": "limit", "schema": {"type": "integer", "minimum": 1}}
+        ],
+        "requestBody": {"required": true, "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Company"}}}},
+        "responses": {
+          "200": {"description": "OK", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Company"}}}},
+          "400": {"description": "Bad Request"}
+        }
+      }
+    },
+    "/companies/export": {
+      "put": {"responses": {"204": {"description": "No Content"}}}
+    }
+  },
+  "components": {
+    "parameters": {
+      "CollegeID": {"in": "header", "name": "X-College-ID", "required": true, "schema": {"type": "string", "format": "uuid"}}
+    },
+    "schemas": {
+      "Company": {
+        "type": "object",
+        "required": ["name", "category"],
+        "properties": {
+          "name": {"type": "string"},
+          "category": {"type": "string", "enum": ["MASS", "CORE"]},
+          "criteria": {
+            "type": "object",
+            "properties": {
+              "minCgpa": {"type": "number", "minimum": 0, "maximum": 10},
+              "allowedYears": {"type": "array", "items": {"type": "integer"}}
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+const (
+	companyPath = "/companies/6dbae7e9-0cfb-40a7-a977-2f826bcc951c"
+	collegeID   = "5c3e1f0e-8a4b-4d2c-9f6e-1b2a3c4d5e6f"
+)
+
+func TestLoad(t *testing.T) {
+	_, err := Load([]byte(`{"paths": {"/a": {"get": {"parameters": [{"$ref": "#/components/parameters/Missing"}]}}}}`))
+
+	assert.EqualError(t, err, "openapi: get /a: unresolved reference #/components/parameters/Missing")
+}
+
+func TestFind(t *testing.T) {
+	spec, err := Load([]byte(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		description string
+		method      string
+		path        string
+		expFound    bool
+		expVars     map[string]string
+	}{
+		{"Success case: path parameter", "PUT", companyPath, true, map[string]string{"id": "6dbae7e9-0cfb-40a7-a977-2f826bcc951c"}},
+		{"Success case: literal segment first", "PUT", "/companies/export", true, map[string]string{}},
+		{"Error case: other method", "GET", companyPath, false, nil},
+		{"Error case: other path", "PUT", companyPath + "/rounds", false, nil},
+	}
+
+	for i, tc := range tests {
+		op, vars := spec.Find(tc.method, tc.path)
+
+		assert.Equal(t, tc.expFound, op != nil, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expVars, vars, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	spec, err := Load([]byte(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		description string
+		path        string
+		college     string
+		body        string
+		response    string
+		expCode     int
+		expBody     string
+	}{
+		{"Success case", companyPath, collegeID, `{"name":"Wipro","category":"MASS","criteria":{"minCgpa":7.5,"allowedYears":[2023]}}`,
+			`{"name":"Wipro","category":"MASS"}`, 200, `{"name":"Wipro","category":"MASS"}`},
+		{"Success case: null optional property", companyPath, collegeID, `{"name":"Wipro","category":"MASS","criteria":null}`,
+			`{"name":"Wipro","category":"MASS"}`, 200, `{"name":"Wipro","category":"MASS"}`},
+		{"Error case: missing properties", companyPath, collegeID, `{"criteria":{}}`, "", 400,
+			errors.MissingParam{Param: []string{"name", "category"}}.Error()},
+		{"Error case: value not in enum", companyPath, collegeID, `{"name":"Wipro","category":"DREAM"}`, "", 400,
+			errors.InvalidParam{Param: "category should be one of MASS, CORE"}.Error()},
+		{"Error case: nested bound", companyPath, collegeID, `{"name":"Wipro","category":"MASS","criteria":{"minCgpa":11}}`, "", 400,
+			errors.InvalidParam{Param: "criteria.minCgpa should be at most 10"}.Error()},
+		{"Error case: array item", companyPath, collegeID, `{"name":"Wipro","category":"MASS","criteria":{"allowedYears":["2023"]}}`, "", 400,
+			errors.InvalidParam{Param: "criteria.allowedYears[0] should be an integer"}.Error()},
+		{"Error case: wrong type", companyPath, collegeID, `{"name":7,"category":"MASS"}`, "", 400,
+			errors.InvalidParam{Param: "name should be a string"}.Error()},
+		{"Error case: missing body", companyPath, collegeID, "", "", 400, errors.MissingParam{Param: []string{"body"}}.Error()},
+		{"Error case: path parameter", "/companies/abc", collegeID, `{"name":"Wipro","category":"MASS"}`, "", 400,
+			errors.InvalidParam{Param: "id should be a UUID"}.Error()},
+		{"Error case: query parameter", companyPath + "?limit=0", collegeID, `{"name":"Wipro","category":"MASS"}`, "", 400,
+			errors.InvalidParam{Param: "limit should be at least 1"}.Error()},
+		{"Error case: missing header", companyPath, "", `{"name":"Wipro","category":"MASS"}`, "", 400,
+			errors.MissingParam{Param: []string{"X-College-ID"}}.Error()},
+		{"Error case: response does not match", companyPath, collegeID, `{"name":"Wipro","category":"MASS"}`, `{"category":"MASS"}`, 500,
+			"response does not match the API spec: Missing Parameter: name"},
+	}
+
+	for i, tc := range tests {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(tc.response))
+		})
+
+		req := httptest.NewRequest("PUT", tc.path, strings.NewReader(tc.body))
+		req.Header.Set("X-College-ID", tc.college)
+		resRec := httptest.NewRecorder()
+
+		spec.Middleware(Config{Responses: true})(next).ServeHTTP(resRec, req)
+
+		assert.Equal(t, tc.expCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expBody, resRec.Body.String(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	spec, err := Load([]byte(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op, _ := spec.Find("PUT", companyPath)
+
+	assert.EqualError(t, spec.CheckResponse(op, 404, nil), "status 404 is not documented")
+	assert.NoError(t, spec.CheckResponse(op, 400, []byte("Missing Parameter: name")))
+}
+
+func TestMiddlewareFlush(t *testing.T) {
+	spec, err := Load([]byte(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		description string
+		status      int
+		expCode     int
+		expBody     string
+	}{
+		{"Success case: documented status", http.StatusOK, 200, "data: 1\n\ndata: 2\n\n"},
+		{"Error case: undocumented status", http.StatusNotFound, 500, "response does not match the API spec: status 404 is not documented"},
+	}
+
+	for i, tc := range tests {
+		resRec := httptest.NewRecorder()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tc.status)
+			_, _ = w.Write([]byte("data: 1\n\n"))
+			w.(http.Flusher).Flush()
+
+			// the flushed part is sent before the handler returns
+			assert.True(t, resRec.Flushed, "Test[%d] failed\n(%s)", i, tc.description)
+
+			_, _ = w.Write([]byte("data: 2\n\n"))
+		})
+
+		req := httptest.NewRequest("PUT", companyPath, strings.NewReader(`{"name":"Wipro","category":"MASS"}`))
+		req.Header.Set("X-College-ID", collegeID)
+
+		spec.Middleware(Config{Responses: true})(next).ServeHTTP(resRec, req)
+
+		assert.Equal(t, tc.expCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expBody, resRec.Body.String(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestMiddlewareMaxBody(t *testing.T) {
+	spec, err := Load([]byte(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler called for a request over the body limit")
+	})
+
+	req := httptest.NewRequest("PUT", companyPath, strings.NewReader(`{"name":"Wipro","category":"MASS"}`))
+	req.Header.Set("X-College-ID", collegeID)
+	resRec := httptest.NewRecorder()
+
+	spec.Middleware(Config{MaxBody: 16})(next).ServeHTTP(resRec, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resRec.Code)
+	assert.Equal(t, "request body too large", resRec.Body.String())
+}
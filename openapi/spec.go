@@ -0,0 +1,302 @@
+// Package openapi reads an OpenAPI 3 document and checks requests and responses against it. It
+// understands the part of the format the API description uses: JSON bodies, path, query and header
+// parameters, and schemas built from types, formats, patterns, enums, bounds, required properties,
+// items and $ref.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Spec struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Servers    []Server            `json:"servers,omitempty"`
+	Tags       []Tag               `json:"tags,omitempty"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+
+	routes []route
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// PathItem holds the operations of a path by lower case method.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	Tags        []string            `json:"tags,omitempty"`
+	Summary     string              `json:"summary,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Parameters  []*Parameter        `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	Ref         string  `json:"$ref,omitempty"`
+	In          string  `json:"in,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Description string  `json:"description,omitempty"`
+}
+
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Content     map[string]MediaType `json:"content"`
+	Required    bool                 `json:"required,omitempty"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref         string             `json:"$ref,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Pattern     string             `json:"pattern,omitempty"`
+	Enum        []interface{}      `json:"enum,omitempty"`
+	Minimum     *float64           `json:"minimum,omitempty"`
+	Maximum     *float64           `json:"maximum,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	Example     interface{}        `json:"example,omitempty"`
+}
+
+type Components struct {
+	Schemas    map[string]*Schema    `json:"schemas,omitempty"`
+	Parameters map[string]*Parameter `json:"parameters,omitempty"`
+}
+
+// route is a path template split into segments, where a parameter segment is its name in braces.
+type route struct {
+	path     string
+	segments []string
+}
+
+// Load parses an OpenAPI document and checks that its references resolve.
+func Load(data []byte) (*Spec, error) {
+	var s Spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("openapi: %w", err)
+	}
+
+	for name, sch := range s.Components.Schemas {
+		if err := s.checkRefs(sch); err != nil {
+			return nil, fmt.Errorf("openapi: schema %s: %w", name, err)
+		}
+	}
+
+	for path, item := range s.Paths {
+		for method, op := range item {
+			if err := s.checkOperation(op); err != nil {
+				return nil, fmt.Errorf("openapi: %s %s: %w", method, path, err)
+			}
+		}
+	}
+
+	s.compile()
+
+	return &s, nil
+}
+
+// compile splits the paths into the routes Find matches requests against.
+func (s *Spec) compile() {
+	s.routes = s.routes[:0]
+
+	for path := range s.Paths {
+		s.routes = append(s.routes, route{path: path, segments: strings.Split(strings.Trim(path, "/"), "/")})
+	}
+
+	// Literal segments take precedence over parameters, so /students/export is not /students/{id}.
+	sort.Slice(s.routes, func(i, j int) bool {
+		return s.routes[i].params() < s.routes[j].params() ||
+			s.routes[i].params() == s.routes[j].params() && s.routes[i].path < s.routes[j].path
+	})
+}
+
+// Find returns the operation of method on path, along with the values of its path parameters.
+func (s *Spec) Find(method, path string) (*Operation, map[string]string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for _, r := range s.routes {
+		vars, ok := r.match(segments)
+		if !ok {
+			continue
+		}
+
+		if op, ok := s.Paths[r.path][strings.ToLower(method)]; ok {
+			return op, vars
+		}
+	}
+
+	return nil, nil
+}
+
+// Operations calls fn with every operation of the spec, in path then method order.
+func (s *Spec) Operations(fn func(method, path string, op *Operation)) {
+	paths := make([]string, 0, len(s.Paths))
+	for path := range s.Paths {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		methods := make([]string, 0, len(s.Paths[path]))
+		for method := range s.Paths[path] {
+			methods = append(methods, method)
+		}
+
+		sort.Strings(methods)
+
+		for _, method := range methods {
+			fn(strings.ToUpper(method), path, s.Paths[path][method])
+		}
+	}
+}
+
+func (r route) params() int {
+	n := 0
+
+	for _, seg := range r.segments {
+		if isParam(seg) {
+			n++
+		}
+	}
+
+	return n
+}
+
+func (r route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(r.segments) {
+		return nil, false
+	}
+
+	vars := map[string]string{}
+
+	for i, seg := range r.segments {
+		switch {
+		case isParam(seg) && segments[i] != "":
+			vars[seg[1:len(seg)-1]] = segments[i]
+		case seg != segments[i]:
+			return nil, false
+		}
+	}
+
+	return vars, true
+}
+
+func isParam(seg string) bool {
+	return strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}")
+}
+
+// checkOperation checks that the references of op resolve.
+func (s *Spec) checkOperation(op *Operation) error {
+	var schemas []*Schema
+
+	for _, p := range op.Parameters {
+		p, err := s.parameter(p)
+		if err != nil {
+			return err
+		}
+
+		schemas = append(schemas, p.Schema)
+	}
+
+	if op.RequestBody != nil {
+		for _, media := range op.RequestBody.Content {
+			schemas = append(schemas, media.Schema)
+		}
+	}
+
+	for _, resp := range op.Responses {
+		for _, media := range resp.Content {
+			schemas = append(schemas, media.Schema)
+		}
+	}
+
+	for _, sch := range schemas {
+		if err := s.checkRefs(sch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkRefs checks that the references in sch and the schemas it is made of resolve.
+func (s *Spec) checkRefs(sch *Schema) error {
+	if sch == nil {
+		return nil
+	}
+
+	if sch.Ref != "" {
+		_, err := s.schema(sch)
+
+		return err
+	}
+
+	for _, prop := range sch.Properties {
+		if err := s.checkRefs(prop); err != nil {
+			return err
+		}
+	}
+
+	return s.checkRefs(sch.Items)
+}
+
+// parameter returns p, or the parameter of the components it refers to.
+func (s *Spec) parameter(p *Parameter) (*Parameter, error) {
+	if p.Ref == "" {
+		return p, nil
+	}
+
+	name := strings.TrimPrefix(p.Ref, "#/components/parameters/")
+
+	ref, ok := s.Components.Parameters[name]
+	if !ok || name == p.Ref {
+		return nil, fmt.Errorf("unresolved reference %s", p.Ref)
+	}
+
+	return ref, nil
+}
+
+// schema returns sch, or the schema of the components it refers to.
+func (s *Spec) schema(sch *Schema) (*Schema, error) {
+	for sch.Ref != "" {
+		name := strings.TrimPrefix(sch.Ref, "#/components/schemas/")
+
+		ref, ok := s.Components.Schemas[name]
+		if !ok || name == sch.Ref {
+			return nil, fmt.Errorf("unresolved reference %s", sch.Ref)
+		}
+
+		sch = ref
+	}
+
+	return sch, nil
+}
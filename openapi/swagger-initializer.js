@@ -0,0 +1,12 @@
+// Replaces the initializer of the Swagger UI distribution, which loads the petstore example, to load
+// the spec of the API instead.
+window.onload = function () {
+  window.ui = SwaggerUIBundle({
+    url: SPEC_URL,
+    dom_id: "#swagger-ui",
+    deepLinking: true,
+    presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+    plugins: [SwaggerUIBundle.plugins.DownloadUrl],
+    layout: "StandaloneLayout",
+  });
+};
@@ -0,0 +1,233 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+// Validate checks a value decoded with json.Decoder.UseNumber against sch. A violation is reported
+// as errors.MissingParam, naming every required property missing from an object, or as
+// errors.InvalidParam saying what the value should be. Values are named by their path in the
+// document, such as comp.id. A null property counts as absent.
+func (s *Spec) Validate(sch *Schema, v interface{}, name string) error {
+	sch, err := s.schema(sch)
+	if err != nil {
+		return err
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	if err = s.validateType(sch, v, name); err != nil {
+		return err
+	}
+
+	if len(sch.Enum) != 0 && !inEnum(sch.Enum, v) {
+		return invalid(name, "should be one of "+joinEnum(sch.Enum))
+	}
+
+	return nil
+}
+
+func (s *Spec) validateType(sch *Schema, v interface{}, name string) error {
+	switch sch.Type {
+	case "object":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return invalid(name, "should be an object")
+		}
+
+		return s.validateObject(sch, obj, name)
+	case "array":
+		items, ok := v.([]interface{})
+		if !ok {
+			return invalid(name, "should be an array")
+		}
+
+		if sch.Items == nil {
+			return nil
+		}
+
+		for i, item := range items {
+			if err := s.Validate(sch.Items, item, fmt.Sprintf("%s[%d]", name, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return invalid(name, "should be a string")
+		}
+
+		return validateString(sch, str, name)
+	case "integer", "number":
+		n, ok := v.(json.Number)
+		if !ok {
+			return invalid(name, "should be "+article(sch.Type))
+		}
+
+		return validateNumber(sch, n, name)
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return invalid(name, "should be a boolean")
+		}
+	}
+
+	return nil
+}
+
+func (s *Spec) validateObject(sch *Schema, obj map[string]interface{}, name string) error {
+	var missing []string
+
+	for _, req := range sch.Required {
+		if obj[req] == nil {
+			missing = append(missing, join(name, req))
+		}
+	}
+
+	if len(missing) != 0 {
+		return errors.MissingParam{Param: missing}
+	}
+
+	props := make([]string, 0, len(sch.Properties))
+	for prop := range sch.Properties {
+		props = append(props, prop)
+	}
+
+	sort.Strings(props)
+
+	for _, prop := range props {
+		if err := s.Validate(sch.Properties[prop], obj[prop], join(name, prop)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateString(sch *Schema, str, name string) error {
+	switch sch.Format {
+	case "uuid":
+		if _, err := uuid.Parse(str); err != nil {
+			return invalid(name, "should be a UUID")
+		}
+	case "date":
+		if _, err := time.Parse("2006-01-02", str); err != nil {
+			return invalid(name, "should be a date in YYYY-MM-DD format")
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, str); err != nil {
+			return invalid(name, "should be an RFC 3339 date-time")
+		}
+	}
+
+	if sch.Pattern != "" {
+		re, err := regexp.Compile(sch.Pattern)
+		if err != nil {
+			return err
+		}
+
+		if !re.MatchString(str) {
+			return invalid(name, "should match "+sch.Pattern)
+		}
+	}
+
+	return nil
+}
+
+func validateNumber(sch *Schema, n json.Number, name string) error {
+	if sch.Type == "integer" {
+		if _, err := n.Int64(); err != nil {
+			return invalid(name, "should be an integer")
+		}
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return invalid(name, "should be a number")
+	}
+
+	if sch.Minimum != nil && f < *sch.Minimum {
+		return invalid(name, "should be at least "+strconv.FormatFloat(*sch.Minimum, 'f', -1, 64))
+	}
+
+	if sch.Maximum != nil && f > *sch.Maximum {
+		return invalid(name, "should be at most "+strconv.FormatFloat(*sch.Maximum, 'f', -1, 64))
+	}
+
+	return nil
+}
+
+// parseParam converts the text of a parameter to the type of its schema, for Validate.
+func (s *Spec) parseParam(sch *Schema, val string) interface{} {
+	sch, err := s.schema(sch)
+	if err != nil {
+		return val
+	}
+
+	switch sch.Type {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(val, 64); err == nil {
+			return json.Number(val)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(val); err == nil {
+			return b
+		}
+	}
+
+	return val
+}
+
+func inEnum(enum []interface{}, v interface{}) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == fmt.Sprint(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinEnum(enum []interface{}) string {
+	values := make([]string, len(enum))
+	for i, e := range enum {
+		values[i] = fmt.Sprint(e)
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func article(typ string) string {
+	if typ == "integer" {
+		return "an integer"
+	}
+
+	return "a " + typ
+}
+
+func join(name, prop string) string {
+	if name == "" {
+		return prop
+	}
+
+	return name + "." + prop
+}
+
+func invalid(name, reason string) error {
+	if name == "" {
+		name = "body"
+	}
+
+	return errors.InvalidParam{Param: name + " " + reason}
+}
@@ -0,0 +1,50 @@
+// Package outbox relays the domain events the stores write to the outbox, in the transaction of the
+// change they describe, to the sinks interested in them. An event reaches every sink at least once:
+// it is retried until each sink has accepted it, so sinks should drop events whose ID they have
+// already seen.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/aditi-zs/Placement-API/entities"
+)
+
+// Sink receives the events relayed from the outbox, in the college and season scope of the change.
+type Sink interface {
+	Deliver(ctx context.Context, e *entities.Event) error
+}
+
+// SinkFunc adapts a function, such as an events.Bus Publish or a webhooks.Publisher Handle, to a Sink.
+type SinkFunc func(ctx context.Context, e *entities.Event) error
+
+func (f SinkFunc) Deliver(ctx context.Context, e *entities.Event) error {
+	return f(ctx, e)
+}
+
+// Log is a Sink writing every event as a line of JSON to a writer, such as a file or standard output.
+type Log struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewLog(w io.Writer) *Log {
+	return &Log{w: w}
+}
+
+func (l *Log) Deliver(_ context.Context, e *entities.Event) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, err = l.w.Write(append(line, '\n'))
+
+	return err
+}
@@ -0,0 +1,176 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+const (
+	maxBackoff  = 6 * time.Hour
+	maxErrorLen = 1024
+)
+
+// RelayConfig holds how often the outbox is polled and how failed deliveries are retried. Events are
+// never given up on, since the change they describe is already committed.
+type RelayConfig struct {
+	// Interval is the time between two polls of the outbox.
+	Interval time.Duration
+	// BatchSize is the most events relayed in one poll.
+	BatchSize int
+	// Backoff is the wait before the first retry. It doubles with every further attempt, up to six hours.
+	Backoff time.Duration
+}
+
+type namedSink struct {
+	name string
+	sink Sink
+}
+
+// Relay delivers the pending events of the outbox to the registered sinks. Every sink that accepts an
+// event is recorded, so a retry after another sink failed only goes to the sinks still missing it; a
+// sink may still get an event twice if recording its delivery fails.
+type Relay struct {
+	outbox store.OutboxStore
+	sinks  []namedSink
+	cfg    RelayConfig
+	// now is replaced in tests to control which events are due.
+	now func() time.Time
+}
+
+func NewRelay(outbox store.OutboxStore, cfg RelayConfig) *Relay {
+	return &Relay{outbox: outbox, cfg: cfg, now: time.Now}
+}
+
+// Register adds sink under name, which identifies it in the delivery records and so must stay the
+// same across restarts. Sinks are registered before Run and get events in registration order.
+func (r *Relay) Register(name string, sink Sink) {
+	r.sinks = append(r.sinks, namedSink{name: name, sink: sink})
+}
+
+// Run relays the pending events every Interval until ctx is done.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Dispatch(ctx); err != nil {
+			log.Printf("outbox: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch relays the events pending now once and records the outcome of every attempt.
+func (r *Relay) Dispatch(ctx context.Context) error {
+	pending, err := r.outbox.Pending(ctx, r.now().UTC(), r.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	for i := range pending {
+		if err = r.deliver(ctx, &pending[i]); err != nil {
+			return err
+		}
+
+		if err = r.outbox.Update(ctx, &pending[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver hands entry to every sink that has not accepted it yet and updates it with the outcome:
+// published once every sink has it, retried after a backoff otherwise. Only a failure to record a
+// delivery is returned.
+func (r *Relay) deliver(ctx context.Context, entry *entities.OutboxEntry) error {
+	ctx = scope(ctx, &entry.Event)
+
+	var failed []string
+
+	for _, s := range r.sinks {
+		if contains(entry.Delivered, s.name) {
+			continue
+		}
+
+		if err := s.sink.Deliver(ctx, &entry.Event); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", s.name, err))
+
+			continue
+		}
+
+		if err := r.outbox.MarkDelivered(ctx, entry.Event.ID, s.name, r.now().UTC()); err != nil {
+			return err
+		}
+
+		entry.Delivered = append(entry.Delivered, s.name)
+	}
+
+	now := r.now().UTC()
+	entry.Attempts++
+
+	if len(failed) == 0 {
+		entry.PublishedAt = &now
+		entry.LastError = ""
+
+		return nil
+	}
+
+	entry.LastError = strings.Join(failed, "; ")
+	if len(entry.LastError) > maxErrorLen {
+		entry.LastError = entry.LastError[:maxErrorLen]
+	}
+
+	entry.NextAttemptAt = now.Add(r.backoff(entry.Attempts))
+
+	return nil
+}
+
+// backoff returns the wait after the given number of failed attempts.
+func (r *Relay) backoff(attempts int) time.Duration {
+	wait := r.cfg.Backoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+
+	return wait
+}
+
+// scope returns ctx scoped to the college and season e was recorded in, as the request making the
+// change was.
+func scope(ctx context.Context, e *entities.Event) context.Context {
+	ctx = auth.WithCollege(ctx, e.CollegeID)
+	if e.SeasonID != uuid.Nil {
+		ctx = auth.WithSeason(ctx, e.SeasonID)
+	}
+
+	return ctx
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,127 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+// fakeSink records the events delivered, with the college they were scoped to, and fails with err.
+type fakeSink struct {
+	colleges []uuid.UUID
+	err      error
+}
+
+func (f *fakeSink) Deliver(ctx context.Context, _ *entities.Event) error {
+	college, _ := auth.CollegeFrom(ctx)
+	f.colleges = append(f.colleges, college)
+
+	return f.err
+}
+
+func TestDispatch(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	cfg := RelayConfig{BatchSize: 10, Backoff: time.Minute}
+	collegeID := uuid.New()
+	event := entities.Event{ID: uuid.New(), Type: entities.StudentCreated, CollegeID: collegeID, SeasonID: uuid.New()}
+
+	tests := []struct {
+		description  string
+		attempts     int
+		delivered    []string
+		hookErr      error
+		expBus       int
+		expHooks     int
+		expMarked    []string
+		expNext      time.Time
+		expLastError string
+		expPublished *time.Time
+	}{
+		{"Success case: every sink accepts the event", 0, nil, nil, 1, 1, []string{"bus", "webhooks"},
+			now, "", &now},
+		{"failing sink is retried after the backoff", 0, nil, errors.New("db down"), 1, 1, []string{"bus"},
+			now.Add(time.Minute), "webhooks: db down", nil},
+		{"backoff doubles with every attempt", 2, []string{"bus"}, errors.New("db down"), 0, 1, nil,
+			now.Add(4 * time.Minute), "webhooks: db down", nil},
+		{"retry skips the sinks that have the event", 1, []string{"bus"}, nil, 0, 1, []string{"webhooks"},
+			now, "", &now},
+	}
+
+	for i, tc := range tests {
+		ctrl := gomock.NewController(t)
+		outbox := store.NewMockOutboxStore(ctrl)
+		bus := &fakeSink{}
+		hooks := &fakeSink{err: tc.hookErr}
+
+		entry := entities.OutboxEntry{Event: event, Attempts: tc.attempts, NextAttemptAt: now, Delivered: tc.delivered}
+		exp := entry
+		exp.Attempts, exp.NextAttemptAt, exp.LastError, exp.PublishedAt = tc.attempts+1, tc.expNext, tc.expLastError, tc.expPublished
+		exp.Delivered = append(append([]string{}, tc.delivered...), tc.expMarked...)
+
+		if len(exp.Delivered) == 0 {
+			exp.Delivered = nil
+		}
+
+		outbox.EXPECT().Pending(context.Background(), now, cfg.BatchSize).Return([]entities.OutboxEntry{entry}, nil)
+
+		for _, name := range tc.expMarked {
+			outbox.EXPECT().MarkDelivered(gomock.Any(), event.ID, name, now).Return(nil)
+		}
+
+		outbox.EXPECT().Update(context.Background(), &exp).Return(nil)
+
+		r := NewRelay(outbox, cfg)
+		r.now = func() time.Time { return now }
+		r.Register("bus", bus)
+		r.Register("webhooks", hooks)
+
+		err := r.Dispatch(context.Background())
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Len(t, bus.colleges, tc.expBus, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Len(t, hooks.colleges, tc.expHooks, "Test[%d] failed\n(%s)", i, tc.description)
+
+		for _, college := range append(bus.colleges, hooks.colleges...) {
+			assert.Equal(t, collegeID, college, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestDispatchMarkError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	outbox := store.NewMockOutboxStore(ctrl)
+	entry := entities.OutboxEntry{Event: entities.Event{ID: uuid.New(), CollegeID: uuid.New()}}
+
+	outbox.EXPECT().Pending(context.Background(), gomock.Any(), 10).Return([]entities.OutboxEntry{entry}, nil)
+	outbox.EXPECT().MarkDelivered(gomock.Any(), entry.Event.ID, "bus", gomock.Any()).Return(errors.New("connection refused"))
+
+	r := NewRelay(outbox, RelayConfig{BatchSize: 10})
+	r.Register("bus", &fakeSink{})
+
+	err := r.Dispatch(context.Background())
+
+	assert.EqualError(t, err, "connection refused")
+}
+
+func TestLog(t *testing.T) {
+	var buf bytes.Buffer
+
+	id := uuid.MustParse("0b5d2a34-8f0e-4c0e-9a51-6c1f6f0f4a11")
+	e := entities.Event{ID: id, Type: entities.CompanyCreated, Company: &entities.Company{Name: "Wipro"}}
+
+	err := NewLog(&buf).Deliver(context.Background(), &e)
+
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `"id":"0b5d2a34-8f0e-4c0e-9a51-6c1f6f0f4a11","type":"company.created"`)
+	assert.Equal(t, byte('\n'), buf.Bytes()[buf.Len()-1])
+}
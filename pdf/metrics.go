@@ -0,0 +1,24 @@
+package pdf
+
+// helveticaWidths holds the advance widths of Helvetica for the printable ASCII characters, space
+// to tilde, in thousandths of the font size, from the Adobe font metrics.
+//
+//nolint:gochecknoglobals // lookup table
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+}
+
+// charWidth returns the width of a WinAnsi byte. Characters above the ASCII range are given the
+// width of a digit, which is close to the Helvetica average.
+func charWidth(c byte) int {
+	if c >= ' ' && c <= '~' {
+		return helveticaWidths[c-' ']
+	}
+
+	return helveticaWidths['0'-' ']
+}
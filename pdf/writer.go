@@ -0,0 +1,219 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A4 portrait in points, with one inch margins.
+const (
+	pageWidth  = 595.0
+	pageHeight = 842.0
+	margin     = 72.0
+	textWidth  = pageWidth - 2*margin
+	lineFactor = 1.4
+)
+
+const (
+	headingSize = 16.0
+	textSize    = 11.0
+)
+
+// Document lays out text on A4 pages using the standard Helvetica font, which every PDF reader
+// provides, so no font has to be embedded. Text is encoded as WinAnsi; characters outside that
+// encoding are written as '?'.
+type Document struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+func New() *Document {
+	d := &Document{}
+	d.newPage()
+
+	return d
+}
+
+// Heading writes text in a larger size followed by a blank line.
+func (d *Document) Heading(text string) {
+	d.block(text, headingSize)
+	d.space(textSize)
+}
+
+// Text writes text, wrapping lines at the page width and starting new pages as needed. Every line
+// break in text starts a new line and an empty line leaves a blank line.
+func (d *Document) Text(text string) {
+	d.block(text, textSize)
+}
+
+// WriteTo writes the document to w.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	var (
+		buf     bytes.Buffer
+		offsets []int
+	)
+
+	obj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 to 3 are the catalog, the page tree and the font; each page then takes two objects,
+	// the page itself followed by its content stream.
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+
+	for i, p := range d.pages {
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %g %g] /Resources << /Font << /F1 3 0 R >> >> "+
+			"/Contents %d 0 R >>", pageWidth, pageHeight, 5+2*i))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", p.Len(), p.Bytes()))
+	}
+
+	xref := buf.Len()
+
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.WriteTo(w)
+}
+
+func (d *Document) block(text string, size float64) {
+	for _, para := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		lines := wrap(encode(para), size, textWidth)
+		if len(lines) == 0 {
+			d.space(size)
+		}
+
+		for _, line := range lines {
+			d.line(line, size)
+		}
+	}
+}
+
+func (d *Document) line(text []byte, size float64) {
+	if d.y-size*lineFactor < margin {
+		d.newPage()
+	}
+
+	d.y -= size * lineFactor
+
+	page := d.pages[len(d.pages)-1]
+	fmt.Fprintf(page, "BT /F1 %g Tf %g %g Td (%s) Tj ET\n", size, margin, d.y, escape(text))
+}
+
+func (d *Document) space(size float64) {
+	d.y -= size * lineFactor
+}
+
+func (d *Document) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pageHeight - margin
+}
+
+// wrap splits text into lines no wider than width at the given font size. Words longer than a line
+// are broken at the page width.
+func wrap(text []byte, size, width float64) [][]byte {
+	var (
+		lines [][]byte
+		line  []byte
+	)
+
+	for _, word := range bytes.Fields(text) {
+		candidate := word
+		if len(line) != 0 {
+			candidate = append(append(append([]byte{}, line...), ' '), word...)
+		}
+
+		if textWidthOf(candidate, size) <= width {
+			line = candidate
+			continue
+		}
+
+		if len(line) != 0 {
+			lines = append(lines, line)
+		}
+
+		for textWidthOf(word, size) > width {
+			n := fits(word, size, width)
+			lines = append(lines, word[:n])
+			word = word[n:]
+		}
+
+		line = word
+	}
+
+	if len(line) != 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// fits returns how many leading bytes of word fit in width, at least one.
+func fits(word []byte, size, width float64) int {
+	for n := len(word) - 1; n > 1; n-- {
+		if textWidthOf(word[:n], size) <= width {
+			return n
+		}
+	}
+
+	return 1
+}
+
+func textWidthOf(text []byte, size float64) float64 {
+	var units int
+
+	for _, c := range text {
+		units += charWidth(c)
+	}
+
+	return float64(units) * size / 1000
+}
+
+// encode converts text to WinAnsi. Latin-1 characters map to the same byte; anything else
+// becomes '?'.
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			out = append(out, ' ')
+		case r >= ' ' && r <= '~', r >= 0xa0 && r <= 0xff:
+			out = append(out, byte(r))
+		default:
+			out = append(out, '?')
+		}
+	}
+
+	return out
+}
+
+func escape(text []byte) []byte {
+	var b bytes.Buffer
+
+	for _, c := range text {
+		if c == '(' || c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+
+		b.WriteByte(c)
+	}
+
+	return b.Bytes()
+}
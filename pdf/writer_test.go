@@ -0,0 +1,103 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteTo(t *testing.T) {
+	tests := []struct {
+		description string
+		paragraphs  int
+		pages       int
+	}{
+		{"Success case: empty document", 0, 1},
+		{"Success case: one page", 5, 1},
+		{"Success case: text flows onto new pages", 120, 3},
+	}
+
+	for i, tc := range tests {
+		d := New()
+		d.Heading("Offer Letter")
+
+		for p := 0; p < tc.paragraphs; p++ {
+			d.Text(fmt.Sprintf("Paragraph %d (draft) \\ final", p))
+		}
+
+		var buf bytes.Buffer
+
+		_, err := d.WriteTo(&buf)
+		out := buf.Bytes()
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")), "Test[%d] failed\n(%s)", i, tc.description)
+		assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")), "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.pages, bytes.Count(out, []byte("/Type /Page /Parent")), "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, fmt.Sprintf("/Count %d", tc.pages), regexp.MustCompile(`/Count \d+`).FindString(string(out)),
+			"Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.paragraphs != 0 {
+			assert.Contains(t, string(out), `(Paragraph 0 \(draft\) \\ final) Tj`, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+
+		checkXref(t, out, i, tc.description)
+	}
+}
+
+// checkXref verifies that every cross-reference entry points at the start of its object.
+func checkXref(t *testing.T, out []byte, i int, description string) {
+	t.Helper()
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	if !assert.NotNil(t, m, "Test[%d] failed\n(%s)", i, description) {
+		return
+	}
+
+	start, _ := strconv.Atoi(string(m[1]))
+	lines := strings.Split(string(out[start:]), "\n")
+
+	assert.Equal(t, "xref", lines[0], "Test[%d] failed\n(%s)", i, description)
+
+	for n, entry := range lines[3:] {
+		if !strings.HasSuffix(entry, " n ") {
+			break
+		}
+
+		off, _ := strconv.Atoi(entry[:10])
+		assert.True(t, bytes.HasPrefix(out[off:], []byte(fmt.Sprintf("%d 0 obj\n", n+1))), "Test[%d] failed\n(%s)", i, description)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		description string
+		text        string
+		width       float64
+		expRes      []string
+	}{
+		{"Success case: fits on one line", "Dear Aditi,", 200, []string{"Dear Aditi,"}},
+		{"Success case: wrapped at word boundaries", "we are pleased to offer", 60,
+			[]string{"we are", "pleased to", "offer"}},
+		{"Success case: long word is broken", "0000000000", 30, []string{"00000", "00000"}},
+		{"Success case: blank text", "   ", 100, nil},
+	}
+
+	for i, tc := range tests {
+		var output []string
+		for _, line := range wrap([]byte(tc.text), 10, tc.width) {
+			output = append(output, string(line))
+		}
+
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	assert.Equal(t, []byte("Caf\xe9 ? 12,00,000"), encode("Café ₹ 12,00,000"))
+}
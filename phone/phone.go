@@ -0,0 +1,126 @@
+package phone
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+// DefaultRegion is used for numbers written without a country code when no region is configured.
+const DefaultRegion = "IN"
+
+const minE164Digits, maxE164Digits = 8, 15
+
+// plan describes the numbering plan of a region: its country calling code and the allowed
+// lengths of the national significant number.
+type plan struct {
+	code           string
+	minLen, maxLen int
+}
+
+//nolint:gochecknoglobals // read-only lookup table
+var plans = map[string]plan{
+	"IN": {code: "91", minLen: 10, maxLen: 10},
+	"US": {code: "1", minLen: 10, maxLen: 10},
+	"CA": {code: "1", minLen: 10, maxLen: 10},
+	"GB": {code: "44", minLen: 10, maxLen: 10},
+	"AU": {code: "61", minLen: 9, maxLen: 9},
+	"SG": {code: "65", minLen: 8, maxLen: 8},
+	"AE": {code: "971", minLen: 8, maxLen: 9},
+	"DE": {code: "49", minLen: 6, maxLen: 13},
+}
+
+// IsRegion reports whether region is a supported ISO 3166-1 alpha-2 region code.
+func IsRegion(region string) bool {
+	_, ok := plans[strings.ToUpper(region)]
+
+	return ok
+}
+
+// Normalize parses a phone number and returns it in E.164 form, e.g. +916388768118.
+// Numbers starting with + or 00 are read as international, anything else as a national number
+// of region. Spaces, dashes, dots and brackets are ignored; any other character is rejected.
+func Normalize(raw, region string) (string, error) {
+	digits, international, err := clean(raw)
+	if err != nil {
+		return "", err
+	}
+
+	p, ok := plans[strings.ToUpper(region)]
+	if !ok {
+		return "", errors.InvalidParam{Param: fmt.Sprintf("unsupported phone region %q", region)}
+	}
+
+	if !international {
+		digits = strings.TrimPrefix(digits, "0")
+
+		// numbers already carrying the country code, such as 916388768118, were accepted before
+		// normalization was introduced
+		if len(digits) > p.maxLen && strings.HasPrefix(digits, p.code) {
+			digits = digits[len(p.code):]
+		}
+
+		if len(digits) < p.minLen || len(digits) > p.maxLen {
+			return "", errors.InvalidParam{Param: fmt.Sprintf("phone number is not a valid %s number", strings.ToUpper(region))}
+		}
+
+		return "+" + p.code + digits, nil
+	}
+
+	if len(digits) < minE164Digits || len(digits) > maxE164Digits {
+		return "", errors.InvalidParam{Param: "phone number should have 8 to 15 digits including the country code"}
+	}
+
+	// lengths are only checked for the country codes in plans, other codes are accepted as is
+	code := ""
+
+	for _, known := range plans {
+		if !strings.HasPrefix(digits, known.code) {
+			continue
+		}
+
+		if n := len(digits) - len(known.code); n >= known.minLen && n <= known.maxLen {
+			return "+" + digits, nil
+		}
+
+		code = known.code
+	}
+
+	if code != "" {
+		return "", errors.InvalidParam{Param: fmt.Sprintf("phone number is not valid for country code +%s", code)}
+	}
+
+	return "+" + digits, nil
+}
+
+// clean strips the formatting characters from raw and reports whether it was written in
+// international form.
+func clean(raw string) (digits string, international bool, err error) {
+	s := strings.TrimSpace(raw)
+
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s, international = s[1:], true
+	case strings.HasPrefix(s, "00"):
+		s, international = s[2:], true
+	}
+
+	var b strings.Builder
+
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return "", false, errors.InvalidParam{Param: "phone number should contain only digits, spaces, dashes, brackets and a leading +"}
+		}
+	}
+
+	if b.Len() == 0 {
+		return "", false, errors.InvalidParam{Param: "phone number should contain only digits, spaces, dashes, brackets and a leading +"}
+	}
+
+	return b.String(), international, nil
+}
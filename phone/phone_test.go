@@ -0,0 +1,54 @@
+package phone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		region      string
+		expRes      string
+		expErr      error
+	}{
+		{"Success case: national number", "6388768118", "IN", "+916388768118", nil},
+		{"Success case: formatted national number", "(638) 876-8118", "IN", "+916388768118", nil},
+		{"Success case: trunk prefix", "06388768118", "IN", "+916388768118", nil},
+		{"Success case: country code without +", "916388768118", "IN", "+916388768118", nil},
+		{"Success case: international number", "+91 63887 68118", "IN", "+916388768118", nil},
+		{"Success case: 00 prefix", "0091 6388768118", "IN", "+916388768118", nil},
+		{"Success case: other default region", "020 7946 0018", "gb", "+442079460018", nil},
+		{"Success case: foreign number with another default region", "+1 415 555 2671", "IN", "+14155552671", nil},
+		{"Success case: unknown country code", "+8613800138000", "IN", "+8613800138000", nil},
+		{"Error case: letters", "63887abc18", "IN", "",
+			errors.InvalidParam{Param: "phone number should contain only digits, spaces, dashes, brackets and a leading +"}},
+		{"Error case: empty", " ", "IN", "",
+			errors.InvalidParam{Param: "phone number should contain only digits, spaces, dashes, brackets and a leading +"}},
+		{"Error case: too short for region", "638876811", "IN", "",
+			errors.InvalidParam{Param: "phone number is not a valid IN number"}},
+		{"Error case: wrong length for country code", "+91 638876811", "IN", "",
+			errors.InvalidParam{Param: "phone number is not valid for country code +91"}},
+		{"Error case: too long", "+1234567890123456", "IN", "",
+			errors.InvalidParam{Param: "phone number should have 8 to 15 digits including the country code"}},
+		{"Error case: unsupported region", "6388768118", "XX", "",
+			errors.InvalidParam{Param: `unsupported phone region "XX"`}},
+	}
+
+	for i, tc := range tests {
+		res, err := Normalize(tc.input, tc.region)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, res, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestIsRegion(t *testing.T) {
+	assert.True(t, IsRegion("IN"))
+	assert.True(t, IsRegion("us"))
+	assert.False(t, IsRegion("XX"))
+}
@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the time between two removals of the buckets that have refilled.
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	// updated is when tokens was last computed.
+	updated time.Time
+	// full is when the bucket refills, after which it is the same as no bucket at all.
+	full time.Time
+}
+
+// Memory is an in-process Limiter.
+type Memory struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+	// now is replaced in tests to refill the buckets.
+	now func() time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{buckets: make(map[string]*bucket), now: time.Now}
+}
+
+func (m *Memory) Allow(_ context.Context, key string, limit Limit) Result {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	// rate is the tokens added per second.
+	rate := float64(limit.Requests) / limit.Per.Seconds()
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Requests), updated: now}
+		m.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(limit.Requests), b.tokens+now.Sub(b.updated).Seconds()*rate)
+	b.updated = now
+
+	res := Result{Allowed: b.tokens >= 1}
+	if res.Allowed {
+		b.tokens--
+	} else {
+		res.RetryAfter = fromSeconds((1 - b.tokens) / rate)
+	}
+
+	res.Remaining = int(b.tokens)
+	res.Reset = fromSeconds((float64(limit.Requests) - b.tokens) / rate)
+	b.full = now.Add(res.Reset)
+
+	return res
+}
+
+// sweep removes the buckets that have refilled, at most once every sweepInterval. It is called with
+// mu held.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.swept) < sweepInterval {
+		return
+	}
+
+	for key, b := range m.buckets {
+		if !now.Before(b.full) {
+			delete(m.buckets, key)
+		}
+	}
+
+	m.swept = now
+}
+
+// fromSeconds converts a number of seconds to a Duration.
+func fromSeconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
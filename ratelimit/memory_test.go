@@ -0,0 +1,43 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryAllow(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	limit := Limit{Requests: 2, Per: 10 * time.Second}
+
+	m := NewMemory()
+	m.now = func() time.Time { return now }
+
+	tests := []struct {
+		description string
+		key         string
+		elapsed     time.Duration
+		expRes      Result
+	}{
+		{"Success case: full bucket", "a", 0, Result{Allowed: true, Remaining: 1, Reset: 5 * time.Second}},
+		{"Success case: last token", "a", 0, Result{Allowed: true, Remaining: 0, Reset: 10 * time.Second}},
+		{"Error case: empty bucket", "a", time.Second, Result{Remaining: 0, RetryAfter: 4 * time.Second, Reset: 9 * time.Second}},
+		{"Success case: other client", "b", 0, Result{Allowed: true, Remaining: 1, Reset: 5 * time.Second}},
+		{"Success case: refilled token", "a", 4 * time.Second, Result{Allowed: true, Remaining: 0, Reset: 10 * time.Second}},
+	}
+
+	for i, tc := range tests {
+		now = now.Add(tc.elapsed)
+
+		res := m.Allow(context.Background(), tc.key, limit)
+
+		assert.Equal(t, tc.expRes, res, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+
+	now = now.Add(time.Minute)
+	m.Allow(context.Background(), "c", limit)
+
+	assert.Len(t, m.buckets, 1, "refilled buckets should be swept")
+}
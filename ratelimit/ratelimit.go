@@ -0,0 +1,152 @@
+// Package ratelimit throttles clients with token buckets: a client may send Limit.Requests requests
+// at once and gets them back at an even rate over Limit.Per.
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aditi-zs/Placement-API/auth"
+)
+
+// Limit is the size of a bucket and the time it takes to refill from empty. The zero Limit lets
+// every request through.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// Result is the outcome of taking a token from a bucket.
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is the wait before the next token, set when the request is not allowed.
+	RetryAfter time.Duration
+	// Reset is the wait until the bucket is full again.
+	Reset time.Duration
+}
+
+// Limiter keeps the buckets of the clients. Memory keeps them in process; a shared limiter lets
+// several servers enforce one limit. A limiter that cannot be reached should allow the request, so
+// an outage of the limiter does not take the API down with it.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) Result
+}
+
+// Middleware throttles the requests of a route group to limit; every group has its own buckets.
+// authenticate is the middleware authenticating the group's requests, and a client is the principal
+// it authenticated the request as. Requests without an API key or with one authenticate turned down
+// are charged to the peer's address, so made up keys do not get buckets of their own. Requests over
+// the limit get 429 with Retry-After, and every response carries the RateLimit-Limit,
+// RateLimit-Remaining and RateLimit-Reset headers.
+func Middleware(l Limiter, group string, limit Limit, authenticate func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limit.Requests == 0 {
+			return authenticate(next)
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var (
+				authenticated bool
+				rejected      = rejection{header: http.Header{}}
+			)
+
+			authenticate(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				authenticated = true
+
+				if allow(w, r, l, group+":"+client(r), limit) {
+					next.ServeHTTP(w, r)
+				}
+			})).ServeHTTP(&rejected, r)
+
+			if authenticated || !allow(w, r, l, group+":ip:"+peer(r), limit) {
+				return
+			}
+
+			for k, v := range rejected.header {
+				w.Header()[k] = v
+			}
+
+			if rejected.status != 0 {
+				w.WriteHeader(rejected.status)
+			}
+
+			_, _ = w.Write(rejected.body.Bytes())
+		})
+	}
+}
+
+// allow takes a token from the bucket of key and sets the rate limit headers. It answers the request
+// with 429 and returns false when the bucket is empty.
+func allow(w http.ResponseWriter, r *http.Request, l Limiter, key string, limit Limit) bool {
+	res := l.Allow(r.Context(), key, limit)
+
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(limit.Requests))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+	w.Header().Set("RateLimit-Reset", seconds(res.Reset))
+
+	if !res.Allowed {
+		w.Header().Set("Retry-After", seconds(res.RetryAfter))
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write([]byte("rate limit exceeded"))
+
+		return false
+	}
+
+	return true
+}
+
+// client returns the key of the authenticated client sending r: its principal, or its address when
+// authentication named none.
+func client(r *http.Request) string {
+	if p, ok := auth.PrincipalFrom(r.Context()); ok {
+		return "principal:" + p
+	}
+
+	return "ip:" + peer(r)
+}
+
+// peer returns the address of the peer sending r. Behind a proxy, every client shares the proxy's
+// limit.
+func peer(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// rejection holds the response authentication turned a request down with until the client's address
+// has been charged for it.
+type rejection struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *rejection) Header() http.Header {
+	return r.header
+}
+
+func (r *rejection) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *rejection) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+
+	return r.body.Write(b)
+}
+
+// seconds formats d as whole seconds, rounded up so clients do not retry too early.
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,106 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+)
+
+// fakeLimiter records the keys it is asked about and returns res.
+type fakeLimiter struct {
+	keys []string
+	res  Result
+}
+
+func (f *fakeLimiter) Allow(_ context.Context, key string, _ Limit) Result {
+	f.keys = append(f.keys, key)
+
+	return f.res
+}
+
+// authenticate accepts the API key "secret" as college 1 and turns every other request down.
+func authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-KEY") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte("authentication failed"))
+
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), "college:1")))
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("hello world"))
+	})
+	limit := Limit{Requests: 100, Per: time.Minute}
+	allowed := Result{Allowed: true, Remaining: 99, Reset: 600 * time.Millisecond}
+	denied := Result{RetryAfter: 1500 * time.Millisecond, Reset: time.Minute}
+
+	tests := []struct {
+		description string
+		apiKey      string
+		res         Result
+		expKey      string
+		expCode     int
+		expBody     string
+		expHeaders  map[string]string
+	}{
+		{"Success case: client by principal", "secret", Result{Allowed: true, Remaining: 5, Reset: 57 * time.Second},
+			"admin:principal:college:1", 200, "hello world", map[string]string{"RateLimit-Remaining": "5", "RateLimit-Reset": "57"}},
+		{"Success case: no key is charged to the IP", "", allowed, "admin:ip:192.0.2.1", 401, "authentication failed",
+			map[string]string{"RateLimit-Limit": "100", "RateLimit-Remaining": "99", "RateLimit-Reset": "1", "Retry-After": ""}},
+		{"Success case: an invalid key is charged to the IP", "guess", allowed, "admin:ip:192.0.2.1", 401,
+			"authentication failed", map[string]string{"RateLimit-Remaining": "99"}},
+		{"Error case: throttled principal", "secret", denied, "admin:principal:college:1", 429, "rate limit exceeded",
+			map[string]string{"RateLimit-Remaining": "0", "RateLimit-Reset": "60", "Retry-After": "2"}},
+		{"Error case: throttled IP", "guess", denied, "admin:ip:192.0.2.1", 429, "rate limit exceeded",
+			map[string]string{"RateLimit-Remaining": "0", "RateLimit-Reset": "60", "Retry-After": "2"}},
+	}
+
+	for i, tc := range tests {
+		limiter := &fakeLimiter{res: tc.res}
+		req := httptest.NewRequest("GET", "/students", nil)
+		req.Header.Set("X-API-KEY", tc.apiKey)
+		resRec := httptest.NewRecorder()
+
+		Middleware(limiter, "admin", limit, authenticate)(next).ServeHTTP(resRec, req)
+
+		assert.Equal(t, []string{tc.expKey}, limiter.keys, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expCode, resRec.Code, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expBody, resRec.Body.String(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		for k, v := range tc.expHeaders {
+			assert.Equal(t, v, resRec.Header().Get(k), "Test[%d] failed\n(%s): header %s", i, tc.description, k)
+		}
+	}
+}
+
+func TestMiddlewareWithoutLimit(t *testing.T) {
+	limiter := &fakeLimiter{}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	resRec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/colleges", nil)
+	req.Header.Set("X-API-KEY", "secret")
+
+	Middleware(limiter, "public", Limit{}, authenticate)(next).ServeHTTP(resRec, req)
+
+	assert.Equal(t, 200, resRec.Code)
+	assert.Empty(t, limiter.keys, "a group without a limit should not be throttled")
+
+	resRec = httptest.NewRecorder()
+
+	Middleware(limiter, "public", Limit{}, authenticate)(next).ServeHTTP(resRec, httptest.NewRequest("GET", "/colleges", nil))
+
+	assert.Equal(t, 401, resRec.Code, "requests are still authenticated")
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/aditi-zs/Placement-API/config"
+	collegeHandler "github.com/aditi-zs/Placement-API/delivery/college"
+	companyHandler "github.com/aditi-zs/Placement-API/delivery/company"
+	documentHandler "github.com/aditi-zs/Placement-API/delivery/document"
+	driveHandler "github.com/aditi-zs/Placement-API/delivery/drive"
+	eventHandler "github.com/aditi-zs/Placement-API/delivery/event"
+	letterHandler "github.com/aditi-zs/Placement-API/delivery/letter"
+	offerHandler "github.com/aditi-zs/Placement-API/delivery/offer"
+	recruiterHandler "github.com/aditi-zs/Placement-API/delivery/recruiter"
+	reportHandler "github.com/aditi-zs/Placement-API/delivery/report"
+	seasonHandler "github.com/aditi-zs/Placement-API/delivery/season"
+	studentHandler "github.com/aditi-zs/Placement-API/delivery/student"
+	webhookHandler "github.com/aditi-zs/Placement-API/delivery/webhook"
+	"github.com/aditi-zs/Placement-API/ratelimit"
+	"github.com/aditi-zs/Placement-API/service"
+)
+
+// services holds the services the API routes are handled by.
+type services struct {
+	College   service.CollegeSvc
+	Season    service.SeasonSvc
+	Company   service.CompanySvc
+	Student   service.StudentSvc
+	Document  service.DocumentSvc
+	Drive     service.DriveSvc
+	Offer     service.OfferSvc
+	Letter    service.LetterSvc
+	Report    service.ReportSvc
+	Recruiter service.RecruiterSvc
+	Webhook   service.WebhookSvc
+	Event     service.EventSvc
+}
+
+// routeConfig holds the settings of the API routes and the middlewares they share.
+type routeConfig struct {
+	OperatorKey     string
+	RateLimit       config.RateLimitConfig
+	MaxDocumentSize int64
+	Limiter         ratelimit.Limiter
+	// Validate checks requests against the API spec and Idempotent replays POST requests retried with
+	// an Idempotency-Key.
+	Validate   func(http.Handler) http.Handler
+	Idempotent func(http.Handler) http.Handler
+}
+
+// addRoutes registers the API routes on router. Every route must be documented in api/openapi.json.
+// The admin group matches every path, so routes outside the API are registered on router before.
+func addRoutes(router *mux.Router, svc *services, cfg *routeConfig) {
+	colHandler := collegeHandler.New(svc.College)
+	seaHandler := seasonHandler.New(svc.Season)
+	cmpHandler := companyHandler.New(svc.Company)
+	stuHandler := studentHandler.New(svc.Student)
+	drvHandler := driveHandler.New(svc.Drive)
+	ofrHandler := offerHandler.New(svc.Offer)
+	ltrHandler := letterHandler.New(svc.Letter)
+	docHandler := documentHandler.New(svc.Document, cfg.MaxDocumentSize)
+	rptHandler := reportHandler.New(svc.Report)
+	recHandler := recruiterHandler.New(svc.Recruiter)
+	hookHandler := webhookHandler.New(svc.Webhook)
+	evtHandler := eventHandler.New(svc.Event)
+
+	// Every route group is throttled on its own: clients are told apart by the college, recruiter or
+	// operator their API key authenticates them as, and by their address otherwise. Requests are
+	// checked against the API spec once the client is authenticated. Colleges are managed by the
+	// operator of the deployment with the operator API key.
+	colleges := router.PathPrefix("/colleges").Subrouter()
+	colleges.Use(ratelimit.Middleware(cfg.Limiter, "public", cfg.RateLimit.Public, collegeHandler.Operator(cfg.OperatorKey)), cfg.Validate)
+	colleges.HandleFunc("", colHandler.Get).Methods("GET")
+	colleges.HandleFunc("/{id}", colHandler.GetByID).Methods("GET")
+	colleges.HandleFunc("", colHandler.Create).Methods("POST")
+	colleges.HandleFunc("/{id}/key", colHandler.RotateKey).Methods("POST")
+
+	// Everything else is college data: admin routes are scoped by the college's API key and the
+	// recruiter portal by the recruiter's API key. Within a college, data is scoped to the season in
+	// the season query parameter or the current one. The portal goes first, as the admin subrouter
+	// matches every path.
+	portal := router.PathPrefix("/recruiter").Subrouter()
+	portal.Use(ratelimit.Middleware(cfg.Limiter, "portal", cfg.RateLimit.Portal, recHandler.Authenticate), cfg.Validate, seaHandler.Scope,
+		cfg.Idempotent)
+	portal.HandleFunc("/drives", recHandler.Drives).Methods("GET")
+	portal.HandleFunc("/applicants", recHandler.Applicants).Methods("GET")
+	portal.HandleFunc("/applicants/{studentId}/shortlist", recHandler.Shortlist).Methods("POST")
+	portal.HandleFunc("/applicants/{studentId}/status", recHandler.SetStatus).Methods("PUT")
+	portal.HandleFunc("/drives/{id}/rounds/{round}/results/{studentId}", recHandler.RecordResult).Methods("PUT")
+
+	admin := router.NewRoute().Subrouter()
+	admin.Use(ratelimit.Middleware(cfg.Limiter, "admin", cfg.RateLimit.Admin, colHandler.Authenticate), cfg.Validate, cfg.Idempotent)
+	admin.HandleFunc("/seasons", seaHandler.Get).Methods("GET")
+	admin.HandleFunc("/seasons/{id}", seaHandler.GetByID).Methods("GET")
+	admin.HandleFunc("/seasons", seaHandler.Create).Methods("POST")
+	admin.HandleFunc("/seasons/rollover", seaHandler.Rollover).Methods("POST")
+	admin.HandleFunc("/webhooks", hookHandler.Get).Methods("GET")
+	admin.HandleFunc("/webhooks/{id}", hookHandler.GetByID).Methods("GET")
+	admin.HandleFunc("/webhooks", hookHandler.Create).Methods("POST")
+	admin.HandleFunc("/webhooks/{id}", hookHandler.Delete).Methods("DELETE")
+	admin.HandleFunc("/webhooks/{id}/deliveries", hookHandler.GetDeliveries).Methods("GET")
+	admin.HandleFunc("/webhooks/{id}/deliveries/{deliveryId}/redeliver", hookHandler.Redeliver).Methods("POST")
+
+	seasonal := admin.NewRoute().Subrouter()
+	seasonal.Use(seaHandler.Scope)
+	seasonal.HandleFunc("/companies", cmpHandler.Get).Methods("GET")
+	seasonal.HandleFunc("/companies/{id}", cmpHandler.GetByID).Methods("GET")
+	seasonal.HandleFunc("/companies", cmpHandler.Create).Methods("POST")
+	seasonal.HandleFunc("/companies/{id}", cmpHandler.Update).Methods("PUT")
+	seasonal.HandleFunc("/companies/{id}", cmpHandler.Patch).Methods("PATCH")
+	seasonal.HandleFunc("/companies/{id}", cmpHandler.Delete).Methods("DELETE")
+	seasonal.HandleFunc("/companies/{id}/rounds", cmpHandler.GetRounds).Methods("GET")
+	seasonal.HandleFunc("/companies/{id}/rounds", cmpHandler.SetRounds).Methods("PUT")
+	seasonal.HandleFunc("/companies/{id}/recruiters", recHandler.Get).Methods("GET")
+	seasonal.HandleFunc("/companies/{id}/recruiters", recHandler.Create).Methods("POST")
+	seasonal.HandleFunc("/recruiters/{id}", recHandler.Delete).Methods("DELETE")
+
+	seasonal.HandleFunc("/students", stuHandler.Get).Methods("GET")
+	seasonal.HandleFunc("/students/export", stuHandler.Export).Methods("GET")
+	seasonal.HandleFunc("/students/{id}", stuHandler.GetByID).Methods("GET")
+	seasonal.HandleFunc("/students", stuHandler.Create).Methods("POST")
+	seasonal.HandleFunc("/students/import", stuHandler.Import).Methods("POST")
+	seasonal.HandleFunc("/students/{id}", stuHandler.Update).Methods("PUT")
+	seasonal.HandleFunc("/students/{id}", stuHandler.Patch).Methods("PATCH")
+	seasonal.HandleFunc("/students/{id}", stuHandler.Delete).Methods("DELETE")
+	seasonal.HandleFunc("/students/{id}/merge/{otherId}", stuHandler.Merge).Methods("POST")
+	seasonal.HandleFunc("/students/{id}/documents", docHandler.Get).Methods("GET")
+	seasonal.HandleFunc("/students/{id}/documents", docHandler.Upload).Methods("POST")
+	seasonal.HandleFunc("/students/{id}/documents/{documentId}", docHandler.Download).Methods("GET")
+	seasonal.HandleFunc("/students/{id}/documents/{documentId}", docHandler.Delete).Methods("DELETE")
+
+	seasonal.HandleFunc("/drives", drvHandler.Get).Methods("GET")
+	seasonal.HandleFunc("/drives/{id}", drvHandler.GetByID).Methods("GET")
+	seasonal.HandleFunc("/drives", drvHandler.Create).Methods("POST")
+	seasonal.HandleFunc("/drives/{id}", drvHandler.Update).Methods("PUT")
+	seasonal.HandleFunc("/drives/{id}", drvHandler.Delete).Methods("DELETE")
+	seasonal.HandleFunc("/drives/{id}/registrations", drvHandler.GetRegistrations).Methods("GET")
+	seasonal.HandleFunc("/drives/{id}/registrations", drvHandler.Register).Methods("POST")
+	seasonal.HandleFunc("/drives/{id}/results", drvHandler.GetResults).Methods("GET")
+	seasonal.HandleFunc("/drives/{id}/rounds/{round}/results/{studentId}", drvHandler.RecordResult).Methods("PUT")
+
+	seasonal.HandleFunc("/offers", ofrHandler.Get).Methods("GET")
+	seasonal.HandleFunc("/offers/{id}", ofrHandler.GetByID).Methods("GET")
+	seasonal.HandleFunc("/offers", ofrHandler.Create).Methods("POST")
+	seasonal.HandleFunc("/offers/{id}/accept", ofrHandler.Accept).Methods("POST")
+	seasonal.HandleFunc("/offers/{id}/decline", ofrHandler.Decline).Methods("POST")
+	seasonal.HandleFunc("/offers/{id}/letter.pdf", ofrHandler.Letter).Methods("GET")
+
+	seasonal.HandleFunc("/letter-templates", ltrHandler.Get).Methods("GET")
+	seasonal.HandleFunc("/letter-templates/{id}", ltrHandler.GetByID).Methods("GET")
+	seasonal.HandleFunc("/letter-templates", ltrHandler.Create).Methods("POST")
+	seasonal.HandleFunc("/letter-templates/{id}", ltrHandler.Update).Methods("PUT")
+	seasonal.HandleFunc("/letter-templates/{id}", ltrHandler.Delete).Methods("DELETE")
+
+	seasonal.HandleFunc("/reports/summary", rptHandler.Summary).Methods("GET")
+
+	seasonal.HandleFunc("/events/stream", evtHandler.Stream).Methods("GET")
+}
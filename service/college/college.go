@@ -0,0 +1,88 @@
+package college
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+type handler struct {
+	store store.CollegeStore
+	// now is replaced in tests to fix the creation time of colleges.
+	now func() time.Time
+}
+
+//nolint:revive // it's a factory function
+func New(s store.CollegeStore) handler {
+	return handler{store: s, now: time.Now}
+}
+
+func (h handler) Get(ctx context.Context) ([]entities.College, error) {
+	return h.store.Get(ctx)
+}
+
+func (h handler) GetByID(ctx context.Context, id uuid.UUID) (entities.College, error) {
+	return h.store.GetByID(ctx, id)
+}
+
+// Create adds a college with a new API key for its admin requests. The key is only returned here.
+func (h handler) Create(ctx context.Context, c *entities.College) (entities.College, error) {
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return entities.College{}, errors.MissingParam{Param: []string{"name"}}
+	}
+
+	key, hash, err := auth.NewKey()
+	if err != nil {
+		return entities.College{}, err
+	}
+
+	c.CreatedAt = h.now()
+
+	resp, err := h.store.Create(ctx, c, hash)
+	if err != nil {
+		return entities.College{}, err
+	}
+
+	resp.APIKey = key
+
+	return resp, nil
+}
+
+// RotateKey issues the college a new API key, which replaces the previous one. The key is only
+// returned here.
+func (h handler) RotateKey(ctx context.Context, id uuid.UUID) (entities.College, error) {
+	c, err := h.store.GetByID(ctx, id)
+	if err != nil {
+		return entities.College{}, err
+	}
+
+	key, hash, err := auth.NewKey()
+	if err != nil {
+		return entities.College{}, err
+	}
+
+	if err = h.store.SetKeyHash(ctx, id, hash); err != nil {
+		return entities.College{}, err
+	}
+
+	c.APIKey = key
+
+	return c, nil
+}
+
+// Authenticate returns the college owning key.
+func (h handler) Authenticate(ctx context.Context, key string) (entities.College, error) {
+	if key == "" {
+		return entities.College{}, errors.MissingParam{Param: []string{"X-API-KEY"}}
+	}
+
+	return h.store.GetByKeyHash(ctx, auth.HashKey(key))
+}
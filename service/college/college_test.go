@@ -0,0 +1,129 @@
+package college
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func initializeTest(t *testing.T) *store.MockCollegeStore {
+	ctrl := gomock.NewController(t)
+
+	return store.NewMockCollegeStore(ctrl)
+}
+
+func TestCreate(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		input       entities.College
+		createTimes int
+		createErr   error
+		expErr      error
+	}{
+		{"Success case", entities.College{Name: " RVCE "}, 1, nil, nil},
+		{"Error case: blank name", entities.College{Name: "  "}, 0, nil, errors.MissingParam{Param: []string{"name"}}},
+		{"Error case: name taken", entities.College{Name: "RVCE"}, 1, errors.Conflict{Reason: "exists"},
+			errors.Conflict{Reason: "exists"}},
+	}
+
+	for i, tc := range tests {
+		mockStore := initializeTest(t)
+
+		var hash string
+
+		mockStore.EXPECT().Create(context.Background(), &entities.College{Name: "RVCE", CreatedAt: now}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, c *entities.College, keyHash string) (entities.College, error) {
+				hash = keyHash
+				return *c, tc.createErr
+			}).Times(tc.createTimes)
+
+		h := New(mockStore)
+		h.now = func() time.Time { return now }
+
+		c := tc.input
+		output, err := h.Create(context.Background(), &c)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, auth.HashKey(output.APIKey), hash, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestRotateKey(t *testing.T) {
+	id := uuid.New()
+	notFound := errors.EntityNotFound{Reason: "id not found: " + id.String()}
+
+	tests := []struct {
+		description string
+		getErr      error
+		setTimes    int
+		setErr      error
+		expErr      error
+	}{
+		{"Success case", nil, 1, nil, nil},
+		{"Error case: unknown college", notFound, 0, nil, notFound},
+		{"Error case: update fails", nil, 1, errors.DB{Reason: "server error"}, errors.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		mockStore := initializeTest(t)
+
+		var hash string
+
+		mockStore.EXPECT().GetByID(context.Background(), id).Return(entities.College{ID: id, Name: "RVCE"}, tc.getErr)
+		mockStore.EXPECT().SetKeyHash(context.Background(), id, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, h string) error {
+				hash = h
+				return tc.setErr
+			}).Times(tc.setTimes)
+
+		output, err := New(mockStore).RotateKey(context.Background(), id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, auth.HashKey(output.APIKey), hash, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		key         string
+		getTimes    int
+		getErr      error
+		expErr      error
+	}{
+		{"Success case", "s3cret", 1, nil, nil},
+		{"Error case: no key", "", 0, nil, errors.MissingParam{Param: []string{"X-API-KEY"}}},
+		{"Error case: unknown key", "s3cret", 1, errors.EntityNotFound{Reason: "unknown api key"},
+			errors.EntityNotFound{Reason: "unknown api key"}},
+	}
+
+	for i, tc := range tests {
+		mockStore := initializeTest(t)
+
+		mockStore.EXPECT().GetByKeyHash(context.Background(), auth.HashKey(tc.key)).Return(entities.College{ID: id}, tc.getErr).
+			Times(tc.getTimes)
+
+		_, err := New(mockStore).Authenticate(context.Background(), tc.key)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
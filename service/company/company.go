@@ -2,12 +2,15 @@ package company
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"reflect"
 
 	"github.com/google/uuid"
 
 	"github.com/aditi-zs/Placement-API/entities"
 	errors2 "github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/mergepatch"
 	"github.com/aditi-zs/Placement-API/store"
 )
 
@@ -42,6 +45,10 @@ func (c handler) Create(ctx context.Context, cmp entities.Company) (entities.Com
 		return entities.Company{}, errors2.InvalidParam{Param: "invalid category"}
 	}
 
+	if err := validateCriteria(cmp); err != nil {
+		return entities.Company{}, err
+	}
+
 	resp, err := c.datastore.Create(ctx, cmp)
 	if err != nil {
 		return entities.Company{}, err
@@ -55,6 +62,10 @@ func (c handler) Update(ctx context.Context, id uuid.UUID, cmp entities.Company)
 		return entities.Company{}, errors2.InvalidParam{Param: "invalid category"}
 	}
 
+	if err := validateCriteria(cmp); err != nil {
+		return entities.Company{}, err
+	}
+
 	resp, err := c.datastore.Update(ctx, id, cmp)
 	if err != nil {
 		return entities.Company{}, err
@@ -63,6 +74,66 @@ func (c handler) Update(ctx context.Context, id uuid.UUID, cmp entities.Company)
 	return resp, nil
 }
 
+func (c handler) Patch(ctx context.Context, id uuid.UUID, patch []byte) (entities.Company, error) {
+	existing, err := c.datastore.GetByID(ctx, id)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
+	doc, err := json.Marshal(existing)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
+	merged, err := mergepatch.Apply(doc, patch)
+	if err != nil {
+		return entities.Company{}, errors2.InvalidParam{Param: "invalid merge patch document"}
+	}
+
+	var cmp entities.Company
+	if err = json.Unmarshal(merged, &cmp); err != nil {
+		return entities.Company{}, errors2.InvalidParam{Param: "invalid merge patch document"}
+	}
+
+	cmp.ID = id
+
+	if cmp.Name == "" {
+		return entities.Company{}, errors2.MissingParam{Param: []string{"name"}}
+	}
+
+	if err = validateCompany(cmp); err != nil {
+		return entities.Company{}, errors2.InvalidParam{Param: "invalid category"}
+	}
+
+	if err = validateCriteria(cmp); err != nil {
+		return entities.Company{}, err
+	}
+
+	fields := make(map[string]interface{})
+
+	if existing.Name != cmp.Name {
+		fields["name"] = cmp.Name
+	}
+
+	if existing.Category != cmp.Category {
+		fields["category"] = cmp.Category
+	}
+
+	if !reflect.DeepEqual(existing.Criteria, cmp.Criteria) {
+		fields["criteria"] = cmp.Criteria
+	}
+
+	if len(fields) == 0 {
+		return cmp, nil
+	}
+
+	if err = c.datastore.Patch(ctx, id, fields); err != nil {
+		return entities.Company{}, err
+	}
+
+	return cmp, nil
+}
+
 func (c handler) Delete(ctx context.Context, id uuid.UUID) error {
 	err := c.datastore.Delete(ctx, id)
 	if err != nil {
@@ -72,6 +143,31 @@ func (c handler) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (c handler) GetRounds(ctx context.Context, id uuid.UUID) ([]entities.Round, error) {
+	if _, err := c.datastore.GetByID(ctx, id); err != nil {
+		return []entities.Round{}, err
+	}
+
+	return c.datastore.GetRounds(ctx, id)
+}
+
+// SetRounds replaces the rounds the company holds by default. They are numbered in the given order.
+func (c handler) SetRounds(ctx context.Context, id uuid.UUID, rounds []entities.Round) ([]entities.Round, error) {
+	if err := entities.NumberRounds(rounds); err != nil {
+		return []entities.Round{}, err
+	}
+
+	if _, err := c.datastore.GetByID(ctx, id); err != nil {
+		return []entities.Round{}, err
+	}
+
+	if err := c.datastore.SetRounds(ctx, id, rounds); err != nil {
+		return []entities.Round{}, err
+	}
+
+	return rounds, nil
+}
+
 func validateCompany(cmp entities.Company) error {
 	if !entities.IsValidCategory(cmp.Category) {
 		return errors.New("this category is invalid")
@@ -79,3 +175,11 @@ func validateCompany(cmp entities.Company) error {
 
 	return nil
 }
+
+func validateCriteria(cmp entities.Company) error {
+	if cmp.Criteria == nil {
+		return nil
+	}
+
+	return cmp.Criteria.Validate()
+}
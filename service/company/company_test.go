@@ -93,6 +93,10 @@ func TestCreate(t *testing.T) {
 			entities.Company{Name: "Google", Category: "A"}, 0, entities.Company{}, nil,
 			entities.Company{}, errors.InvalidParam{Param: "invalid category"},
 		},
+		{"Error case: invalid eligibility criteria",
+			entities.Company{Name: "Google", Category: "DREAM IT", Criteria: &entities.EligibilityCriteria{MinCGPA: 12}}, 0,
+			entities.Company{}, nil, entities.Company{}, errors.InvalidParam{Param: "minCgpa should be between 0 and 10"},
+		},
 		{"Error case: server error",
 			entities.Company{Name: "Infosys", Category: "MASS"}, 1, entities.Company{},
 			errors.DB{Reason: "server error"}, entities.Company{},
@@ -150,6 +154,57 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestPatch(t *testing.T) {
+	mockCompany := initializeTest(t)
+	id := uuid.New()
+	existing := entities.Company{ID: id, Name: "Google", Category: "DREAM IT"}
+	tests := []struct {
+		description  string
+		patch        string
+		mockGetRes   entities.Company
+		mockGetErr   error
+		patchTimes   int
+		expFields    map[string]interface{}
+		mockPatchErr error
+		expRes       entities.Company
+		expErr       error
+	}{
+		{"Success case: only category is written", `{"category":"OPEN DREAM"}`, existing, nil, 1,
+			map[string]interface{}{"category": entities.OPENDREAM}, nil,
+			entities.Company{ID: id, Name: "Google", Category: "OPEN DREAM"}, nil,
+		},
+		{"Success case: unchanged values are not written", `{"name":"Google"}`, existing, nil, 0, nil, nil,
+			existing, nil,
+		},
+		{"Error case: when id is not present in db", `{"name":"Alphabet"}`, entities.Company{},
+			errors.EntityNotFound{Reason: "id not found: " + id.String()}, 0, nil, nil,
+			entities.Company{}, errors.EntityNotFound{Reason: "id not found: " + id.String()},
+		},
+		{"Error case: merged category is invalid", `{"category":"A"}`, existing, nil, 0, nil, nil,
+			entities.Company{}, errors.InvalidParam{Param: "invalid category"},
+		},
+		{"Error case: name removed by the patch", `{"name":null}`, existing, nil, 0, nil, nil,
+			entities.Company{}, errors.MissingParam{Param: []string{"name"}},
+		},
+		{"Error case: server error", `{"name":"Alphabet"}`, existing, nil, 1,
+			map[string]interface{}{"name": "Alphabet"}, errors.DB{Reason: "server error"},
+			entities.Company{}, errors.DB{Reason: "server error"},
+		},
+	}
+
+	for i, tc := range tests {
+		c := New(mockCompany)
+
+		mockCompany.EXPECT().GetByID(context.Background(), id).Return(tc.mockGetRes, tc.mockGetErr)
+		mockCompany.EXPECT().Patch(context.Background(), id, tc.expFields).Return(tc.mockPatchErr).Times(tc.patchTimes)
+
+		output, err := c.Patch(context.Background(), id, []byte(tc.patch))
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	mockCompany := initializeTest(t)
 	id := uuid.New()
@@ -177,3 +232,36 @@ func TestDelete(t *testing.T) {
 		assert.Equal(t, tc.res, err, "Test[%d] failed\n(%s)", i, tc.description)
 	}
 }
+
+func TestSetRounds(t *testing.T) {
+	id := uuid.New()
+	tests := []struct {
+		description string
+		input       []entities.Round
+		getTimes    int
+		getErr      error
+		setTimes    int
+		expRes      []entities.Round
+		expErr      error
+	}{
+		{"Success case: rounds are numbered in order", []entities.Round{{Name: "Aptitude"}, {Name: "HR"}}, 1, nil, 1,
+			[]entities.Round{{Number: 1, Name: "Aptitude"}, {Number: 2, Name: "HR"}}, nil},
+		{"Error case: round without a name", []entities.Round{{Name: "Aptitude"}, {}}, 0, nil, 0,
+			[]entities.Round{}, errors.MissingParam{Param: []string{"rounds.name"}}},
+		{"Error case: company does not exist", []entities.Round{{Name: "HR"}}, 1,
+			errors.EntityNotFound{Reason: "id not found: " + id.String()}, 0,
+			[]entities.Round{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}},
+	}
+
+	for i, tc := range tests {
+		mockCompany := initializeTest(t)
+
+		mockCompany.EXPECT().GetByID(context.Background(), id).Return(entities.Company{ID: id}, tc.getErr).Times(tc.getTimes)
+		mockCompany.EXPECT().SetRounds(context.Background(), id, gomock.Any()).Return(nil).Times(tc.setTimes)
+
+		output, err := New(mockCompany).SetRounds(context.Background(), id, tc.input)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,165 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/blob"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+// allowedTypes are the content types accepted for documents. The type is sniffed from the contents
+// rather than taken from the upload.
+//
+//nolint:gochecknoglobals // lookup table
+var allowedTypes = map[string]bool{"application/pdf": true, "image/png": true, "image/jpeg": true}
+
+// Config holds the upload limits.
+type Config struct {
+	// MaxSize is the largest document, in bytes, that can be uploaded.
+	MaxSize int64
+}
+
+type handler struct {
+	documents store.DocumentStore
+	students  store.StudentStore
+	blobs     blob.Storage
+	cfg       Config
+	// now is replaced in tests to fix the upload time.
+	now func() time.Time
+}
+
+//nolint:revive // it's a factory function
+func New(documents store.DocumentStore, students store.StudentStore, blobs blob.Storage, cfg Config) handler {
+	return handler{documents: documents, students: students, blobs: blobs, cfg: cfg, now: time.Now}
+}
+
+func (h handler) Get(ctx context.Context, studentID uuid.UUID) ([]entities.Document, error) {
+	if _, err := h.students.GetByID(ctx, studentID); err != nil {
+		return []entities.Document{}, err
+	}
+
+	return h.documents.Get(ctx, studentID)
+}
+
+// Upload stores the contents in blob storage and records the document, which is of kind OTHER
+// unless given. A student uploading a file they already have gets errors.Conflict naming the
+// existing document.
+func (h handler) Upload(ctx context.Context, doc *entities.Document, content []byte) (entities.Document, error) {
+	if doc.Kind == "" {
+		doc.Kind = entities.OtherDoc
+	}
+
+	switch {
+	case !entities.IsValidDocumentKind(doc.Kind):
+		return entities.Document{}, errors.InvalidParam{Param: "kind should be one of RESUME, MARKSHEET, OTHER"}
+	case len(content) == 0:
+		return entities.Document{}, errors.InvalidParam{Param: "file is empty"}
+	case int64(len(content)) > h.cfg.MaxSize:
+		return entities.Document{}, errors.InvalidParam{
+			Param: "file is larger than " + strconv.FormatInt(h.cfg.MaxSize, 10) + " bytes"}
+	}
+
+	contentType := http.DetectContentType(content)
+	if !allowedTypes[contentType] {
+		return entities.Document{}, errors.InvalidParam{Param: "content type " + contentType + " is not allowed"}
+	}
+
+	if _, err := h.students.GetByID(ctx, doc.StudentID); err != nil {
+		return entities.Document{}, err
+	}
+
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	existing, err := h.documents.GetByChecksum(ctx, doc.StudentID, checksum)
+
+	switch err.(type) {
+	case nil:
+		return entities.Document{}, errors.Conflict{Reason: "file already uploaded as document " + existing.ID.String()}
+	case errors.EntityNotFound:
+	default:
+		return entities.Document{}, err
+	}
+
+	doc.ContentType = contentType
+	doc.Size = int64(len(content))
+	doc.Checksum = checksum
+	doc.UploadedAt = h.now().UTC()
+
+	created, err := h.documents.Create(ctx, doc, func() error {
+		if err := h.blobs.Put(ctx, checksum, bytes.NewReader(content)); err != nil {
+			return errors.DB{Reason: "could not store file"}
+		}
+
+		return nil
+	})
+	if err != nil {
+		h.release(ctx, checksum)
+
+		return entities.Document{}, err
+	}
+
+	return created, nil
+}
+
+// Download returns the document and a reader for its contents, which the caller must close.
+func (h handler) Download(ctx context.Context, studentID, id uuid.UUID) (entities.Document, io.ReadCloser, error) {
+	doc, err := h.documents.GetByID(ctx, studentID, id)
+	if err != nil {
+		return entities.Document{}, nil, err
+	}
+
+	r, err := h.blobs.Open(ctx, doc.Checksum)
+	if err != nil {
+		return entities.Document{}, nil, errors.DB{Reason: "could not read file"}
+	}
+
+	return doc, r, nil
+}
+
+// Delete removes the document, and its contents once no other document shares them.
+func (h handler) Delete(ctx context.Context, studentID, id uuid.UUID) error {
+	doc, err := h.documents.GetByID(ctx, studentID, id)
+	if err != nil {
+		return err
+	}
+
+	if err = h.documents.Delete(ctx, studentID, id); err != nil {
+		return err
+	}
+
+	h.release(ctx, doc.Checksum)
+
+	return nil
+}
+
+// Purge removes the contents of docs, the documents of a deleted student, that no other document
+// shares.
+func (h handler) Purge(ctx context.Context, docs []entities.Document) {
+	for i := range docs {
+		h.release(ctx, docs[i].Checksum)
+	}
+}
+
+// release deletes the contents stored under checksum when no document refers to them. Failures
+// only leave an unreferenced file behind, so they are not reported.
+func (h handler) release(ctx context.Context, checksum string) {
+	_ = h.documents.Release(ctx, checksum, func() error {
+		if err := h.blobs.Delete(ctx, checksum); err != nil && err != blob.ErrNotFound {
+			return err
+		}
+
+		return nil
+	})
+}
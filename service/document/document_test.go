@@ -0,0 +1,189 @@
+package document
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/blob"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+const pdfContent = "%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\n%%EOF\n"
+
+func initializeTest(t *testing.T) (*store.MockDocumentStore, *store.MockStudentStore, blob.Disk) {
+	ctrl := gomock.NewController(t)
+
+	disk, err := blob.NewDisk(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return store.NewMockDocumentStore(ctrl), store.NewMockStudentStore(ctrl), disk
+}
+
+func checksumOf(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func TestUpload(t *testing.T) {
+	stuID := uuid.New()
+	existingID := uuid.New()
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	notFound := errors.EntityNotFound{Reason: "checksum not found"}
+
+	tests := []struct {
+		description string
+		kind        entities.DocumentKind
+		content     string
+		lookupTimes int
+		existing    error
+		createTimes int
+		expKind     entities.DocumentKind
+		expErr      error
+	}{
+		{"Success case: resume", entities.Resume, pdfContent, 1, notFound, 1, entities.Resume, nil},
+		{"Success case: kind defaults to OTHER", "", pdfContent, 1, notFound, 1, entities.OtherDoc, nil},
+		{"Error case: unknown kind", "PHOTO", pdfContent, 0, nil, 0, "",
+			errors.InvalidParam{Param: "kind should be one of RESUME, MARKSHEET, OTHER"}},
+		{"Error case: empty file", entities.Resume, "", 0, nil, 0, "", errors.InvalidParam{Param: "file is empty"}},
+		{"Error case: file too large", entities.Resume, pdfContent + string(make([]byte, 100)), 0, nil, 0, "",
+			errors.InvalidParam{Param: "file is larger than 100 bytes"}},
+		{"Error case: content type not allowed", entities.Resume, "#!/bin/sh\nrm -rf /\n", 0, nil, 0, "",
+			errors.InvalidParam{Param: "content type text/plain; charset=utf-8 is not allowed"}},
+		{"Error case: same file uploaded again", entities.Resume, pdfContent, 1, nil, 0, "",
+			errors.Conflict{Reason: "file already uploaded as document " + existingID.String()}},
+	}
+
+	for i, tc := range tests {
+		mockDocument, mockStudent, disk := initializeTest(t)
+
+		mockStudent.EXPECT().GetByID(context.Background(), stuID).Return(entities.Student{ID: stuID}, nil).Times(tc.lookupTimes)
+		mockDocument.EXPECT().GetByChecksum(context.Background(), stuID, checksumOf(tc.content)).
+			Return(entities.Document{ID: existingID}, tc.existing).Times(tc.lookupTimes)
+		mockDocument.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, doc *entities.Document, put func() error) (entities.Document, error) {
+				return *doc, put()
+			}).Times(tc.createTimes)
+
+		h := New(mockDocument, mockStudent, disk, Config{MaxSize: 100})
+		h.now = func() time.Time { return now }
+
+		doc := entities.Document{StudentID: stuID, Kind: tc.kind, FileName: "resume.pdf"}
+		output, err := h.Upload(context.Background(), &doc, []byte(tc.content))
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, entities.Document{StudentID: stuID, Kind: tc.expKind, FileName: "resume.pdf",
+				ContentType: "application/pdf", Size: int64(len(tc.content)), Checksum: checksumOf(tc.content), UploadedAt: now},
+				output, "Test[%d] failed\n(%s)", i, tc.description)
+
+			r, err := disk.Open(context.Background(), output.Checksum)
+			assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+			stored, _ := io.ReadAll(r)
+			_ = r.Close()
+
+			assert.Equal(t, tc.content, string(stored), "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+// release returns a Release stub that removes the contents when there are no references left.
+func release(references int) func(context.Context, string, func() error) error {
+	return func(_ context.Context, _ string, remove func() error) error {
+		if references == 0 {
+			return remove()
+		}
+
+		return nil
+	}
+}
+
+func TestUploadFails(t *testing.T) {
+	mockDocument, mockStudent, disk := initializeTest(t)
+	stuID := uuid.New()
+	sum := checksumOf(pdfContent)
+
+	mockStudent.EXPECT().GetByID(context.Background(), stuID).Return(entities.Student{ID: stuID}, nil)
+	mockDocument.EXPECT().GetByChecksum(context.Background(), stuID, sum).Return(entities.Document{},
+		errors.EntityNotFound{Reason: "checksum not found"})
+	mockDocument.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *entities.Document, put func() error) (entities.Document, error) {
+			_ = put()
+
+			return entities.Document{}, errors.DB{Reason: "server error"}
+		})
+	mockDocument.EXPECT().Release(context.Background(), sum, gomock.Any()).DoAndReturn(release(0))
+
+	doc := entities.Document{StudentID: stuID, Kind: entities.Resume, FileName: "resume.pdf"}
+	_, err := New(mockDocument, mockStudent, disk, Config{MaxSize: 100}).Upload(context.Background(), &doc, []byte(pdfContent))
+
+	assert.Equal(t, errors.DB{Reason: "server error"}, err)
+
+	_, err = disk.Open(context.Background(), sum)
+	assert.Equal(t, blob.ErrNotFound, err, "the contents of a document that was not recorded should be removed")
+}
+
+func TestDelete(t *testing.T) {
+	stuID := uuid.New()
+	docID := uuid.New()
+	sum := checksumOf(pdfContent)
+
+	tests := []struct {
+		description string
+		references  int
+		expStored   bool
+	}{
+		{"Success case: contents removed with the last document", 0, false},
+		{"Success case: contents kept for another student", 1, true},
+	}
+
+	for i, tc := range tests {
+		mockDocument, mockStudent, disk := initializeTest(t)
+		_ = disk.Put(context.Background(), sum, strings.NewReader(pdfContent))
+
+		mockDocument.EXPECT().GetByID(context.Background(), stuID, docID).Return(entities.Document{ID: docID, Checksum: sum}, nil)
+		mockDocument.EXPECT().Delete(context.Background(), stuID, docID).Return(nil)
+		mockDocument.EXPECT().Release(context.Background(), sum, gomock.Any()).DoAndReturn(release(tc.references))
+
+		err := New(mockDocument, mockStudent, disk, Config{MaxSize: 100}).Delete(context.Background(), stuID, docID)
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		_, err = disk.Open(context.Background(), sum)
+		assert.Equal(t, tc.expStored, err == nil, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestPurge(t *testing.T) {
+	mockDocument, mockStudent, disk := initializeTest(t)
+	shared, own := checksumOf(pdfContent), checksumOf(pdfContent+"%%EOF\n")
+	_ = disk.Put(context.Background(), shared, strings.NewReader(pdfContent))
+	_ = disk.Put(context.Background(), own, strings.NewReader(pdfContent+"%%EOF\n"))
+
+	mockDocument.EXPECT().Release(context.Background(), shared, gomock.Any()).DoAndReturn(release(1))
+	mockDocument.EXPECT().Release(context.Background(), own, gomock.Any()).DoAndReturn(release(0))
+
+	New(mockDocument, mockStudent, disk, Config{MaxSize: 100}).Purge(context.Background(),
+		[]entities.Document{{Checksum: shared}, {Checksum: own}})
+
+	_, err := disk.Open(context.Background(), shared)
+	assert.NoError(t, err, "contents shared with another student should be kept")
+
+	_, err = disk.Open(context.Background(), own)
+	assert.Equal(t, blob.ErrNotFound, err, "contents of the deleted student only should be removed")
+}
@@ -0,0 +1,240 @@
+package drive
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+type handler struct {
+	drives   store.DriveStore
+	students store.StudentStore
+	// now is replaced in tests to place registrations inside or outside the window.
+	now func() time.Time
+}
+
+//nolint:revive // it's a factory function
+func New(drives store.DriveStore, students store.StudentStore) handler {
+	return handler{drives: drives, students: students, now: time.Now}
+}
+
+func (h handler) Get(ctx context.Context, companyID uuid.UUID) ([]entities.Drive, error) {
+	return h.drives.Get(ctx, companyID)
+}
+
+func (h handler) GetByID(ctx context.Context, id uuid.UUID) (entities.Drive, error) {
+	return h.drives.GetByID(ctx, id)
+}
+
+func (h handler) Create(ctx context.Context, drive *entities.Drive) (entities.Drive, error) {
+	if err := h.validateDrive(ctx, drive); err != nil {
+		return entities.Drive{}, err
+	}
+
+	return h.drives.Create(ctx, drive)
+}
+
+func (h handler) Update(ctx context.Context, id uuid.UUID, drive *entities.Drive) (entities.Drive, error) {
+	if err := h.validateDrive(ctx, drive); err != nil {
+		return entities.Drive{}, err
+	}
+
+	return h.drives.Update(ctx, id, drive)
+}
+
+func (h handler) Delete(ctx context.Context, id uuid.UUID) error {
+	return h.drives.Delete(ctx, id)
+}
+
+// Register signs the student up for the drive. It fails with errors.Conflict outside the
+// registration window and with errors.Ineligible when the student does not meet the drive's
+// branch list or the company's eligibility criteria.
+func (h handler) Register(ctx context.Context, driveID, studentID uuid.UUID) (entities.Registration, error) {
+	drive, err := h.drives.GetByID(ctx, driveID)
+	if err != nil {
+		return entities.Registration{}, err
+	}
+
+	now := h.now()
+
+	switch {
+	case now.Before(drive.RegistrationOpens):
+		return entities.Registration{}, errors.Conflict{
+			Reason: "registration for this drive opens at " + drive.RegistrationOpens.Format(time.RFC3339)}
+	case now.After(drive.RegistrationCloses):
+		return entities.Registration{}, errors.Conflict{
+			Reason: "registration for this drive closed at " + drive.RegistrationCloses.Format(time.RFC3339)}
+	}
+
+	st, err := h.students.GetByID(ctx, studentID)
+	if err != nil {
+		return entities.Registration{}, err
+	}
+
+	if failed := drive.Check(&st); len(failed) != 0 {
+		return entities.Registration{}, errors.Ineligible{Criteria: failed}
+	}
+
+	reg := entities.Registration{DriveID: driveID, StudentID: studentID, RegisteredAt: now.UTC()}
+
+	if err = h.drives.Register(ctx, &reg); err != nil {
+		return entities.Registration{}, err
+	}
+
+	return reg, nil
+}
+
+func (h handler) GetRegistrations(ctx context.Context, driveID uuid.UUID) ([]entities.Registration, error) {
+	if _, err := h.drives.GetByID(ctx, driveID); err != nil {
+		return []entities.Registration{}, err
+	}
+
+	return h.drives.GetRegistrations(ctx, driveID)
+}
+
+// RecordResult stores the result of a round for a registered student and updates the student's
+// status: REJECTED after a failed round, ACCEPTED after the last round is passed and PENDING in
+// between. Rounds must be recorded in order; recording an earlier round again corrects it. A student
+// placed elsewhere is only accepted when the placement policy allows the move to this company.
+func (h handler) RecordResult(ctx context.Context, res *entities.RoundResult) (entities.RoundResult, error) {
+	if res.Score != nil && *res.Score < 0 {
+		return entities.RoundResult{}, errors.InvalidParam{Param: "score cannot be negative"}
+	}
+
+	drive, err := h.drives.GetByID(ctx, res.DriveID)
+	if err != nil {
+		return entities.RoundResult{}, err
+	}
+
+	if res.Round < 1 || res.Round > len(drive.Rounds) {
+		return entities.RoundResult{}, errors.EntityNotFound{Reason: fmt.Sprintf("round %d not found", res.Round)}
+	}
+
+	registered, err := h.drives.IsRegistered(ctx, res.DriveID, res.StudentID)
+	if err != nil {
+		return entities.RoundResult{}, err
+	}
+
+	if !registered {
+		return entities.RoundResult{}, errors.Conflict{Reason: "student is not registered for this drive"}
+	}
+
+	results, err := h.drives.GetResults(ctx, res.DriveID, res.StudentID)
+	if err != nil {
+		return entities.RoundResult{}, err
+	}
+
+	if res.Round > 1 && !clearedRound(results, res.Round-1) {
+		return entities.RoundResult{}, errors.Conflict{Reason: fmt.Sprintf("student has not cleared round %d", res.Round-1)}
+	}
+
+	res.RecordedAt = h.now().UTC()
+	results = append(withoutRound(results, res.Round), *res)
+
+	st, err := h.students.GetByID(ctx, res.StudentID)
+	if err != nil {
+		return entities.RoundResult{}, err
+	}
+
+	status := entities.RoundStatus(drive.Rounds, results)
+
+	if status == entities.ACCEPTED {
+		if reason := entities.PlacementPolicy(&st, &drive.Comp); reason != "" {
+			return entities.RoundResult{}, errors.Conflict{Reason: reason}
+		}
+	}
+
+	if err = h.drives.RecordResult(ctx, res, statusChange(&st, &drive, status)); err != nil {
+		return entities.RoundResult{}, err
+	}
+
+	return *res, nil
+}
+
+func (h handler) GetResults(ctx context.Context, driveID, studentID uuid.UUID) ([]entities.RoundResult, error) {
+	if _, err := h.drives.GetByID(ctx, driveID); err != nil {
+		return []entities.RoundResult{}, err
+	}
+
+	return h.drives.GetResults(ctx, driveID, studentID)
+}
+
+// validateDrive checks the schedule, branches and rounds of the drive, numbers its rounds and
+// fills in its company. A drive without rounds gets the default rounds of its company.
+func (h handler) validateDrive(ctx context.Context, drive *entities.Drive) error {
+	if !drive.RegistrationCloses.After(drive.RegistrationOpens) {
+		return errors.InvalidParam{Param: "registrationCloses should be after registrationOpens"}
+	}
+
+	// Registration may stay open until the end of the drive day.
+	if !drive.RegistrationCloses.Before(drive.Date.AddDate(0, 0, 1)) {
+		return errors.InvalidParam{Param: "registration should close by the drive date"}
+	}
+
+	for _, b := range drive.Branches {
+		if !entities.IsValidBranch(b) {
+			return errors.InvalidParam{Param: "this branch is not allowed"}
+		}
+	}
+
+	if err := entities.NumberRounds(drive.Rounds); err != nil {
+		return err
+	}
+
+	company, err := h.students.GetCompanyByID(ctx, drive.Comp.ID)
+	if err != nil {
+		return err
+	}
+
+	drive.Comp = company
+
+	if len(drive.Rounds) == 0 {
+		if drive.Rounds, err = h.drives.GetCompanyRounds(ctx, company.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// statusChange returns st linked to the drive's company with the given status, or nil when the
+// student should be left alone: a student already placed with another company keeps that placement
+// unless they are accepted here as well.
+func statusChange(st *entities.Student, drive *entities.Drive, status entities.Status) *entities.Student {
+	if st.Status == entities.ACCEPTED && st.Comp.ID != drive.Comp.ID && status != entities.ACCEPTED {
+		return nil
+	}
+
+	st.Comp = drive.Comp
+	st.Status = status
+
+	return st
+}
+
+func clearedRound(results []entities.RoundResult, round int) bool {
+	for _, r := range results {
+		if r.Round == round {
+			return r.Passed
+		}
+	}
+
+	return false
+}
+
+func withoutRound(results []entities.RoundResult, round int) []entities.RoundResult {
+	kept := make([]entities.RoundResult, 0, len(results))
+
+	for _, r := range results {
+		if r.Round != round {
+			kept = append(kept, r)
+		}
+	}
+
+	return kept
+}
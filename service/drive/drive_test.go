@@ -0,0 +1,242 @@
+package drive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func initializeTest(t *testing.T) (*store.MockDriveStore, *store.MockStudentStore) {
+	ctrl := gomock.NewController(t)
+
+	return store.NewMockDriveStore(ctrl), store.NewMockStudentStore(ctrl)
+}
+
+func TestCreate(t *testing.T) {
+	cmpID := uuid.New()
+	wipro := entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}
+	opens := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	closes := time.Date(2023, 7, 10, 18, 0, 0, 0, time.UTC)
+	companyRounds := []entities.Round{{Number: 1, Name: "Technical"}, {Number: 2, Name: "HR"}}
+
+	tests := []struct {
+		description string
+		input       entities.Drive
+		cmpTimes    int
+		cmpErr      error
+		roundsTimes int
+		createTimes int
+		expErr      error
+	}{
+		{"Success case: rounds are numbered and the company filled in",
+			entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 15), RegistrationOpens: opens,
+				RegistrationCloses: closes, Rounds: []entities.Round{{Name: "Aptitude"}, {Name: "HR"}}},
+			1, nil, 0, 1, nil},
+		{"Success case: registration closes on the drive day and the company rounds are used",
+			entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 10), RegistrationOpens: opens,
+				RegistrationCloses: closes},
+			1, nil, 1, 1, nil},
+		{"Error case: window closes before it opens",
+			entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 15), RegistrationOpens: closes,
+				RegistrationCloses: opens},
+			0, nil, 0, 0, errors.InvalidParam{Param: "registrationCloses should be after registrationOpens"}},
+		{"Error case: window closes after the drive",
+			entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 5), RegistrationOpens: opens,
+				RegistrationCloses: closes},
+			0, nil, 0, 0, errors.InvalidParam{Param: "registration should close by the drive date"}},
+		{"Error case: invalid branch",
+			entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 15), RegistrationOpens: opens,
+				RegistrationCloses: closes, Branches: []entities.Branch{"ABC"}},
+			0, nil, 0, 0, errors.InvalidParam{Param: "this branch is not allowed"}},
+		{"Error case: round without a name",
+			entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 15), RegistrationOpens: opens,
+				RegistrationCloses: closes, Rounds: []entities.Round{{Name: ""}}},
+			0, nil, 0, 0, errors.MissingParam{Param: []string{"rounds.name"}}},
+		{"Error case: company does not exist",
+			entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 15), RegistrationOpens: opens,
+				RegistrationCloses: closes},
+			1, errors.EntityNotFound{Reason: "id not found"}, 0, 0, errors.EntityNotFound{Reason: "id not found"}},
+	}
+
+	for i, tc := range tests {
+		mockDrive, mockStudent := initializeTest(t)
+
+		mockStudent.EXPECT().GetCompanyByID(context.Background(), cmpID).Return(wipro, tc.cmpErr).Times(tc.cmpTimes)
+		mockDrive.EXPECT().GetCompanyRounds(context.Background(), cmpID).Return(companyRounds, nil).Times(tc.roundsTimes)
+		mockDrive.EXPECT().Create(context.Background(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, d *entities.Drive) (entities.Drive, error) { return *d, nil }).
+			Times(tc.createTimes)
+
+		output, err := New(mockDrive, mockStudent).Create(context.Background(), &tc.input)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, wipro, output.Comp, "Test[%d] failed\n(%s)", i, tc.description)
+			assert.NotEmpty(t, output.Rounds, "Test[%d] failed\n(%s)", i, tc.description)
+
+			for n, r := range output.Rounds {
+				assert.Equal(t, n+1, r.Number, "Test[%d] failed\n(%s)", i, tc.description)
+			}
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	driveID := uuid.New()
+	stuID := uuid.New()
+	noBacklogs := 0
+	drive := entities.Drive{ID: driveID,
+		Comp: entities.Company{Name: "Google", Category: "DREAM IT",
+			Criteria: &entities.EligibilityCriteria{MinCGPA: 7, MaxBacklogs: &noBacklogs}},
+		Date: entities.NewDate(2023, 7, 15), RegistrationOpens: time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC),
+		RegistrationCloses: time.Date(2023, 7, 10, 18, 0, 0, 0, time.UTC), Branches: []entities.Branch{"CSE", "ISE"}}
+	inWindow := time.Date(2023, 7, 5, 10, 0, 0, 0, time.UTC)
+	eligible := entities.Student{ID: stuID, Branch: "CSE", Academic: &entities.AcademicProfile{CGPA: 8.1, GraduationYear: 2023}}
+
+	tests := []struct {
+		description   string
+		now           time.Time
+		student       entities.Student
+		studentTimes  int
+		registerTimes int
+		registerErr   error
+		expErr        error
+	}{
+		{"Success case: eligible student inside the window", inWindow, eligible, 1, 1, nil, nil},
+		{"Error case: registration not open yet", time.Date(2023, 6, 30, 10, 0, 0, 0, time.UTC), eligible, 0, 0, nil,
+			errors.Conflict{Reason: "registration for this drive opens at 2023-07-01T09:00:00Z"}},
+		{"Error case: registration closed", time.Date(2023, 7, 10, 18, 0, 1, 0, time.UTC), eligible, 0, 0, nil,
+			errors.Conflict{Reason: "registration for this drive closed at 2023-07-10T18:00:00Z"}},
+		{"Error case: branch and criteria not met", inWindow,
+			entities.Student{ID: stuID, Branch: "ECE", Academic: &entities.AcademicProfile{CGPA: 6.5, ActiveBacklogs: 1,
+				GraduationYear: 2023}}, 1, 0, nil,
+			errors.Ineligible{Criteria: []string{"branch ECE is not one of CSE, ISE", "branch ECE is not allowed for DREAM IT companies",
+				"cgpa 6.50 is below the minimum of 7.00",
+				"1 active backlogs, at most 0 allowed"}}},
+		{"Error case: already registered", inWindow, eligible, 1, 1,
+			errors.Conflict{Reason: "student already registered for this drive"},
+			errors.Conflict{Reason: "student already registered for this drive"}},
+	}
+
+	for i, tc := range tests {
+		mockDrive, mockStudent := initializeTest(t)
+
+		mockDrive.EXPECT().GetByID(context.Background(), driveID).Return(drive, nil)
+		mockStudent.EXPECT().GetByID(context.Background(), stuID).Return(tc.student, nil).Times(tc.studentTimes)
+		mockDrive.EXPECT().Register(context.Background(), &entities.Registration{DriveID: driveID, StudentID: stuID,
+			RegisteredAt: tc.now}).Return(tc.registerErr).Times(tc.registerTimes)
+
+		h := New(mockDrive, mockStudent)
+		h.now = func() time.Time { return tc.now }
+
+		output, err := h.Register(context.Background(), driveID, stuID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, entities.Registration{DriveID: driveID, StudentID: stuID, RegisteredAt: tc.now}, output,
+				"Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestGetRegistrations(t *testing.T) {
+	driveID := uuid.New()
+
+	tests := []struct {
+		description string
+		getErr      error
+		listTimes   int
+		expErr      error
+	}{
+		{"Success case: drive exists", nil, 1, nil},
+		{"Error case: drive does not exist", errors.EntityNotFound{Reason: "id not found: " + driveID.String()}, 0,
+			errors.EntityNotFound{Reason: "id not found: " + driveID.String()}},
+	}
+
+	for i, tc := range tests {
+		mockDrive, mockStudent := initializeTest(t)
+
+		mockDrive.EXPECT().GetByID(context.Background(), driveID).Return(entities.Drive{}, tc.getErr)
+		mockDrive.EXPECT().GetRegistrations(context.Background(), driveID).Return(nil, nil).Times(tc.listTimes)
+
+		_, err := New(mockDrive, mockStudent).GetRegistrations(context.Background(), driveID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRecordResult(t *testing.T) {
+	driveID := uuid.New()
+	stuID := uuid.New()
+	cmpID := uuid.New()
+	otherID := uuid.New()
+	now := time.Date(2023, 7, 15, 11, 0, 0, 0, time.UTC)
+	drive := entities.Drive{ID: driveID, Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"},
+		Rounds: []entities.Round{{Number: 1, Name: "Aptitude"}, {Number: 2, Name: "HR"}}}
+	pending := entities.Student{ID: stuID, Comp: entities.Company{ID: otherID}, Status: "PENDING"}
+	placed := entities.Student{ID: stuID, Comp: entities.Company{ID: otherID}, Status: "ACCEPTED"}
+	placedCore := entities.Student{ID: stuID, Comp: entities.Company{ID: otherID, Category: "CORE"}, Status: "ACCEPTED"}
+	passedFirst := []entities.RoundResult{{DriveID: driveID, Round: 1, StudentID: stuID, Passed: true}}
+
+	tests := []struct {
+		description string
+		round       int
+		passed      bool
+		registered  bool
+		previous    []entities.RoundResult
+		student     entities.Student
+		recordTimes int
+		expStudent  *entities.Student
+		expErr      error
+	}{
+		{"Success case: first round passed keeps the student pending", 1, true, true, nil, pending, 1,
+			&entities.Student{ID: stuID, Comp: drive.Comp, Status: "PENDING"}, nil},
+		{"Success case: last round passed accepts the student", 2, true, true, passedFirst, pending, 1,
+			&entities.Student{ID: stuID, Comp: drive.Comp, Status: "ACCEPTED"}, nil},
+		{"Success case: failed round rejects the student", 1, false, true, nil, pending, 1,
+			&entities.Student{ID: stuID, Comp: drive.Comp, Status: "REJECTED"}, nil},
+		{"Success case: a student placed elsewhere keeps the placement", 1, false, true, nil, placed, 1, nil, nil},
+		{"Success case: a student placed in a lower tier moves here", 2, true, true, passedFirst, placed, 1,
+			&entities.Student{ID: stuID, Comp: drive.Comp, Status: "ACCEPTED"}, nil},
+		{"Error case: placement policy forbids the move", 2, true, true, passedFirst, placedCore, 0, nil,
+			errors.Conflict{Reason: "already placed in a CORE company, only DREAM IT, OPEN DREAM companies are allowed"}},
+		{"Error case: round does not exist", 3, true, true, nil, pending, 0, nil,
+			errors.EntityNotFound{Reason: "round 3 not found"}},
+		{"Error case: student not registered", 1, true, false, nil, pending, 0, nil,
+			errors.Conflict{Reason: "student is not registered for this drive"}},
+		{"Error case: previous round not cleared", 2, true, true, nil, pending, 0, nil,
+			errors.Conflict{Reason: "student has not cleared round 1"}},
+	}
+
+	for i, tc := range tests {
+		mockDrive, mockStudent := initializeTest(t)
+		res := entities.RoundResult{DriveID: driveID, Round: tc.round, StudentID: stuID, Passed: tc.passed, Interviewer: "R. Rao"}
+
+		mockDrive.EXPECT().GetByID(context.Background(), driveID).Return(drive, nil)
+		mockDrive.EXPECT().IsRegistered(context.Background(), driveID, stuID).Return(tc.registered, nil).AnyTimes()
+		mockDrive.EXPECT().GetResults(context.Background(), driveID, stuID).Return(tc.previous, nil).AnyTimes()
+		mockStudent.EXPECT().GetByID(context.Background(), stuID).Return(tc.student, nil).AnyTimes()
+		mockDrive.EXPECT().RecordResult(context.Background(), gomock.Any(), tc.expStudent).Return(nil).Times(tc.recordTimes)
+
+		h := New(mockDrive, mockStudent)
+		h.now = func() time.Time { return now }
+
+		output, err := h.RecordResult(context.Background(), &res)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, now, output.RecordedAt, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
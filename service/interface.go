@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"io"
 
 	"github.com/google/uuid"
 
@@ -13,7 +14,11 @@ type StudentSvc interface {
 	GetByID(ctx context.Context, id uuid.UUID) (entities.Student, error)
 	Create(ctx context.Context, stu *entities.Student) (entities.Student, error)
 	Update(ctx context.Context, id uuid.UUID, stu *entities.Student) (entities.Student, error)
+	Patch(ctx context.Context, id uuid.UUID, patch []byte) (entities.Student, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	Merge(ctx context.Context, id uuid.UUID, duplicateID uuid.UUID) (entities.Student, error)
+	Import(ctx context.Context, rows []entities.ImportRow, dryRun bool) (entities.ImportReport, error)
+	Export(ctx context.Context, name string, branch string, includeCompany string, fn func(entities.Student) error) error
 }
 
 type CompanySvc interface {
@@ -21,5 +26,90 @@ type CompanySvc interface {
 	GetByID(ctx context.Context, id uuid.UUID) (entities.Company, error)
 	Create(ctx context.Context, cmp entities.Company) (entities.Company, error)
 	Update(ctx context.Context, id uuid.UUID, cmp entities.Company) (entities.Company, error)
+	Patch(ctx context.Context, id uuid.UUID, patch []byte) (entities.Company, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	GetRounds(ctx context.Context, id uuid.UUID) ([]entities.Round, error)
+	SetRounds(ctx context.Context, id uuid.UUID, rounds []entities.Round) ([]entities.Round, error)
+}
+
+type DriveSvc interface {
+	Get(ctx context.Context, companyID uuid.UUID) ([]entities.Drive, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Drive, error)
+	Create(ctx context.Context, drive *entities.Drive) (entities.Drive, error)
+	Update(ctx context.Context, id uuid.UUID, drive *entities.Drive) (entities.Drive, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Register(ctx context.Context, driveID uuid.UUID, studentID uuid.UUID) (entities.Registration, error)
+	GetRegistrations(ctx context.Context, driveID uuid.UUID) ([]entities.Registration, error)
+	RecordResult(ctx context.Context, res *entities.RoundResult) (entities.RoundResult, error)
+	GetResults(ctx context.Context, driveID uuid.UUID, studentID uuid.UUID) ([]entities.RoundResult, error)
+}
+
+type OfferSvc interface {
+	Get(ctx context.Context, studentID uuid.UUID) ([]entities.Offer, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Offer, error)
+	Create(ctx context.Context, offer *entities.Offer) (entities.Offer, error)
+	Accept(ctx context.Context, id uuid.UUID) (entities.Offer, error)
+	Decline(ctx context.Context, id uuid.UUID) (entities.Offer, error)
+	Letter(ctx context.Context, id uuid.UUID) ([]byte, error)
+}
+
+type DocumentSvc interface {
+	Get(ctx context.Context, studentID uuid.UUID) ([]entities.Document, error)
+	Upload(ctx context.Context, doc *entities.Document, content []byte) (entities.Document, error)
+	Download(ctx context.Context, studentID, id uuid.UUID) (entities.Document, io.ReadCloser, error)
+	Delete(ctx context.Context, studentID, id uuid.UUID) error
+	Purge(ctx context.Context, docs []entities.Document)
+}
+
+type LetterSvc interface {
+	Get(ctx context.Context) ([]entities.LetterTemplate, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.LetterTemplate, error)
+	Create(ctx context.Context, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error)
+	Update(ctx context.Context, id uuid.UUID, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type ReportSvc interface {
+	Summary(ctx context.Context, top int) (entities.PlacementSummary, error)
+}
+
+type RecruiterSvc interface {
+	Get(ctx context.Context, companyID uuid.UUID) ([]entities.Recruiter, error)
+	Create(ctx context.Context, r *entities.Recruiter) (entities.Recruiter, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Authenticate(ctx context.Context, key string) (entities.Recruiter, error)
+	Drives(ctx context.Context, rec *entities.Recruiter) ([]entities.Drive, error)
+	Applicants(ctx context.Context, rec *entities.Recruiter, driveID uuid.UUID) ([]entities.Applicant, error)
+	SetStatus(ctx context.Context, rec *entities.Recruiter, studentID uuid.UUID, status entities.Status) (entities.Student, error)
+	RecordResult(ctx context.Context, rec *entities.Recruiter, res *entities.RoundResult) (entities.RoundResult, error)
+}
+
+type CollegeSvc interface {
+	Get(ctx context.Context) ([]entities.College, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.College, error)
+	Create(ctx context.Context, c *entities.College) (entities.College, error)
+	RotateKey(ctx context.Context, id uuid.UUID) (entities.College, error)
+	Authenticate(ctx context.Context, key string) (entities.College, error)
+}
+
+type SeasonSvc interface {
+	Get(ctx context.Context) ([]entities.Season, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Season, error)
+	Current(ctx context.Context) (entities.Season, error)
+	Create(ctx context.Context, season *entities.Season) (entities.Season, error)
+	Rollover(ctx context.Context, next *entities.Season) (entities.Season, error)
+}
+
+type WebhookSvc interface {
+	Get(ctx context.Context) ([]entities.Webhook, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Webhook, error)
+	Create(ctx context.Context, w *entities.Webhook) (entities.Webhook, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]entities.Delivery, error)
+	Redeliver(ctx context.Context, webhookID, id uuid.UUID) (entities.Delivery, error)
+}
+
+// EventSvc streams live domain events, replaying the buffered ones a reconnecting client missed.
+type EventSvc interface {
+	Subscribe(filter entities.EventFilter, lastID uuid.UUID) (replay []entities.Event, updates <-chan entities.Event, cancel func())
 }
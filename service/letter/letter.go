@@ -0,0 +1,69 @@
+package letter
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+type handler struct {
+	templates store.LetterStore
+	students  store.StudentStore
+	// now is replaced in tests to fix the update time of templates.
+	now func() time.Time
+}
+
+//nolint:revive // it's a factory function
+func New(templates store.LetterStore, students store.StudentStore) handler {
+	return handler{templates: templates, students: students, now: time.Now}
+}
+
+func (h handler) Get(ctx context.Context) ([]entities.LetterTemplate, error) {
+	return h.templates.Get(ctx)
+}
+
+func (h handler) GetByID(ctx context.Context, id uuid.UUID) (entities.LetterTemplate, error) {
+	return h.templates.GetByID(ctx, id)
+}
+
+func (h handler) Create(ctx context.Context, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error) {
+	if err := h.validateTemplate(ctx, tmpl); err != nil {
+		return entities.LetterTemplate{}, err
+	}
+
+	return h.templates.Create(ctx, tmpl)
+}
+
+func (h handler) Update(ctx context.Context, id uuid.UUID, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error) {
+	if err := h.validateTemplate(ctx, tmpl); err != nil {
+		return entities.LetterTemplate{}, err
+	}
+
+	return h.templates.Update(ctx, id, tmpl)
+}
+
+func (h handler) Delete(ctx context.Context, id uuid.UUID) error {
+	return h.templates.Delete(ctx, id)
+}
+
+// validateTemplate checks that the template renders and that its company exists, and stamps the
+// update time.
+func (h handler) validateTemplate(ctx context.Context, tmpl *entities.LetterTemplate) error {
+	if err := tmpl.Validate(); err != nil {
+		return err
+	}
+
+	if tmpl.CompanyID != nil {
+		if _, err := h.students.GetCompanyByID(ctx, *tmpl.CompanyID); err != nil {
+			return err
+		}
+	}
+
+	tmpl.UpdatedAt = h.now().UTC()
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package letter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func initializeTest(t *testing.T) (*store.MockLetterStore, *store.MockStudentStore) {
+	ctrl := gomock.NewController(t)
+
+	return store.NewMockLetterStore(ctrl), store.NewMockStudentStore(ctrl)
+}
+
+func TestCreate(t *testing.T) {
+	cmpID := uuid.New()
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		input       entities.LetterTemplate
+		cmpTimes    int
+		cmpErr      error
+		createTimes int
+		expErr      error
+	}{
+		{"Success case: default template", entities.LetterTemplate{Name: "default", Body: "Dear {{.StudentName}}"},
+			0, nil, 1, nil},
+		{"Success case: company template", entities.LetterTemplate{Name: "google", CompanyID: &cmpID,
+			Body: "Dear {{.StudentName}}"}, 1, nil, 1, nil},
+		{"Error case: unknown field", entities.LetterTemplate{Name: "default", Body: "{{.Salary}}"}, 0, nil, 0,
+			errors.InvalidParam{Param: `template: body:1:2: executing "body" at <.Salary>: ` +
+				`can't evaluate field Salary in type *entities.LetterData`}},
+		{"Error case: company not found", entities.LetterTemplate{Name: "google", CompanyID: &cmpID, Body: "Dear"}, 1,
+			errors.EntityNotFound{Reason: "id not found"}, 0, errors.EntityNotFound{Reason: "id not found"}},
+	}
+
+	for i, tc := range tests {
+		mockLetter, mockStudent := initializeTest(t)
+
+		mockStudent.EXPECT().GetCompanyByID(context.Background(), cmpID).Return(entities.Company{ID: cmpID}, tc.cmpErr).
+			Times(tc.cmpTimes)
+		mockLetter.EXPECT().Create(context.Background(), gomock.Any()).Return(tc.input, nil).Times(tc.createTimes)
+
+		h := New(mockLetter, mockStudent)
+		h.now = func() time.Time { return now }
+
+		tmpl := tc.input
+		_, err := h.Create(context.Background(), &tmpl)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, now, tmpl.UpdatedAt, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	id := uuid.New()
+	input := entities.LetterTemplate{Name: "default", Body: "Dear {{.StudentName}}"}
+
+	tests := []struct {
+		description string
+		updateErr   error
+		expErr      error
+	}{
+		{"Success case", nil, nil},
+		{"Error case: template not found", errors.EntityNotFound{Reason: "id not found"}, errors.EntityNotFound{Reason: "id not found"}},
+	}
+
+	for i, tc := range tests {
+		mockLetter, mockStudent := initializeTest(t)
+
+		mockLetter.EXPECT().Update(context.Background(), id, gomock.Any()).Return(input, tc.updateErr)
+
+		tmpl := input
+		_, err := New(mockLetter, mockStudent).Update(context.Background(), id, &tmpl)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
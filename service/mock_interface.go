@@ -6,6 +6,7 @@ package service
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	entities "github.com/aditi-zs/Placement-API/entities"
@@ -65,6 +66,20 @@ func (mr *MockStudentSvcMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStudentSvc)(nil).Delete), ctx, id)
 }
 
+// Export mocks base method.
+func (m *MockStudentSvc) Export(ctx context.Context, name, branch, includeCompany string, fn func(entities.Student) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, name, branch, includeCompany, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockStudentSvcMockRecorder) Export(ctx, name, branch, includeCompany, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockStudentSvc)(nil).Export), ctx, name, branch, includeCompany, fn)
+}
+
 // Get mocks base method.
 func (m *MockStudentSvc) Get(ctx context.Context, name, branch, includeCompany string) ([]entities.Student, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +110,51 @@ func (mr *MockStudentSvcMockRecorder) GetByID(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockStudentSvc)(nil).GetByID), ctx, id)
 }
 
+// Import mocks base method.
+func (m *MockStudentSvc) Import(ctx context.Context, rows []entities.ImportRow, dryRun bool) (entities.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, rows, dryRun)
+	ret0, _ := ret[0].(entities.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockStudentSvcMockRecorder) Import(ctx, rows, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockStudentSvc)(nil).Import), ctx, rows, dryRun)
+}
+
+// Merge mocks base method.
+func (m *MockStudentSvc) Merge(ctx context.Context, id, duplicateID uuid.UUID) (entities.Student, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, id, duplicateID)
+	ret0, _ := ret[0].(entities.Student)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockStudentSvcMockRecorder) Merge(ctx, id, duplicateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockStudentSvc)(nil).Merge), ctx, id, duplicateID)
+}
+
+// Patch mocks base method.
+func (m *MockStudentSvc) Patch(ctx context.Context, id uuid.UUID, patch []byte) (entities.Student, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, id, patch)
+	ret0, _ := ret[0].(entities.Student)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockStudentSvcMockRecorder) Patch(ctx, id, patch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockStudentSvc)(nil).Patch), ctx, id, patch)
+}
+
 // Update mocks base method.
 func (m *MockStudentSvc) Update(ctx context.Context, id uuid.UUID, stu *entities.Student) (entities.Student, error) {
 	m.ctrl.T.Helper()
@@ -192,6 +252,51 @@ func (mr *MockCompanySvcMockRecorder) GetByID(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCompanySvc)(nil).GetByID), ctx, id)
 }
 
+// GetRounds mocks base method.
+func (m *MockCompanySvc) GetRounds(ctx context.Context, id uuid.UUID) ([]entities.Round, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRounds", ctx, id)
+	ret0, _ := ret[0].([]entities.Round)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRounds indicates an expected call of GetRounds.
+func (mr *MockCompanySvcMockRecorder) GetRounds(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRounds", reflect.TypeOf((*MockCompanySvc)(nil).GetRounds), ctx, id)
+}
+
+// Patch mocks base method.
+func (m *MockCompanySvc) Patch(ctx context.Context, id uuid.UUID, patch []byte) (entities.Company, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, id, patch)
+	ret0, _ := ret[0].(entities.Company)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockCompanySvcMockRecorder) Patch(ctx, id, patch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockCompanySvc)(nil).Patch), ctx, id, patch)
+}
+
+// SetRounds mocks base method.
+func (m *MockCompanySvc) SetRounds(ctx context.Context, id uuid.UUID, rounds []entities.Round) ([]entities.Round, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRounds", ctx, id, rounds)
+	ret0, _ := ret[0].([]entities.Round)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRounds indicates an expected call of SetRounds.
+func (mr *MockCompanySvcMockRecorder) SetRounds(ctx, id, rounds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRounds", reflect.TypeOf((*MockCompanySvc)(nil).SetRounds), ctx, id, rounds)
+}
+
 // Update mocks base method.
 func (m *MockCompanySvc) Update(ctx context.Context, id uuid.UUID, cmp entities.Company) (entities.Company, error) {
 	m.ctrl.T.Helper()
@@ -206,3 +311,992 @@ func (mr *MockCompanySvcMockRecorder) Update(ctx, id, cmp interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCompanySvc)(nil).Update), ctx, id, cmp)
 }
+
+// MockDriveSvc is a mock of DriveSvc interface.
+type MockDriveSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockDriveSvcMockRecorder
+}
+
+// MockDriveSvcMockRecorder is the mock recorder for MockDriveSvc.
+type MockDriveSvcMockRecorder struct {
+	mock *MockDriveSvc
+}
+
+// NewMockDriveSvc creates a new mock instance.
+func NewMockDriveSvc(ctrl *gomock.Controller) *MockDriveSvc {
+	mock := &MockDriveSvc{ctrl: ctrl}
+	mock.recorder = &MockDriveSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDriveSvc) EXPECT() *MockDriveSvcMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDriveSvc) Create(ctx context.Context, drive *entities.Drive) (entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, drive)
+	ret0, _ := ret[0].(entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDriveSvcMockRecorder) Create(ctx, drive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDriveSvc)(nil).Create), ctx, drive)
+}
+
+// Delete mocks base method.
+func (m *MockDriveSvc) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDriveSvcMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDriveSvc)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockDriveSvc) Get(ctx context.Context, companyID uuid.UUID) ([]entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, companyID)
+	ret0, _ := ret[0].([]entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDriveSvcMockRecorder) Get(ctx, companyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDriveSvc)(nil).Get), ctx, companyID)
+}
+
+// GetByID mocks base method.
+func (m *MockDriveSvc) GetByID(ctx context.Context, id uuid.UUID) (entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDriveSvcMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDriveSvc)(nil).GetByID), ctx, id)
+}
+
+// GetRegistrations mocks base method.
+func (m *MockDriveSvc) GetRegistrations(ctx context.Context, driveID uuid.UUID) ([]entities.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrations", ctx, driveID)
+	ret0, _ := ret[0].([]entities.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrations indicates an expected call of GetRegistrations.
+func (mr *MockDriveSvcMockRecorder) GetRegistrations(ctx, driveID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrations", reflect.TypeOf((*MockDriveSvc)(nil).GetRegistrations), ctx, driveID)
+}
+
+// GetResults mocks base method.
+func (m *MockDriveSvc) GetResults(ctx context.Context, driveID, studentID uuid.UUID) ([]entities.RoundResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResults", ctx, driveID, studentID)
+	ret0, _ := ret[0].([]entities.RoundResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResults indicates an expected call of GetResults.
+func (mr *MockDriveSvcMockRecorder) GetResults(ctx, driveID, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResults", reflect.TypeOf((*MockDriveSvc)(nil).GetResults), ctx, driveID, studentID)
+}
+
+// RecordResult mocks base method.
+func (m *MockDriveSvc) RecordResult(ctx context.Context, res *entities.RoundResult) (entities.RoundResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordResult", ctx, res)
+	ret0, _ := ret[0].(entities.RoundResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordResult indicates an expected call of RecordResult.
+func (mr *MockDriveSvcMockRecorder) RecordResult(ctx, res interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordResult", reflect.TypeOf((*MockDriveSvc)(nil).RecordResult), ctx, res)
+}
+
+// Register mocks base method.
+func (m *MockDriveSvc) Register(ctx context.Context, driveID, studentID uuid.UUID) (entities.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, driveID, studentID)
+	ret0, _ := ret[0].(entities.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockDriveSvcMockRecorder) Register(ctx, driveID, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockDriveSvc)(nil).Register), ctx, driveID, studentID)
+}
+
+// Update mocks base method.
+func (m *MockDriveSvc) Update(ctx context.Context, id uuid.UUID, drive *entities.Drive) (entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, drive)
+	ret0, _ := ret[0].(entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDriveSvcMockRecorder) Update(ctx, id, drive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDriveSvc)(nil).Update), ctx, id, drive)
+}
+
+// MockOfferSvc is a mock of OfferSvc interface.
+type MockOfferSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockOfferSvcMockRecorder
+}
+
+// MockOfferSvcMockRecorder is the mock recorder for MockOfferSvc.
+type MockOfferSvcMockRecorder struct {
+	mock *MockOfferSvc
+}
+
+// NewMockOfferSvc creates a new mock instance.
+func NewMockOfferSvc(ctrl *gomock.Controller) *MockOfferSvc {
+	mock := &MockOfferSvc{ctrl: ctrl}
+	mock.recorder = &MockOfferSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOfferSvc) EXPECT() *MockOfferSvcMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockOfferSvc) Accept(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, id)
+	ret0, _ := ret[0].(entities.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockOfferSvcMockRecorder) Accept(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockOfferSvc)(nil).Accept), ctx, id)
+}
+
+// Create mocks base method.
+func (m *MockOfferSvc) Create(ctx context.Context, offer *entities.Offer) (entities.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, offer)
+	ret0, _ := ret[0].(entities.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOfferSvcMockRecorder) Create(ctx, offer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOfferSvc)(nil).Create), ctx, offer)
+}
+
+// Decline mocks base method.
+func (m *MockOfferSvc) Decline(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", ctx, id)
+	ret0, _ := ret[0].(entities.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockOfferSvcMockRecorder) Decline(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockOfferSvc)(nil).Decline), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockOfferSvc) Get(ctx context.Context, studentID uuid.UUID) ([]entities.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, studentID)
+	ret0, _ := ret[0].([]entities.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOfferSvcMockRecorder) Get(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOfferSvc)(nil).Get), ctx, studentID)
+}
+
+// GetByID mocks base method.
+func (m *MockOfferSvc) GetByID(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOfferSvcMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOfferSvc)(nil).GetByID), ctx, id)
+}
+
+// Letter mocks base method.
+func (m *MockOfferSvc) Letter(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Letter", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Letter indicates an expected call of Letter.
+func (mr *MockOfferSvcMockRecorder) Letter(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Letter", reflect.TypeOf((*MockOfferSvc)(nil).Letter), ctx, id)
+}
+
+// MockDocumentSvc is a mock of DocumentSvc interface.
+type MockDocumentSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentSvcMockRecorder
+}
+
+// MockDocumentSvcMockRecorder is the mock recorder for MockDocumentSvc.
+type MockDocumentSvcMockRecorder struct {
+	mock *MockDocumentSvc
+}
+
+// NewMockDocumentSvc creates a new mock instance.
+func NewMockDocumentSvc(ctrl *gomock.Controller) *MockDocumentSvc {
+	mock := &MockDocumentSvc{ctrl: ctrl}
+	mock.recorder = &MockDocumentSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDocumentSvc) EXPECT() *MockDocumentSvcMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDocumentSvc) Delete(ctx context.Context, studentID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, studentID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDocumentSvcMockRecorder) Delete(ctx, studentID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDocumentSvc)(nil).Delete), ctx, studentID, id)
+}
+
+// Download mocks base method.
+func (m *MockDocumentSvc) Download(ctx context.Context, studentID, id uuid.UUID) (entities.Document, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, studentID, id)
+	ret0, _ := ret[0].(entities.Document)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockDocumentSvcMockRecorder) Download(ctx, studentID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockDocumentSvc)(nil).Download), ctx, studentID, id)
+}
+
+// Get mocks base method.
+func (m *MockDocumentSvc) Get(ctx context.Context, studentID uuid.UUID) ([]entities.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, studentID)
+	ret0, _ := ret[0].([]entities.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDocumentSvcMockRecorder) Get(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDocumentSvc)(nil).Get), ctx, studentID)
+}
+
+// Purge mocks base method.
+func (m *MockDocumentSvc) Purge(ctx context.Context, docs []entities.Document) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Purge", ctx, docs)
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDocumentSvcMockRecorder) Purge(ctx, docs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDocumentSvc)(nil).Purge), ctx, docs)
+}
+
+// Upload mocks base method.
+func (m *MockDocumentSvc) Upload(ctx context.Context, doc *entities.Document, content []byte) (entities.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, doc, content)
+	ret0, _ := ret[0].(entities.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockDocumentSvcMockRecorder) Upload(ctx, doc, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockDocumentSvc)(nil).Upload), ctx, doc, content)
+}
+
+// MockLetterSvc is a mock of LetterSvc interface.
+type MockLetterSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockLetterSvcMockRecorder
+}
+
+// MockLetterSvcMockRecorder is the mock recorder for MockLetterSvc.
+type MockLetterSvcMockRecorder struct {
+	mock *MockLetterSvc
+}
+
+// NewMockLetterSvc creates a new mock instance.
+func NewMockLetterSvc(ctrl *gomock.Controller) *MockLetterSvc {
+	mock := &MockLetterSvc{ctrl: ctrl}
+	mock.recorder = &MockLetterSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLetterSvc) EXPECT() *MockLetterSvcMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLetterSvc) Create(ctx context.Context, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tmpl)
+	ret0, _ := ret[0].(entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLetterSvcMockRecorder) Create(ctx, tmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLetterSvc)(nil).Create), ctx, tmpl)
+}
+
+// Delete mocks base method.
+func (m *MockLetterSvc) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLetterSvcMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLetterSvc)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockLetterSvc) Get(ctx context.Context) ([]entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLetterSvcMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLetterSvc)(nil).Get), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockLetterSvc) GetByID(ctx context.Context, id uuid.UUID) (entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLetterSvcMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLetterSvc)(nil).GetByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockLetterSvc) Update(ctx context.Context, id uuid.UUID, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, tmpl)
+	ret0, _ := ret[0].(entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLetterSvcMockRecorder) Update(ctx, id, tmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLetterSvc)(nil).Update), ctx, id, tmpl)
+}
+
+// MockReportSvc is a mock of ReportSvc interface.
+type MockReportSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportSvcMockRecorder
+}
+
+// MockReportSvcMockRecorder is the mock recorder for MockReportSvc.
+type MockReportSvcMockRecorder struct {
+	mock *MockReportSvc
+}
+
+// NewMockReportSvc creates a new mock instance.
+func NewMockReportSvc(ctrl *gomock.Controller) *MockReportSvc {
+	mock := &MockReportSvc{ctrl: ctrl}
+	mock.recorder = &MockReportSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportSvc) EXPECT() *MockReportSvcMockRecorder {
+	return m.recorder
+}
+
+// Summary mocks base method.
+func (m *MockReportSvc) Summary(ctx context.Context, top int) (entities.PlacementSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary", ctx, top)
+	ret0, _ := ret[0].(entities.PlacementSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary.
+func (mr *MockReportSvcMockRecorder) Summary(ctx, top interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockReportSvc)(nil).Summary), ctx, top)
+}
+
+// MockRecruiterSvc is a mock of RecruiterSvc interface.
+type MockRecruiterSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecruiterSvcMockRecorder
+}
+
+// MockRecruiterSvcMockRecorder is the mock recorder for MockRecruiterSvc.
+type MockRecruiterSvcMockRecorder struct {
+	mock *MockRecruiterSvc
+}
+
+// NewMockRecruiterSvc creates a new mock instance.
+func NewMockRecruiterSvc(ctrl *gomock.Controller) *MockRecruiterSvc {
+	mock := &MockRecruiterSvc{ctrl: ctrl}
+	mock.recorder = &MockRecruiterSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecruiterSvc) EXPECT() *MockRecruiterSvcMockRecorder {
+	return m.recorder
+}
+
+// Applicants mocks base method.
+func (m *MockRecruiterSvc) Applicants(ctx context.Context, rec *entities.Recruiter, driveID uuid.UUID) ([]entities.Applicant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Applicants", ctx, rec, driveID)
+	ret0, _ := ret[0].([]entities.Applicant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Applicants indicates an expected call of Applicants.
+func (mr *MockRecruiterSvcMockRecorder) Applicants(ctx, rec, driveID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Applicants", reflect.TypeOf((*MockRecruiterSvc)(nil).Applicants), ctx, rec, driveID)
+}
+
+// Authenticate mocks base method.
+func (m *MockRecruiterSvc) Authenticate(ctx context.Context, key string) (entities.Recruiter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, key)
+	ret0, _ := ret[0].(entities.Recruiter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockRecruiterSvcMockRecorder) Authenticate(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockRecruiterSvc)(nil).Authenticate), ctx, key)
+}
+
+// Create mocks base method.
+func (m *MockRecruiterSvc) Create(ctx context.Context, r *entities.Recruiter) (entities.Recruiter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(entities.Recruiter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecruiterSvcMockRecorder) Create(ctx, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecruiterSvc)(nil).Create), ctx, r)
+}
+
+// Delete mocks base method.
+func (m *MockRecruiterSvc) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecruiterSvcMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecruiterSvc)(nil).Delete), ctx, id)
+}
+
+// Drives mocks base method.
+func (m *MockRecruiterSvc) Drives(ctx context.Context, rec *entities.Recruiter) ([]entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Drives", ctx, rec)
+	ret0, _ := ret[0].([]entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Drives indicates an expected call of Drives.
+func (mr *MockRecruiterSvcMockRecorder) Drives(ctx, rec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Drives", reflect.TypeOf((*MockRecruiterSvc)(nil).Drives), ctx, rec)
+}
+
+// Get mocks base method.
+func (m *MockRecruiterSvc) Get(ctx context.Context, companyID uuid.UUID) ([]entities.Recruiter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, companyID)
+	ret0, _ := ret[0].([]entities.Recruiter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRecruiterSvcMockRecorder) Get(ctx, companyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRecruiterSvc)(nil).Get), ctx, companyID)
+}
+
+// RecordResult mocks base method.
+func (m *MockRecruiterSvc) RecordResult(ctx context.Context, rec *entities.Recruiter, res *entities.RoundResult) (entities.RoundResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordResult", ctx, rec, res)
+	ret0, _ := ret[0].(entities.RoundResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordResult indicates an expected call of RecordResult.
+func (mr *MockRecruiterSvcMockRecorder) RecordResult(ctx, rec, res interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordResult", reflect.TypeOf((*MockRecruiterSvc)(nil).RecordResult), ctx, rec, res)
+}
+
+// SetStatus mocks base method.
+func (m *MockRecruiterSvc) SetStatus(ctx context.Context, rec *entities.Recruiter, studentID uuid.UUID, status entities.Status) (entities.Student, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, rec, studentID, status)
+	ret0, _ := ret[0].(entities.Student)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockRecruiterSvcMockRecorder) SetStatus(ctx, rec, studentID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockRecruiterSvc)(nil).SetStatus), ctx, rec, studentID, status)
+}
+
+// MockCollegeSvc is a mock of CollegeSvc interface.
+type MockCollegeSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollegeSvcMockRecorder
+}
+
+// MockCollegeSvcMockRecorder is the mock recorder for MockCollegeSvc.
+type MockCollegeSvcMockRecorder struct {
+	mock *MockCollegeSvc
+}
+
+// NewMockCollegeSvc creates a new mock instance.
+func NewMockCollegeSvc(ctrl *gomock.Controller) *MockCollegeSvc {
+	mock := &MockCollegeSvc{ctrl: ctrl}
+	mock.recorder = &MockCollegeSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollegeSvc) EXPECT() *MockCollegeSvcMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockCollegeSvc) Authenticate(ctx context.Context, key string) (entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, key)
+	ret0, _ := ret[0].(entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockCollegeSvcMockRecorder) Authenticate(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockCollegeSvc)(nil).Authenticate), ctx, key)
+}
+
+// Create mocks base method.
+func (m *MockCollegeSvc) Create(ctx context.Context, c *entities.College) (entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c)
+	ret0, _ := ret[0].(entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCollegeSvcMockRecorder) Create(ctx, c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCollegeSvc)(nil).Create), ctx, c)
+}
+
+// Get mocks base method.
+func (m *MockCollegeSvc) Get(ctx context.Context) ([]entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCollegeSvcMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCollegeSvc)(nil).Get), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockCollegeSvc) GetByID(ctx context.Context, id uuid.UUID) (entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCollegeSvcMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCollegeSvc)(nil).GetByID), ctx, id)
+}
+
+// RotateKey mocks base method.
+func (m *MockCollegeSvc) RotateKey(ctx context.Context, id uuid.UUID) (entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateKey", ctx, id)
+	ret0, _ := ret[0].(entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateKey indicates an expected call of RotateKey.
+func (mr *MockCollegeSvcMockRecorder) RotateKey(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateKey", reflect.TypeOf((*MockCollegeSvc)(nil).RotateKey), ctx, id)
+}
+
+// MockSeasonSvc is a mock of SeasonSvc interface.
+type MockSeasonSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeasonSvcMockRecorder
+}
+
+// MockSeasonSvcMockRecorder is the mock recorder for MockSeasonSvc.
+type MockSeasonSvcMockRecorder struct {
+	mock *MockSeasonSvc
+}
+
+// NewMockSeasonSvc creates a new mock instance.
+func NewMockSeasonSvc(ctrl *gomock.Controller) *MockSeasonSvc {
+	mock := &MockSeasonSvc{ctrl: ctrl}
+	mock.recorder = &MockSeasonSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeasonSvc) EXPECT() *MockSeasonSvcMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSeasonSvc) Create(ctx context.Context, season *entities.Season) (entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, season)
+	ret0, _ := ret[0].(entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeasonSvcMockRecorder) Create(ctx, season interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeasonSvc)(nil).Create), ctx, season)
+}
+
+// Current mocks base method.
+func (m *MockSeasonSvc) Current(ctx context.Context) (entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Current", ctx)
+	ret0, _ := ret[0].(entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Current indicates an expected call of Current.
+func (mr *MockSeasonSvcMockRecorder) Current(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Current", reflect.TypeOf((*MockSeasonSvc)(nil).Current), ctx)
+}
+
+// Get mocks base method.
+func (m *MockSeasonSvc) Get(ctx context.Context) ([]entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSeasonSvcMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSeasonSvc)(nil).Get), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockSeasonSvc) GetByID(ctx context.Context, id uuid.UUID) (entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSeasonSvcMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSeasonSvc)(nil).GetByID), ctx, id)
+}
+
+// Rollover mocks base method.
+func (m *MockSeasonSvc) Rollover(ctx context.Context, next *entities.Season) (entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollover", ctx, next)
+	ret0, _ := ret[0].(entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollover indicates an expected call of Rollover.
+func (mr *MockSeasonSvcMockRecorder) Rollover(ctx, next interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollover", reflect.TypeOf((*MockSeasonSvc)(nil).Rollover), ctx, next)
+}
+
+// MockWebhookSvc is a mock of WebhookSvc interface.
+type MockWebhookSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSvcMockRecorder
+}
+
+// MockWebhookSvcMockRecorder is the mock recorder for MockWebhookSvc.
+type MockWebhookSvcMockRecorder struct {
+	mock *MockWebhookSvc
+}
+
+// NewMockWebhookSvc creates a new mock instance.
+func NewMockWebhookSvc(ctrl *gomock.Controller) *MockWebhookSvc {
+	mock := &MockWebhookSvc{ctrl: ctrl}
+	mock.recorder = &MockWebhookSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSvc) EXPECT() *MockWebhookSvcMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookSvc) Create(ctx context.Context, w *entities.Webhook) (entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, w)
+	ret0, _ := ret[0].(entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSvcMockRecorder) Create(ctx, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSvc)(nil).Create), ctx, w)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSvc) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSvcMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSvc)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockWebhookSvc) Get(ctx context.Context) ([]entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookSvcMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookSvc)(nil).Get), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockWebhookSvc) GetByID(ctx context.Context, id uuid.UUID) (entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWebhookSvcMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWebhookSvc)(nil).GetByID), ctx, id)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookSvc) GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]entities.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, webhookID)
+	ret0, _ := ret[0].([]entities.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookSvcMockRecorder) GetDeliveries(ctx, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookSvc)(nil).GetDeliveries), ctx, webhookID)
+}
+
+// Redeliver mocks base method.
+func (m *MockWebhookSvc) Redeliver(ctx context.Context, webhookID, id uuid.UUID) (entities.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, webhookID, id)
+	ret0, _ := ret[0].(entities.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhookSvcMockRecorder) Redeliver(ctx, webhookID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhookSvc)(nil).Redeliver), ctx, webhookID, id)
+}
+
+// MockEventSvc is a mock of EventSvc interface.
+type MockEventSvc struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventSvcMockRecorder
+}
+
+// MockEventSvcMockRecorder is the mock recorder for MockEventSvc.
+type MockEventSvcMockRecorder struct {
+	mock *MockEventSvc
+}
+
+// NewMockEventSvc creates a new mock instance.
+func NewMockEventSvc(ctrl *gomock.Controller) *MockEventSvc {
+	mock := &MockEventSvc{ctrl: ctrl}
+	mock.recorder = &MockEventSvcMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventSvc) EXPECT() *MockEventSvcMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockEventSvc) Subscribe(filter entities.EventFilter, lastID uuid.UUID) ([]entities.Event, <-chan entities.Event, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", filter, lastID)
+	ret0, _ := ret[0].([]entities.Event)
+	ret1, _ := ret[1].(<-chan entities.Event)
+	ret2, _ := ret[2].(func())
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventSvcMockRecorder) Subscribe(filter, lastID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventSvc)(nil).Subscribe), filter, lastID)
+}
@@ -0,0 +1,238 @@
+package offer
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/pdf"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+type handler struct {
+	offers    store.OfferStore
+	students  store.StudentStore
+	templates store.LetterStore
+	// now is replaced in tests to place responses before or after an offer expires.
+	now func() time.Time
+}
+
+//nolint:revive // it's a factory function
+func New(offers store.OfferStore, students store.StudentStore, templates store.LetterStore) handler {
+	return handler{offers: offers, students: students, templates: templates, now: time.Now}
+}
+
+func (h handler) Get(ctx context.Context, studentID uuid.UUID) ([]entities.Offer, error) {
+	offers, err := h.offers.Get(ctx, studentID)
+	if err != nil {
+		return []entities.Offer{}, err
+	}
+
+	for i := range offers {
+		h.markExpired(&offers[i])
+	}
+
+	return offers, nil
+}
+
+func (h handler) GetByID(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	offer, err := h.offers.GetByID(ctx, id)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	h.markExpired(&offer)
+
+	return offer, nil
+}
+
+// Create records an offer for a student the company may hire, as on drive registration: the student
+// must have a branch allowed for the company's category and meet its eligibility criteria, and the
+// placement policy applies, so a placed student can only receive offers from companies of a higher
+// category.
+func (h handler) Create(ctx context.Context, offer *entities.Offer) (entities.Offer, error) {
+	now := h.now()
+
+	switch {
+	case offer.CTC <= 0:
+		return entities.Offer{}, errors.InvalidParam{Param: "ctc should be greater than 0"}
+	case offer.ExpiresOn.Before(entities.NewDate(now.Date()).Time):
+		return entities.Offer{}, errors.InvalidParam{Param: "expiresOn cannot be in the past"}
+	case offer.JoiningDate.Before(offer.ExpiresOn.Time):
+		return entities.Offer{}, errors.InvalidParam{Param: "joiningDate cannot be before expiresOn"}
+	}
+
+	st, err := h.students.GetByID(ctx, offer.StudentID)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	company, err := h.students.GetCompanyByID(ctx, offer.Comp.ID)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	if failed := company.Check(&st); len(failed) != 0 {
+		return entities.Offer{}, errors.Ineligible{Criteria: failed}
+	}
+
+	offer.Comp = company
+	offer.Status = entities.OfferMade
+	offer.CreatedAt = now.UTC()
+	offer.RespondedAt = nil
+
+	return h.offers.Create(ctx, offer)
+}
+
+// Accept accepts an open offer and places the student with its company, once the student is checked
+// against the company again as the student or the company may have changed. Any other offer the student
+// accepted earlier is released, and open offers from companies of the same or a lower category are
+// declined since the placement policy would no longer allow them.
+func (h handler) Accept(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	offer, err := h.openOffer(ctx, id)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	st, err := h.students.GetByID(ctx, offer.StudentID)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	company, err := h.students.GetCompanyByID(ctx, offer.Comp.ID)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	if failed := company.Check(&st); len(failed) != 0 {
+		return entities.Offer{}, errors.Ineligible{Criteria: failed}
+	}
+
+	others, err := h.offers.Get(ctx, offer.StudentID)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	var released []uuid.UUID
+
+	for i := range others {
+		o := &others[i]
+
+		switch {
+		case o.ID == offer.ID:
+		case o.Status == entities.OfferAccepted:
+			released = append(released, o.ID)
+		case o.Status == entities.OfferMade && o.Comp.Category.Tier() <= offer.Comp.Category.Tier():
+			released = append(released, o.ID)
+		}
+	}
+
+	respondedAt := h.now().UTC()
+	offer.Status = entities.OfferAccepted
+	offer.RespondedAt = &respondedAt
+
+	if err = h.offers.Accept(ctx, &offer, released); err != nil {
+		return entities.Offer{}, err
+	}
+
+	return offer, nil
+}
+
+func (h handler) Decline(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	offer, err := h.offers.GetByID(ctx, id)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	if offer.Status != entities.OfferMade {
+		return entities.Offer{}, errors.Conflict{Reason: "offer is already " + string(offer.Status)}
+	}
+
+	respondedAt := h.now().UTC()
+	offer.Status = entities.OfferDeclined
+	offer.RespondedAt = &respondedAt
+
+	if err = h.offers.Decline(ctx, &offer); err != nil {
+		return entities.Offer{}, err
+	}
+
+	return offer, nil
+}
+
+// Letter renders the offer letter as a PDF from the template of the offer's company, falling back
+// to the default template.
+func (h handler) Letter(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	offer, err := h.offers.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if offer.Status == entities.OfferDeclined {
+		return nil, errors.Conflict{Reason: "offer is already " + string(offer.Status)}
+	}
+
+	st, err := h.students.GetByID(ctx, offer.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err := h.templates.GetByCompany(ctx, &offer.Comp.ID)
+	if _, ok := err.(errors.EntityNotFound); ok {
+		tmpl, err = h.templates.GetByCompany(ctx, nil)
+		if _, ok = err.(errors.EntityNotFound); ok {
+			return nil, errors.EntityNotFound{Reason: "no letter template for " + offer.Comp.Name + " and no default template"}
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	data := entities.NewLetterData(&offer, &st, h.now())
+
+	title, body, err := tmpl.Render(&data)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.New()
+	if title != "" {
+		doc.Heading(title)
+	}
+
+	doc.Text(body)
+
+	// Writing to a bytes.Buffer cannot fail.
+	var buf bytes.Buffer
+	_, _ = doc.WriteTo(&buf)
+
+	return buf.Bytes(), nil
+}
+
+// openOffer returns the offer when it can still be accepted.
+func (h handler) openOffer(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	offer, err := h.offers.GetByID(ctx, id)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	if offer.Status != entities.OfferMade {
+		return entities.Offer{}, errors.Conflict{Reason: "offer is already " + string(offer.Status)}
+	}
+
+	if offer.IsExpired(h.now()) {
+		return entities.Offer{}, errors.Conflict{Reason: "offer expired on " + offer.ExpiresOn.String()}
+	}
+
+	return offer, nil
+}
+
+func (h handler) markExpired(offer *entities.Offer) {
+	if offer.IsExpired(h.now()) {
+		offer.Status = entities.OfferExpired
+	}
+}
@@ -0,0 +1,301 @@
+package offer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func initializeTest(t *testing.T) (*store.MockOfferStore, *store.MockStudentStore, *store.MockLetterStore) {
+	ctrl := gomock.NewController(t)
+
+	return store.NewMockOfferStore(ctrl), store.NewMockStudentStore(ctrl), store.NewMockLetterStore(ctrl)
+}
+
+func TestGetByID(t *testing.T) {
+	offerID := uuid.New()
+	offer := entities.Offer{ID: offerID, Status: entities.OfferMade, ExpiresOn: entities.NewDate(2023, 8, 1)}
+
+	tests := []struct {
+		description string
+		now         time.Time
+		expStatus   entities.OfferStatus
+	}{
+		{"Success case: open on the expiry date", time.Date(2023, 8, 1, 23, 0, 0, 0, time.UTC), entities.OfferMade},
+		{"Success case: reported as expired the day after", time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC), entities.OfferExpired},
+	}
+
+	for i, tc := range tests {
+		mockOffer, mockStudent, mockLetter := initializeTest(t)
+
+		mockOffer.EXPECT().GetByID(context.Background(), offerID).Return(offer, nil)
+
+		h := New(mockOffer, mockStudent, mockLetter)
+		h.now = func() time.Time { return tc.now }
+
+		output, err := h.GetByID(context.Background(), offerID)
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expStatus, output.Status, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	stuID := uuid.New()
+	cmpID := uuid.New()
+	now := time.Date(2023, 7, 20, 10, 0, 0, 0, time.UTC)
+	google := entities.Company{ID: cmpID, Name: "Google", Category: "DREAM IT"}
+	unplaced := entities.Student{ID: stuID, Branch: "CSE", Status: "PENDING"}
+	placedOpenDream := entities.Student{ID: stuID, Branch: "CSE", Comp: entities.Company{ID: uuid.New(), Category: "OPEN DREAM"},
+		Status: "ACCEPTED"}
+	placedMass := entities.Student{ID: stuID, Branch: "CSE", Comp: entities.Company{ID: uuid.New(), Category: "MASS"},
+		Status: "ACCEPTED"}
+
+	tests := []struct {
+		description string
+		ctc         int64
+		expiresOn   entities.Date
+		joiningDate entities.Date
+		student     entities.Student
+		lookupTimes int
+		createTimes int
+		expErr      error
+	}{
+		{"Success case: unplaced student", 2400000, entities.NewDate(2023, 8, 1), entities.NewDate(2024, 7, 1), unplaced,
+			1, 1, nil},
+		{"Success case: student placed in a lower category", 2400000, entities.NewDate(2023, 7, 20),
+			entities.NewDate(2024, 7, 1), placedMass, 1, 1, nil},
+		{"Error case: ctc not positive", -1, entities.NewDate(2023, 8, 1), entities.NewDate(2024, 7, 1), unplaced, 0, 0,
+			errors.InvalidParam{Param: "ctc should be greater than 0"}},
+		{"Error case: expiry in the past", 2400000, entities.NewDate(2023, 7, 19), entities.NewDate(2024, 7, 1), unplaced,
+			0, 0, errors.InvalidParam{Param: "expiresOn cannot be in the past"}},
+		{"Error case: joining before expiry", 2400000, entities.NewDate(2023, 8, 1), entities.NewDate(2023, 7, 31), unplaced,
+			0, 0, errors.InvalidParam{Param: "joiningDate cannot be before expiresOn"}},
+		{"Error case: student placed in a higher category", 2400000, entities.NewDate(2023, 8, 1),
+			entities.NewDate(2024, 7, 1), placedOpenDream, 1, 0,
+			errors.Ineligible{Criteria: []string{"already placed in a OPEN DREAM company"}}},
+		{"Error case: branch not allowed for the company category", 2400000, entities.NewDate(2023, 8, 1),
+			entities.NewDate(2024, 7, 1), entities.Student{ID: stuID, Branch: "MECH", Status: "PENDING"}, 1, 0,
+			errors.Ineligible{Criteria: []string{"branch MECH is not allowed for DREAM IT companies"}}},
+	}
+
+	for i, tc := range tests {
+		mockOffer, mockStudent, mockLetter := initializeTest(t)
+		input := entities.Offer{StudentID: stuID, Comp: entities.Company{ID: cmpID}, Role: "SDE", CTC: tc.ctc,
+			JoiningDate: tc.joiningDate, ExpiresOn: tc.expiresOn}
+		expOffer := input
+		expOffer.Comp = google
+		expOffer.Status = entities.OfferMade
+		expOffer.CreatedAt = now
+
+		mockStudent.EXPECT().GetByID(context.Background(), stuID).Return(tc.student, nil).Times(tc.lookupTimes)
+		mockStudent.EXPECT().GetCompanyByID(context.Background(), cmpID).Return(google, nil).Times(tc.lookupTimes)
+		mockOffer.EXPECT().Create(context.Background(), &expOffer).Return(expOffer, nil).Times(tc.createTimes)
+
+		h := New(mockOffer, mockStudent, mockLetter)
+		h.now = func() time.Time { return now }
+
+		output, err := h.Create(context.Background(), &input)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, expOffer, output, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestAccept(t *testing.T) {
+	stuID := uuid.New()
+	now := time.Date(2023, 7, 25, 16, 30, 0, 0, time.UTC)
+	wipro := entities.Company{ID: uuid.New(), Name: "Wipro", Category: "MASS"}
+	google := entities.Company{ID: uuid.New(), Name: "Google", Category: "DREAM IT"}
+	open := func(c entities.Company) entities.Offer {
+		return entities.Offer{ID: uuid.New(), StudentID: stuID, Comp: c, Status: entities.OfferMade,
+			ExpiresOn: entities.NewDate(2023, 8, 1)}
+	}
+	googleOffer := open(google)
+	wiproOffer := open(wipro)
+	acceptedWipro := open(wipro)
+	acceptedWipro.Status = entities.OfferAccepted
+	expired := open(google)
+	expired.ExpiresOn = entities.NewDate(2023, 7, 24)
+	declined := open(google)
+	declined.Status = entities.OfferDeclined
+
+	tests := []struct {
+		description string
+		offer       entities.Offer
+		student     entities.Student
+		others      []entities.Offer
+		released    []uuid.UUID
+		acceptTimes int
+		expErr      error
+	}{
+		{"Success case: lower category offers are released", googleOffer, entities.Student{ID: stuID, Branch: "CSE", Status: "PENDING"},
+			[]entities.Offer{wiproOffer, googleOffer}, []uuid.UUID{wiproOffer.ID}, 1, nil},
+		{"Success case: an earlier acceptance is released", googleOffer,
+			entities.Student{ID: stuID, Branch: "CSE", Comp: wipro, Status: "ACCEPTED"}, []entities.Offer{acceptedWipro, googleOffer},
+			[]uuid.UUID{acceptedWipro.ID}, 1, nil},
+		{"Success case: a higher category offer stays open", wiproOffer, entities.Student{ID: stuID, Branch: "CSE", Status: "PENDING"},
+			[]entities.Offer{wiproOffer, googleOffer}, nil, 1, nil},
+		{"Error case: offer expired", expired, entities.Student{}, nil, nil, 0,
+			errors.Conflict{Reason: "offer expired on 2023-07-24"}},
+		{"Error case: offer already declined", declined, entities.Student{}, nil, nil, 0,
+			errors.Conflict{Reason: "offer is already DECLINED"}},
+		{"Error case: placed in a higher category", wiproOffer, entities.Student{ID: stuID, Branch: "CSE", Comp: google, Status: "ACCEPTED"},
+			nil, nil, 0, errors.Ineligible{Criteria: []string{
+				"already placed in a DREAM IT company, only OPEN DREAM companies are allowed"}}},
+		{"Error case: branch not allowed for the company category", googleOffer,
+			entities.Student{ID: stuID, Branch: "ECE", Status: "PENDING"}, nil, nil, 0,
+			errors.Ineligible{Criteria: []string{"branch ECE is not allowed for DREAM IT companies"}}},
+	}
+
+	for i, tc := range tests {
+		mockOffer, mockStudent, mockLetter := initializeTest(t)
+		responded := now
+		expOffer := tc.offer
+		expOffer.Status = entities.OfferAccepted
+		expOffer.RespondedAt = &responded
+
+		mockOffer.EXPECT().GetByID(context.Background(), tc.offer.ID).Return(tc.offer, nil)
+		mockStudent.EXPECT().GetByID(context.Background(), stuID).Return(tc.student, nil).AnyTimes()
+		mockStudent.EXPECT().GetCompanyByID(context.Background(), tc.offer.Comp.ID).Return(tc.offer.Comp, nil).AnyTimes()
+		mockOffer.EXPECT().Get(context.Background(), stuID).Return(tc.others, nil).Times(tc.acceptTimes)
+		mockOffer.EXPECT().Accept(context.Background(), &expOffer, tc.released).Return(nil).Times(tc.acceptTimes)
+
+		h := New(mockOffer, mockStudent, mockLetter)
+		h.now = func() time.Time { return now }
+
+		output, err := h.Accept(context.Background(), tc.offer.ID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, expOffer, output, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestAcceptChecksCriteria(t *testing.T) {
+	mockOffer, mockStudent, mockLetter := initializeTest(t)
+	stuID := uuid.New()
+	google := entities.Company{ID: uuid.New(), Name: "Google", Category: "DREAM IT"}
+	offer := entities.Offer{ID: uuid.New(), StudentID: stuID, Comp: google, Status: entities.OfferMade,
+		ExpiresOn: entities.NewDate(2023, 8, 1)}
+	// The company raised its bar after making the offer.
+	raised := google
+	raised.Criteria = &entities.EligibilityCriteria{MinCGPA: 8}
+
+	mockOffer.EXPECT().GetByID(context.Background(), offer.ID).Return(offer, nil)
+	mockStudent.EXPECT().GetByID(context.Background(), stuID).Return(entities.Student{ID: stuID, Branch: "CSE", Status: "PENDING",
+		Academic: &entities.AcademicProfile{CGPA: 7, GraduationYear: 2023}}, nil)
+	mockStudent.EXPECT().GetCompanyByID(context.Background(), google.ID).Return(raised, nil)
+
+	h := New(mockOffer, mockStudent, mockLetter)
+	h.now = func() time.Time { return time.Date(2023, 7, 25, 16, 30, 0, 0, time.UTC) }
+
+	_, err := h.Accept(context.Background(), offer.ID)
+
+	assert.Equal(t, errors.Ineligible{Criteria: []string{"cgpa 7.00 is below the minimum of 8.00"}}, err)
+}
+
+func TestDecline(t *testing.T) {
+	offerID := uuid.New()
+	now := time.Date(2023, 7, 25, 16, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		description  string
+		status       entities.OfferStatus
+		declineTimes int
+		expErr       error
+	}{
+		{"Success case", entities.OfferMade, 1, nil},
+		{"Error case: offer already accepted", entities.OfferAccepted, 0, errors.Conflict{Reason: "offer is already ACCEPTED"}},
+	}
+
+	for i, tc := range tests {
+		mockOffer, mockStudent, mockLetter := initializeTest(t)
+
+		mockOffer.EXPECT().GetByID(context.Background(), offerID).Return(entities.Offer{ID: offerID, Status: tc.status}, nil)
+		mockOffer.EXPECT().Decline(context.Background(), gomock.Any()).Return(nil).Times(tc.declineTimes)
+
+		h := New(mockOffer, mockStudent, mockLetter)
+		h.now = func() time.Time { return now }
+
+		output, err := h.Decline(context.Background(), offerID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, entities.OfferDeclined, output.Status, "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Equal(t, now, *output.RespondedAt, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestLetter(t *testing.T) {
+	offerID := uuid.New()
+	stuID := uuid.New()
+	now := time.Date(2023, 7, 20, 10, 0, 0, 0, time.UTC)
+	google := entities.Company{ID: uuid.New(), Name: "Google", Category: "DREAM IT"}
+	offer := entities.Offer{ID: offerID, StudentID: stuID, Comp: google, Role: "SDE", CTC: 2400000, Status: entities.OfferMade,
+		JoiningDate: entities.NewDate(2024, 7, 1), ExpiresOn: entities.NewDate(2023, 8, 1)}
+	declined := offer
+	declined.Status = entities.OfferDeclined
+	tmpl := entities.LetterTemplate{Name: "default", Title: "Offer of employment",
+		Body: "Dear {{.StudentName}}, {{.CompanyName}} offers you {{.CTC}}."}
+	notFound := errors.EntityNotFound{Reason: "letter template not found"}
+
+	tests := []struct {
+		description  string
+		offer        entities.Offer
+		companyErr   error
+		defaultTimes int
+		defaultErr   error
+		expErr       error
+	}{
+		{"Success case: company template", offer, nil, 0, nil, nil},
+		{"Success case: default template", offer, notFound, 1, nil, nil},
+		{"Error case: no template", offer, notFound, 1, notFound,
+			errors.EntityNotFound{Reason: "no letter template for Google and no default template"}},
+		{"Error case: declined offer", declined, nil, 0, nil, errors.Conflict{Reason: "offer is already DECLINED"}},
+	}
+
+	for i, tc := range tests {
+		mockOffer, mockStudent, mockLetter := initializeTest(t)
+		lookups := 1
+
+		if tc.offer.Status == entities.OfferDeclined {
+			lookups = 0
+		}
+
+		mockOffer.EXPECT().GetByID(context.Background(), offerID).Return(tc.offer, nil)
+		mockStudent.EXPECT().GetByID(context.Background(), stuID).Return(entities.Student{ID: stuID, Name: "Aditi"}, nil).
+			Times(lookups)
+		mockLetter.EXPECT().GetByCompany(context.Background(), &google.ID).Return(tmpl, tc.companyErr).Times(lookups)
+		mockLetter.EXPECT().GetByCompany(context.Background(), nil).Return(tmpl, tc.defaultErr).Times(tc.defaultTimes)
+
+		h := New(mockOffer, mockStudent, mockLetter)
+		h.now = func() time.Time { return now }
+
+		output, err := h.Letter(context.Background(), offerID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.True(t, bytes.HasPrefix(output, []byte("%PDF-")), "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Contains(t, string(output), "(Dear Aditi, Google offers you Rs. 24,00,000.) Tj", "Test[%d] failed\n(%s)",
+				i, tc.description)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package recruiter
+
+import (
+	"context"
+	"encoding/json"
+	"net/mail"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+// handler serves recruiter accounts and the recruiter portal. Portal methods take the
+// authenticated recruiter and only act on drives and applicants of the recruiter's company; the
+// work itself is left to the student and drive services so the same rules apply as for admins.
+type handler struct {
+	recruiters store.RecruiterStore
+	companies  store.CompanyStore
+	drives     store.DriveStore
+	students   service.StudentSvc
+	driveSvc   service.DriveSvc
+	// now is replaced in tests to fix the creation time of recruiters.
+	now func() time.Time
+}
+
+//nolint:revive // it's a factory function
+func New(recruiters store.RecruiterStore, companies store.CompanyStore, drives store.DriveStore, students service.StudentSvc,
+	driveSvc service.DriveSvc) handler {
+	return handler{recruiters: recruiters, companies: companies, drives: drives, students: students, driveSvc: driveSvc,
+		now: time.Now}
+}
+
+func (h handler) Get(ctx context.Context, companyID uuid.UUID) ([]entities.Recruiter, error) {
+	if _, err := h.companies.GetByID(ctx, companyID); err != nil {
+		return []entities.Recruiter{}, err
+	}
+
+	return h.recruiters.GetByCompany(ctx, companyID)
+}
+
+// Create adds a recruiter to a company with a new API key. The key is only returned here.
+func (h handler) Create(ctx context.Context, r *entities.Recruiter) (entities.Recruiter, error) {
+	if _, err := mail.ParseAddress(r.Email); err != nil {
+		return entities.Recruiter{}, errors.InvalidParam{Param: "email"}
+	}
+
+	if _, err := h.companies.GetByID(ctx, r.CompanyID); err != nil {
+		return entities.Recruiter{}, err
+	}
+
+	key, hash, err := auth.NewKey()
+	if err != nil {
+		return entities.Recruiter{}, err
+	}
+
+	r.CreatedAt = h.now().UTC().Truncate(time.Second)
+
+	resp, err := h.recruiters.Create(ctx, r, hash)
+	if err != nil {
+		return entities.Recruiter{}, err
+	}
+
+	resp.APIKey = key
+
+	return resp, nil
+}
+
+func (h handler) Delete(ctx context.Context, id uuid.UUID) error {
+	return h.recruiters.Delete(ctx, id)
+}
+
+// Authenticate returns the recruiter owning key.
+func (h handler) Authenticate(ctx context.Context, key string) (entities.Recruiter, error) {
+	if key == "" {
+		return entities.Recruiter{}, errors.MissingParam{Param: []string{"X-API-KEY"}}
+	}
+
+	return h.recruiters.GetByKeyHash(ctx, auth.HashKey(key))
+}
+
+func (h handler) Drives(ctx context.Context, rec *entities.Recruiter) ([]entities.Drive, error) {
+	return h.driveSvc.Get(ctx, rec.CompanyID)
+}
+
+// Applicants returns the students registered for the company's drives, or only for driveID when it
+// is not uuid.Nil.
+func (h handler) Applicants(ctx context.Context, rec *entities.Recruiter, driveID uuid.UUID) ([]entities.Applicant, error) {
+	if driveID != uuid.Nil {
+		if err := h.ownDrive(ctx, rec, driveID); err != nil {
+			return []entities.Applicant{}, err
+		}
+	}
+
+	return h.drives.GetApplicants(ctx, rec.CompanyID, driveID)
+}
+
+// SetStatus links an applicant to the recruiter's company with the given status. A student placed
+// with another company cannot be changed by this company's recruiters.
+func (h handler) SetStatus(ctx context.Context, rec *entities.Recruiter, studentID uuid.UUID,
+	status entities.Status) (entities.Student, error) {
+	if !entities.IsValidStatus(status) {
+		return entities.Student{}, errors.InvalidParam{Param: "invalid status"}
+	}
+
+	applied, err := h.drives.IsApplicant(ctx, rec.CompanyID, studentID)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	if !applied {
+		return entities.Student{}, errors.EntityNotFound{Reason: "applicant not found: " + studentID.String()}
+	}
+
+	st, err := h.students.GetByID(ctx, studentID)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	if st.Status == entities.ACCEPTED && st.Comp.ID != rec.CompanyID {
+		return entities.Student{}, errors.Conflict{Reason: "student is already placed with another company"}
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{"comp": map[string]uuid.UUID{"id": rec.CompanyID}, "status": status})
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	return h.students.Patch(ctx, studentID, patch)
+}
+
+// RecordResult records a round result in one of the company's drives.
+func (h handler) RecordResult(ctx context.Context, rec *entities.Recruiter, res *entities.RoundResult) (entities.RoundResult, error) {
+	if err := h.ownDrive(ctx, rec, res.DriveID); err != nil {
+		return entities.RoundResult{}, err
+	}
+
+	if res.Interviewer == "" {
+		res.Interviewer = rec.Name
+	}
+
+	return h.driveSvc.RecordResult(ctx, res)
+}
+
+// ownDrive returns errors.EntityNotFound for drives of other companies, the same as for drives that
+// do not exist, so recruiters cannot probe for them.
+func (h handler) ownDrive(ctx context.Context, rec *entities.Recruiter, driveID uuid.UUID) error {
+	drive, err := h.drives.GetByID(ctx, driveID)
+	if err != nil {
+		return err
+	}
+
+	if drive.Comp.ID != rec.CompanyID {
+		return errors.EntityNotFound{Reason: "id not found: " + driveID.String()}
+	}
+
+	return nil
+}
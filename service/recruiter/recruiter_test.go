@@ -0,0 +1,224 @@
+package recruiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+type mocks struct {
+	recruiters *store.MockRecruiterStore
+	companies  *store.MockCompanyStore
+	drives     *store.MockDriveStore
+	students   *service.MockStudentSvc
+	driveSvc   *service.MockDriveSvc
+}
+
+func initializeTest(t *testing.T) (mocks, handler) {
+	ctrl := gomock.NewController(t)
+	m := mocks{store.NewMockRecruiterStore(ctrl), store.NewMockCompanyStore(ctrl), store.NewMockDriveStore(ctrl),
+		service.NewMockStudentSvc(ctrl), service.NewMockDriveSvc(ctrl)}
+
+	return m, New(m.recruiters, m.companies, m.drives, m.students, m.driveSvc)
+}
+
+func TestCreate(t *testing.T) {
+	cmpID := uuid.New()
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		input       entities.Recruiter
+		mock        func(m mocks)
+		expErr      error
+	}{
+		{"Success case", entities.Recruiter{CompanyID: cmpID, Name: "Ravi", Email: "ravi@wipro.com"}, func(m mocks) {
+			m.companies.EXPECT().GetByID(context.Background(), cmpID).Return(entities.Company{ID: cmpID}, nil)
+			m.recruiters.EXPECT().Create(context.Background(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, r *entities.Recruiter, hash string) (entities.Recruiter, error) {
+					assert.Len(t, hash, 64)
+					assert.Equal(t, now, r.CreatedAt)
+
+					return *r, nil
+				})
+		}, nil},
+		{"Error case: invalid email", entities.Recruiter{CompanyID: cmpID, Name: "Ravi", Email: "ravi"}, func(m mocks) {},
+			errors.InvalidParam{Param: "email"}},
+		{"Error case: company not found", entities.Recruiter{CompanyID: cmpID, Name: "Ravi", Email: "ravi@wipro.com"},
+			func(m mocks) {
+				m.companies.EXPECT().GetByID(context.Background(), cmpID).
+					Return(entities.Company{}, errors.EntityNotFound{Reason: "id not found"})
+			}, errors.EntityNotFound{Reason: "id not found"}},
+	}
+
+	for i, tc := range tests {
+		m, h := initializeTest(t)
+		h.now = func() time.Time { return now }
+
+		tc.mock(m)
+
+		output, err := h.Create(context.Background(), &tc.input)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.Len(t, output.APIKey, 64, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: uuid.New(), Name: "Ravi"}
+
+	tests := []struct {
+		description string
+		key         string
+		mock        func(m mocks)
+		expRes      entities.Recruiter
+		expErr      error
+	}{
+		{"Success case", "secret", func(m mocks) {
+			m.recruiters.EXPECT().GetByKeyHash(context.Background(), auth.HashKey("secret")).Return(rec, nil)
+		}, rec, nil},
+		{"Error case: no key", "", func(m mocks) {}, entities.Recruiter{}, errors.MissingParam{Param: []string{"X-API-KEY"}}},
+		{"Error case: unknown key", "guess", func(m mocks) {
+			m.recruiters.EXPECT().GetByKeyHash(context.Background(), auth.HashKey("guess")).
+				Return(entities.Recruiter{}, errors.EntityNotFound{Reason: "unknown api key"})
+		}, entities.Recruiter{}, errors.EntityNotFound{Reason: "unknown api key"}},
+	}
+
+	for i, tc := range tests {
+		m, h := initializeTest(t)
+
+		tc.mock(m)
+
+		output, err := h.Authenticate(context.Background(), tc.key)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestApplicants(t *testing.T) {
+	cmpID := uuid.New()
+	driveID := uuid.New()
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: cmpID}
+	applicants := []entities.Applicant{{Student: entities.Student{ID: uuid.New()}, DriveID: driveID}}
+
+	tests := []struct {
+		description string
+		driveID     uuid.UUID
+		mock        func(m mocks)
+		expRes      []entities.Applicant
+		expErr      error
+	}{
+		{"Success case: every drive", uuid.Nil, func(m mocks) {
+			m.drives.EXPECT().GetApplicants(context.Background(), cmpID, uuid.Nil).Return(applicants, nil)
+		}, applicants, nil},
+		{"Success case: own drive", driveID, func(m mocks) {
+			m.drives.EXPECT().GetByID(context.Background(), driveID).
+				Return(entities.Drive{ID: driveID, Comp: entities.Company{ID: cmpID}}, nil)
+			m.drives.EXPECT().GetApplicants(context.Background(), cmpID, driveID).Return(applicants, nil)
+		}, applicants, nil},
+		{"Error case: drive of another company", driveID, func(m mocks) {
+			m.drives.EXPECT().GetByID(context.Background(), driveID).
+				Return(entities.Drive{ID: driveID, Comp: entities.Company{ID: uuid.New()}}, nil)
+		}, []entities.Applicant{}, errors.EntityNotFound{Reason: "id not found: " + driveID.String()}},
+	}
+
+	for i, tc := range tests {
+		m, h := initializeTest(t)
+
+		tc.mock(m)
+
+		output, err := h.Applicants(context.Background(), &rec, tc.driveID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestSetStatus(t *testing.T) {
+	cmpID := uuid.New()
+	stuID := uuid.New()
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: cmpID}
+	pending := entities.Student{ID: stuID, Comp: entities.Company{ID: cmpID}, Status: entities.PENDING}
+	shortlisted := entities.Student{ID: stuID, Comp: entities.Company{ID: cmpID}, Status: entities.SHORTLISTED}
+	placedElsewhere := entities.Student{ID: stuID, Comp: entities.Company{ID: uuid.New()}, Status: entities.ACCEPTED}
+	patch := `{"comp":{"id":"` + cmpID.String() + `"},"status":"SHORTLISTED"}`
+
+	tests := []struct {
+		description string
+		status      entities.Status
+		mock        func(m mocks)
+		expRes      entities.Student
+		expErr      error
+	}{
+		{"Success case: shortlisted", entities.SHORTLISTED, func(m mocks) {
+			m.drives.EXPECT().IsApplicant(context.Background(), cmpID, stuID).Return(true, nil)
+			m.students.EXPECT().GetByID(context.Background(), stuID).Return(pending, nil)
+			m.students.EXPECT().Patch(context.Background(), stuID, []byte(patch)).Return(shortlisted, nil)
+		}, shortlisted, nil},
+		{"Error case: invalid status", "HIRED", func(m mocks) {}, entities.Student{}, errors.InvalidParam{Param: "invalid status"}},
+		{"Error case: not an applicant of the company", entities.SHORTLISTED, func(m mocks) {
+			m.drives.EXPECT().IsApplicant(context.Background(), cmpID, stuID).Return(false, nil)
+		}, entities.Student{}, errors.EntityNotFound{Reason: "applicant not found: " + stuID.String()}},
+		{"Error case: placed with another company", entities.SHORTLISTED, func(m mocks) {
+			m.drives.EXPECT().IsApplicant(context.Background(), cmpID, stuID).Return(true, nil)
+			m.students.EXPECT().GetByID(context.Background(), stuID).Return(placedElsewhere, nil)
+		}, entities.Student{}, errors.Conflict{Reason: "student is already placed with another company"}},
+	}
+
+	for i, tc := range tests {
+		m, h := initializeTest(t)
+
+		tc.mock(m)
+
+		output, err := h.SetStatus(context.Background(), &rec, stuID, tc.status)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRecordResult(t *testing.T) {
+	cmpID := uuid.New()
+	driveID := uuid.New()
+	rec := entities.Recruiter{ID: uuid.New(), CompanyID: cmpID, Name: "Ravi"}
+
+	tests := []struct {
+		description string
+		owner       uuid.UUID
+		mock        func(m mocks)
+		expErr      error
+	}{
+		{"Success case: interviewer defaults to the recruiter", cmpID, func(m mocks) {
+			m.driveSvc.EXPECT().RecordResult(context.Background(), &entities.RoundResult{DriveID: driveID, Round: 1,
+				Passed: true, Interviewer: "Ravi"}).Return(entities.RoundResult{DriveID: driveID, Round: 1}, nil)
+		}, nil},
+		{"Error case: drive of another company", uuid.New(), func(m mocks) {},
+			errors.EntityNotFound{Reason: "id not found: " + driveID.String()}},
+	}
+
+	for i, tc := range tests {
+		m, h := initializeTest(t)
+
+		m.drives.EXPECT().GetByID(context.Background(), driveID).
+			Return(entities.Drive{ID: driveID, Comp: entities.Company{ID: tc.owner}}, nil)
+		tc.mock(m)
+
+		_, err := h.RecordResult(context.Background(), &rec, &entities.RoundResult{DriveID: driveID, Round: 1, Passed: true})
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
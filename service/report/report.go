@@ -0,0 +1,137 @@
+package report
+
+import (
+	"context"
+	"math"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+const maxTop = 50
+
+type handler struct {
+	datastore store.ReportStore
+}
+
+//nolint:revive // it's a factory function
+func New(report store.ReportStore) handler {
+	return handler{datastore: report}
+}
+
+func (h handler) Summary(ctx context.Context, top int) (entities.PlacementSummary, error) {
+	if top < 1 || top > maxTop {
+		return entities.PlacementSummary{}, errors.InvalidParam{Param: "top should be between 1 and 50"}
+	}
+
+	byBranchStatus, err := h.datastore.CountByBranchStatus(ctx)
+	if err != nil {
+		return entities.PlacementSummary{}, err
+	}
+
+	byCategoryStatus, err := h.datastore.CountByCategoryStatus(ctx)
+	if err != nil {
+		return entities.PlacementSummary{}, err
+	}
+
+	recruiters, err := h.datastore.TopRecruiters(ctx, top)
+	if err != nil {
+		return entities.PlacementSummary{}, err
+	}
+
+	ctcs, err := h.datastore.AcceptedCTCs(ctx)
+	if err != nil {
+		return entities.PlacementSummary{}, err
+	}
+
+	summary := entities.PlacementSummary{
+		ByBranch:         []entities.BranchPlacement{},
+		ByBranchStatus:   byBranchStatus,
+		ByCategoryStatus: byCategoryStatus,
+		TopRecruiters:    recruiters,
+		CTCByBranch:      ctcStats(ctcs),
+	}
+
+	index := make(map[entities.Branch]int)
+
+	for _, c := range byBranchStatus {
+		i, ok := index[c.Branch]
+		if !ok {
+			i = len(summary.ByBranch)
+			index[c.Branch] = i
+			summary.ByBranch = append(summary.ByBranch, entities.BranchPlacement{Branch: c.Branch})
+		}
+
+		summary.ByBranch[i].Total += c.Count
+		summary.TotalStudents += c.Count
+
+		if c.Status == entities.ACCEPTED {
+			summary.ByBranch[i].Placed += c.Count
+			summary.PlacedStudents += c.Count
+		}
+	}
+
+	for i := range summary.ByBranch {
+		summary.ByBranch[i].Percentage = percentage(summary.ByBranch[i].Placed, summary.ByBranch[i].Total)
+	}
+
+	summary.PlacementPercentage = percentage(summary.PlacedStudents, summary.TotalStudents)
+
+	return summary, nil
+}
+
+// ctcStats groups CTCs ordered by branch and CTC into per branch averages and medians, rounded to
+// two decimals.
+func ctcStats(ctcs []entities.BranchCTC) []entities.CTCStats {
+	stats := []entities.CTCStats{}
+
+	for start := 0; start < len(ctcs); {
+		end := start
+		for end < len(ctcs) && ctcs[end].Branch == ctcs[start].Branch {
+			end++
+		}
+
+		group := ctcs[start:end]
+
+		var sum int64
+		for _, c := range group {
+			sum += c.CTC
+		}
+
+		mid := len(group) / 2
+		median := float64(group[mid].CTC)
+
+		if len(group)%2 == 0 {
+			median = float64(group[mid-1].CTC+group[mid].CTC) / 2
+		}
+
+		stats = append(stats, entities.CTCStats{
+			Branch:  group[0].Branch,
+			Offers:  len(group),
+			Average: round2(float64(sum) / float64(len(group))),
+			Median:  median,
+		})
+
+		start = end
+	}
+
+	return stats
+}
+
+// percentage returns part as a percentage of total, rounded to two decimals.
+func percentage(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	const hundred = 100
+
+	return round2(float64(part) * hundred / float64(total))
+}
+
+func round2(v float64) float64 {
+	const hundred = 100
+
+	return math.Round(v*hundred) / hundred
+}
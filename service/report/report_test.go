@@ -0,0 +1,102 @@
+package report
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func initializeTest(t *testing.T) *store.MockReportStore {
+	ctrl := gomock.NewController(t)
+	mockReport := store.NewMockReportStore(ctrl)
+
+	return mockReport
+}
+
+func TestSummary(t *testing.T) {
+	mockReport := initializeTest(t)
+	cmpID := uuid.New()
+
+	byBranch := []entities.BranchStatusCount{
+		{Branch: "CSE", Status: "ACCEPTED", Count: 2},
+		{Branch: "CSE", Status: "PENDING", Count: 1},
+		{Branch: "ECE", Status: "REJECTED", Count: 3},
+	}
+	byCategory := []entities.CategoryStatusCount{{Category: "MASS", Status: "ACCEPTED", Count: 2}}
+	recruiters := []entities.RecruiterCount{{Company: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Placed: 2}}
+	ctcs := []entities.BranchCTC{
+		{Branch: "CSE", CTC: 600000}, {Branch: "CSE", CTC: 900000},
+		{Branch: "ECE", CTC: 400000}, {Branch: "ECE", CTC: 450000}, {Branch: "ECE", CTC: 1200000},
+	}
+
+	tests := []struct {
+		description    string
+		top            int
+		branchTimes    int
+		branchErr      error
+		categoryTimes  int
+		categoryErr    error
+		recruiterTimes int
+		expRes         entities.PlacementSummary
+		expErr         error
+	}{
+		{"Success case: percentages are derived from the counts", 5, 1, nil, 1, nil, 1,
+			entities.PlacementSummary{TotalStudents: 6, PlacedStudents: 2, PlacementPercentage: 33.33,
+				ByBranch: []entities.BranchPlacement{
+					{Branch: "CSE", Total: 3, Placed: 2, Percentage: 66.67},
+					{Branch: "ECE", Total: 3, Placed: 0, Percentage: 0},
+				},
+				ByBranchStatus: byBranch, ByCategoryStatus: byCategory, TopRecruiters: recruiters,
+				CTCByBranch: []entities.CTCStats{
+					{Branch: "CSE", Offers: 2, Average: 750000, Median: 750000},
+					{Branch: "ECE", Offers: 3, Average: 683333.33, Median: 450000},
+				}}, nil,
+		},
+		{"Error case: invalid top", 0, 0, nil, 0, nil, 0, entities.PlacementSummary{},
+			errors.InvalidParam{Param: "top should be between 1 and 50"},
+		},
+		{"Error case: branch counts fail", 5, 1, errors.DB{Reason: "server error"}, 0, nil, 0,
+			entities.PlacementSummary{}, errors.DB{Reason: "server error"},
+		},
+		{"Error case: category counts fail", 5, 1, nil, 1, errors.DB{Reason: "server error"}, 0,
+			entities.PlacementSummary{}, errors.DB{Reason: "server error"},
+		},
+	}
+
+	for i, tc := range tests {
+		h := New(mockReport)
+
+		mockReport.EXPECT().CountByBranchStatus(context.Background()).Return(byBranch, tc.branchErr).Times(tc.branchTimes)
+		mockReport.EXPECT().CountByCategoryStatus(context.Background()).Return(byCategory, tc.categoryErr).Times(tc.categoryTimes)
+		mockReport.EXPECT().TopRecruiters(context.Background(), tc.top).Return(recruiters, nil).Times(tc.recruiterTimes)
+		mockReport.EXPECT().AcceptedCTCs(context.Background()).Return(ctcs, nil).Times(tc.recruiterTimes)
+
+		output, err := h.Summary(context.Background(), tc.top)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestSummaryWithoutStudents(t *testing.T) {
+	mockReport := initializeTest(t)
+
+	mockReport.EXPECT().CountByBranchStatus(context.Background()).Return([]entities.BranchStatusCount{}, nil)
+	mockReport.EXPECT().CountByCategoryStatus(context.Background()).Return([]entities.CategoryStatusCount{}, nil)
+	mockReport.EXPECT().TopRecruiters(context.Background(), 1).Return([]entities.RecruiterCount{}, nil)
+	mockReport.EXPECT().AcceptedCTCs(context.Background()).Return([]entities.BranchCTC{}, nil)
+
+	output, err := New(mockReport).Summary(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, output.PlacementPercentage)
+	assert.Equal(t, []entities.BranchPlacement{}, output.ByBranch)
+	assert.Equal(t, []entities.CTCStats{}, output.CTCByBranch)
+}
@@ -0,0 +1,87 @@
+package season
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+type handler struct {
+	store store.SeasonStore
+	// now is replaced in tests to fix the creation and archive times of seasons.
+	now func() time.Time
+}
+
+//nolint:revive // it's a factory function
+func New(s store.SeasonStore) handler {
+	return handler{store: s, now: time.Now}
+}
+
+func (h handler) Get(ctx context.Context) ([]entities.Season, error) {
+	return h.store.Get(ctx)
+}
+
+func (h handler) GetByID(ctx context.Context, id uuid.UUID) (entities.Season, error) {
+	return h.store.GetByID(ctx, id)
+}
+
+func (h handler) Current(ctx context.Context) (entities.Season, error) {
+	return h.store.GetCurrent(ctx)
+}
+
+// Create starts the first season of the college. Once there is a current season, the next one is
+// started by rolling it over.
+func (h handler) Create(ctx context.Context, season *entities.Season) (entities.Season, error) {
+	if err := h.prepare(season); err != nil {
+		return entities.Season{}, err
+	}
+
+	current, err := h.store.GetCurrent(ctx)
+	if err == nil {
+		return entities.Season{}, errors.Conflict{Reason: "season " + current.Name + " is current, roll it over instead"}
+	}
+
+	if _, ok := err.(errors.EntityNotFound); !ok {
+		return entities.Season{}, err
+	}
+
+	return h.store.Create(ctx, season)
+}
+
+// Rollover archives the current season, keeping its students, drives and results readable, and
+// starts next with the companies of the current season taking part in it.
+func (h handler) Rollover(ctx context.Context, next *entities.Season) (entities.Season, error) {
+	if err := h.prepare(next); err != nil {
+		return entities.Season{}, err
+	}
+
+	current, err := h.store.GetCurrent(ctx)
+	if err != nil {
+		return entities.Season{}, err
+	}
+
+	return h.store.Rollover(ctx, current.ID, next)
+}
+
+// prepare checks the name and age rule of a new season and stamps its creation time.
+func (h handler) prepare(season *entities.Season) error {
+	season.Name = strings.TrimSpace(season.Name)
+	if season.Name == "" {
+		return errors.MissingParam{Param: []string{"name"}}
+	}
+
+	if season.MinAge != nil && *season.MinAge < 0 {
+		return errors.InvalidParam{Param: "minAge should not be negative"}
+	}
+
+	season.CreatedAt = h.now()
+	season.ArchivedAt = nil
+
+	return nil
+}
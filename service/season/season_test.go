@@ -0,0 +1,98 @@
+package season
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func initializeTest(t *testing.T) *store.MockSeasonStore {
+	ctrl := gomock.NewController(t)
+
+	return store.NewMockSeasonStore(ctrl)
+}
+
+func TestCreate(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	current := entities.Season{ID: uuid.New(), Name: "2023-24"}
+	negative := -1
+
+	tests := []struct {
+		description string
+		name        string
+		minAge      *int
+		currentRes  entities.Season
+		currentErr  error
+		currentCall int
+		createCall  int
+		expErr      error
+	}{
+		{"Success case: first season", " 2023-24 ", nil, entities.Season{}, errors.EntityNotFound{Reason: "no current season"}, 1, 1, nil},
+		{"Error case: a season is current", "2024-25", nil, current, nil, 1, 0,
+			errors.Conflict{Reason: "season 2023-24 is current, roll it over instead"}},
+		{"Error case: blank name", " ", nil, entities.Season{}, nil, 0, 0, errors.MissingParam{Param: []string{"name"}}},
+		{"Error case: negative minimum age", "2023-24", &negative, entities.Season{}, nil, 0, 0,
+			errors.InvalidParam{Param: "minAge should not be negative"}},
+		{"Error case: lookup fails", "2023-24", nil, entities.Season{}, errors.DB{Reason: "server error"}, 1, 0,
+			errors.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		mockStore := initializeTest(t)
+
+		mockStore.EXPECT().GetCurrent(context.Background()).Return(tc.currentRes, tc.currentErr).Times(tc.currentCall)
+		mockStore.EXPECT().Create(context.Background(), &entities.Season{Name: "2023-24", CreatedAt: now}).
+			Return(entities.Season{Name: "2023-24", CreatedAt: now}, nil).Times(tc.createCall)
+
+		h := New(mockStore)
+		h.now = func() time.Time { return now }
+
+		season := entities.Season{Name: tc.name, MinAge: tc.minAge}
+		_, err := h.Create(context.Background(), &season)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRollover(t *testing.T) {
+	now := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+	current := entities.Season{ID: uuid.New(), Name: "2023-24"}
+
+	tests := []struct {
+		description  string
+		currentErr   error
+		rolloverCall int
+		rolloverErr  error
+		expErr       error
+	}{
+		{"Success case", nil, 1, nil, nil},
+		{"Error case: no current season", errors.EntityNotFound{Reason: "no current season"}, 0, nil,
+			errors.EntityNotFound{Reason: "no current season"}},
+		{"Error case: archived meanwhile", nil, 1, errors.Conflict{Reason: "season already archived"},
+			errors.Conflict{Reason: "season already archived"}},
+	}
+
+	for i, tc := range tests {
+		mockStore := initializeTest(t)
+
+		mockStore.EXPECT().GetCurrent(context.Background()).Return(current, tc.currentErr)
+		mockStore.EXPECT().Rollover(context.Background(), current.ID, &entities.Season{Name: "2024-25", CreatedAt: now}).
+			Return(entities.Season{Name: "2024-25", CreatedAt: now}, tc.rolloverErr).Times(tc.rolloverCall)
+
+		h := New(mockStore)
+		h.now = func() time.Time { return now }
+
+		next := entities.Season{Name: "2024-25"}
+		_, err := h.Rollover(context.Background(), &next)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
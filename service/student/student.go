@@ -2,22 +2,54 @@ package student
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"reflect"
 	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/aditi-zs/Placement-API/entities"
 	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/mergepatch"
+	"github.com/aditi-zs/Placement-API/phone"
+	"github.com/aditi-zs/Placement-API/service"
 	"github.com/aditi-zs/Placement-API/store"
 )
 
+// Config holds the rules the service validates students against.
+type Config struct {
+	// MinAge is the minimum age a student must have reached on AgeReferenceDate, for seasons that do
+	// not set their own.
+	MinAge int
+	// AgeReferenceDate is the day ages are computed on, for seasons that do not set their own. The
+	// zero value means the current day.
+	AgeReferenceDate time.Time
+	// PhoneRegion is the region of phone numbers written without a country code.
+	PhoneRegion string
+	// DuplicateKeys are the keys a new or changed student is checked against existing ones on.
+	DuplicateKeys []entities.DuplicateKey
+}
+
+func DefaultConfig() Config {
+	return Config{
+		MinAge:        minAge,
+		PhoneRegion:   phone.DefaultRegion,
+		DuplicateKeys: []entities.DuplicateKey{entities.PhoneKey, entities.NameDOBKey},
+	}
+}
+
 type handler struct {
 	datastore store.StudentStore
+	seasons   store.SeasonStore
+	documents service.DocumentSvc
+	cfg       Config
 }
 
 //nolint:revive // it's a factory function
-func New(student store.StudentStore) handler {
-	return handler{datastore: student}
+func New(student store.StudentStore, seasons store.SeasonStore, documents service.DocumentSvc, cfg Config) handler {
+	return handler{datastore: student, seasons: seasons, documents: documents, cfg: cfg}
 }
 
 func (s handler) GetByID(ctx context.Context, id uuid.UUID) (entities.Student, error) {
@@ -51,8 +83,18 @@ func (s handler) Get(ctx context.Context, name, branch, includeCompany string) (
 	return resp, nil
 }
 
+// Export streams the students matching the same filters as Get to fn. Company details are
+// included unless includeCompany is explicitly "false".
+func (s handler) Export(ctx context.Context, name, branch, includeCompany string, fn func(entities.Student) error) error {
+	if err := validateQuery(name, branch, includeCompany); err != nil {
+		return err
+	}
+
+	return s.datastore.Stream(ctx, name, branch, includeCompany != "false", fn)
+}
+
 func (s handler) Create(ctx context.Context, st *entities.Student) (entities.Student, error) {
-	if err := validateStudent(st); err != nil {
+	if err := s.validateStudent(ctx, st); err != nil {
 		return entities.Student{}, err
 	}
 
@@ -68,6 +110,14 @@ func (s handler) Create(ctx context.Context, st *entities.Student) (entities.Stu
 		return entities.Student{}, err
 	}
 
+	if err = checkEligibility(company, st); err != nil {
+		return entities.Student{}, err
+	}
+
+	if err = s.findDuplicate(ctx, st); err != nil {
+		return entities.Student{}, err
+	}
+
 	resp, err := s.datastore.Create(ctx, st)
 	if err != nil {
 		return entities.Student{}, err
@@ -76,7 +126,7 @@ func (s handler) Create(ctx context.Context, st *entities.Student) (entities.Stu
 	return resp, nil
 }
 func (s handler) Update(ctx context.Context, id uuid.UUID, st *entities.Student) (entities.Student, error) {
-	if err := validateStudent(st); err != nil {
+	if err := s.validateStudent(ctx, st); err != nil {
 		return entities.Student{}, err
 	}
 
@@ -92,6 +142,17 @@ func (s handler) Update(ctx context.Context, id uuid.UUID, st *entities.Student)
 		return entities.Student{}, err
 	}
 
+	if err = checkEligibility(company, st); err != nil {
+		return entities.Student{}, err
+	}
+
+	probe := *st
+	probe.ID = id
+
+	if err = s.findDuplicate(ctx, &probe); err != nil {
+		return entities.Student{}, err
+	}
+
 	resp, err := s.datastore.Update(ctx, id, st)
 
 	if err != nil {
@@ -101,23 +162,251 @@ func (s handler) Update(ctx context.Context, id uuid.UUID, st *entities.Student)
 	return resp, nil
 }
 
-func (s handler) Delete(ctx context.Context, id uuid.UUID) error {
-	err := s.datastore.Delete(ctx, id)
+func (s handler) Patch(ctx context.Context, id uuid.UUID, patch []byte) (entities.Student, error) {
+	existing, err := s.datastore.GetByID(ctx, id)
+	if err != nil {
+		return entities.Student{}, err
+	}
 
+	doc, err := json.Marshal(existing)
 	if err != nil {
+		return entities.Student{}, err
+	}
+
+	merged, err := mergepatch.Apply(doc, patch)
+	if err != nil {
+		return entities.Student{}, errors.InvalidParam{Param: "invalid merge patch document"}
+	}
+
+	var st entities.Student
+	if err = json.Unmarshal(merged, &st); err != nil {
+		return entities.Student{}, errors.InvalidParam{Param: "invalid merge patch document"}
+	}
+
+	st.ID = id
+
+	if err = s.validateStudent(ctx, &st); err != nil {
+		return entities.Student{}, err
+	}
+
+	company, err := s.datastore.GetCompanyByID(ctx, st.Comp.ID)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	if err = validateBranch(company.Category, st.Branch); err != nil {
+		return entities.Student{}, err
+	}
+
+	if err = checkEligibility(company, &st); err != nil {
+		return entities.Student{}, err
+	}
+
+	st.Comp = company
+
+	fields := changedFields(&existing, &st)
+	if len(fields) == 0 {
+		return st, nil
+	}
+
+	if hasDuplicateFields(fields) {
+		if err = s.findDuplicate(ctx, &st); err != nil {
+			return entities.Student{}, err
+		}
+	}
+
+	if err = s.datastore.Patch(ctx, id, fields); err != nil {
+		return entities.Student{}, err
+	}
+
+	return st, nil
+}
+
+// Import validates every row with the same rules as Create and, unless dryRun is set, stores the
+// valid rows in one transaction. Rows that already carry an error are reported as failed as is.
+func (s handler) Import(ctx context.Context, rows []entities.ImportRow, dryRun bool) (entities.ImportReport, error) {
+	report := entities.ImportReport{DryRun: dryRun, Total: len(rows), Rows: append([]entities.ImportRow(nil), rows...)}
+	companies := make(map[uuid.UUID]entities.Company)
+	seen := make(map[string]int)
+
+	var valid []*entities.Student
+
+	rule, err := s.ageRule(ctx)
+	if err != nil {
+		return entities.ImportReport{}, err
+	}
+
+	for i := range report.Rows {
+		row := &report.Rows[i]
+		if row.Error != "" {
+			continue
+		}
+
+		if err := s.validateImportRow(ctx, &row.Student, rule, companies); err != nil {
+			row.Error = err.Error()
+			continue
+		}
+
+		if err := s.checkImportDuplicate(ctx, row, seen); err != nil {
+			row.Error = err.Error()
+			continue
+		}
+
+		valid = append(valid, &row.Student)
+	}
+
+	if !dryRun && len(valid) != 0 {
+		if err := s.datastore.CreateBatch(ctx, valid); err != nil {
+			return entities.ImportReport{}, err
+		}
+	}
+
+	for i := range report.Rows {
+		row := &report.Rows[i]
+		if row.Error != "" {
+			report.Failed++
+			continue
+		}
+
+		report.Succeeded++
+
+		if !dryRun {
+			id := row.Student.ID
+			row.ID = &id
+		}
+	}
+
+	return report, nil
+}
+
+func (s handler) validateImportRow(ctx context.Context, st *entities.Student, rule ageRule,
+	companies map[uuid.UUID]entities.Company) error {
+	if err := s.checkStudent(st, rule); err != nil {
+		return err
+	}
+
+	company, ok := companies[st.Comp.ID]
+	if !ok {
+		var err error
+
+		company, err = s.datastore.GetCompanyByID(ctx, st.Comp.ID)
+		if err != nil {
+			return err
+		}
+
+		companies[st.Comp.ID] = company
+	}
+
+	if err := validateBranch(company.Category, st.Branch); err != nil {
 		return err
 	}
 
+	return checkEligibility(company, st)
+}
+
+// checkImportDuplicate reports row as a duplicate of an earlier row of the same file or of a stored
+// student. seen maps the duplicate key values of the rows accepted so far to their row numbers.
+func (s handler) checkImportDuplicate(ctx context.Context, row *entities.ImportRow, seen map[string]int) error {
+	for _, key := range s.cfg.DuplicateKeys {
+		if prev, ok := seen[string(key)+":"+key.Value(&row.Student)]; ok {
+			return errors.InvalidParam{Param: fmt.Sprintf("same %s as row %d", key.Label(), prev)}
+		}
+	}
+
+	if err := s.findDuplicate(ctx, &row.Student); err != nil {
+		return err
+	}
+
+	for _, key := range s.cfg.DuplicateKeys {
+		seen[string(key)+":"+key.Value(&row.Student)] = row.Row
+	}
+
+	return nil
+}
+
+// Merge folds the duplicate student into the student with the given id and deletes the duplicate.
+// The kept student takes over the company link of the duplicate when the duplicate got further in
+// the placement process.
+func (s handler) Merge(ctx context.Context, id, duplicateID uuid.UUID) (entities.Student, error) {
+	if id == duplicateID {
+		return entities.Student{}, errors.InvalidParam{Param: "a student cannot be merged with itself"}
+	}
+
+	keep, err := s.datastore.GetByID(ctx, id)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	duplicate, err := s.datastore.GetByID(ctx, duplicateID)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	if placementRank(duplicate.Status) > placementRank(keep.Status) {
+		keep.Comp = duplicate.Comp
+		keep.Status = duplicate.Status
+	}
+
+	if err = s.datastore.Merge(ctx, &keep, duplicateID); err != nil {
+		return entities.Student{}, err
+	}
+
+	return keep, nil
+}
+
+// Delete removes the student. Their documents go with them, and the contents no other document
+// shares are removed from blob storage.
+func (s handler) Delete(ctx context.Context, id uuid.UUID) error {
+	docs, err := s.documents.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err = s.datastore.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.documents.Purge(ctx, docs)
+
 	return nil
 }
 
 const trueVal, mech, ise, civil, cse, ece, eee = "true", "MECH", "ISE", "CIVIL", "CSE", "ECE", "EEE"
 
-func getAge(dob string) int {
-	date, _ := time.Parse("02/01/2006", dob)
-	today := time.Now()
+// ageRule is the age students must have reached on the day their age is computed on.
+type ageRule struct {
+	minAge int
+	day    time.Time
+}
+
+// ageRule returns the age rule of the season ctx is scoped to. A season that does not set the
+// minimum age or the reference date uses the configured one.
+func (s handler) ageRule(ctx context.Context) (ageRule, error) {
+	rule := ageRule{minAge: s.cfg.MinAge, day: s.cfg.AgeReferenceDate}
+
+	id, err := store.Season(ctx)
+	if err != nil {
+		return ageRule{}, err
+	}
+
+	season, err := s.seasons.GetByID(ctx, id)
+	if err != nil {
+		return ageRule{}, err
+	}
+
+	if season.MinAge != nil {
+		rule.minAge = *season.MinAge
+	}
 
-	return today.Year() - date.Year()
+	if season.AgeReferenceDate != nil && !season.AgeReferenceDate.IsZero() {
+		rule.day = season.AgeReferenceDate.Time
+	}
+
+	if rule.day.IsZero() {
+		rule.day = time.Now()
+	}
+
+	return rule, nil
 }
 func validateQuery(name, branch, includeCompany string) error {
 	switch {
@@ -132,37 +421,158 @@ func validateQuery(name, branch, includeCompany string) error {
 	}
 }
 
-const minAge, nameLen, minphnlen, maxPhnLen = 22, 3, 10, 12
+const minAge, nameLen = 22, 3
 
-func validateStudent(stu *entities.Student) error {
-	switch {
-	case len(stu.Name) < nameLen:
+// validateStudent checks stu against the rules of the season in ctx and normalizes its phone
+// number to E.164.
+func (s handler) validateStudent(ctx context.Context, stu *entities.Student) error {
+	rule, err := s.ageRule(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.checkStudent(stu, rule)
+}
+
+// checkStudent checks stu against rule and the other student rules and normalizes its phone number.
+func (s handler) checkStudent(stu *entities.Student, rule ageRule) error {
+	if len(stu.Name) < nameLen {
 		return errors.InvalidParam{Param: "name should be minimum of three characters long"}
-	case len(stu.Phone) < minphnlen || len(stu.Phone) > maxPhnLen:
-		return errors.InvalidParam{Param: "phone number must be 10-12 digit long"}
+	}
+
+	normalized, err := phone.Normalize(stu.Phone, s.cfg.PhoneRegion)
+	if err != nil {
+		return err
+	}
+
+	stu.Phone = normalized
+
+	switch {
 	case !entities.IsValidBranch(stu.Branch):
 		return errors.InvalidParam{Param: "this branch is not allowed"}
-	case getAge(stu.DOB) < minAge:
-		return errors.InvalidParam{Param: "age should be greater than 22"}
+	case stu.DOB.IsZero():
+		return errors.InvalidParam{Param: "dob is required"}
+	case stu.DOB.AgeOn(rule.day) < rule.minAge:
+		return errors.InvalidParam{Param: fmt.Sprintf("age should be greater than %d", rule.minAge)}
 	case !entities.IsValidStatus(stu.Status):
 		return errors.InvalidParam{Param: "invalid status"}
+	case stu.Email != "" && !isValidEmail(stu.Email):
+		return errors.InvalidParam{Param: "invalid email"}
+	case stu.Academic != nil:
+		return stu.Academic.Validate()
 	default:
 		return nil
 	}
 }
 
+// isValidEmail reports whether email is a bare address, without a display name.
+func isValidEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+
+	return err == nil && addr.Address == email
+}
+
+// checkEligibility returns errors.Ineligible listing every eligibility criterion of company st fails.
+func checkEligibility(company entities.Company, st *entities.Student) error {
+	if company.Criteria == nil {
+		return nil
+	}
+
+	if failed := company.Criteria.Check(st); len(failed) != 0 {
+		return errors.Ineligible{Criteria: failed}
+	}
+
+	return nil
+}
+
+// findDuplicate returns errors.Duplicate naming the first stored student that matches st on one of
+// the configured keys.
+func (s handler) findDuplicate(ctx context.Context, st *entities.Student) error {
+	for _, key := range s.cfg.DuplicateKeys {
+		id, err := s.datastore.FindDuplicate(ctx, st, key)
+		if err != nil {
+			return err
+		}
+
+		if id != uuid.Nil {
+			return errors.Duplicate{ID: id.String(), Key: key.Label()}
+		}
+	}
+
+	return nil
+}
+
+// hasDuplicateFields reports whether a patch touches a field used by the duplicate keys.
+func hasDuplicateFields(fields map[string]interface{}) bool {
+	for _, f := range []string{"name", "phone", "dob"} {
+		if _, ok := fields[f]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// placementRank orders statuses by how far a student got in the placement process.
+func placementRank(status entities.Status) int {
+	switch status {
+	case entities.ACCEPTED:
+		return 3
+	case entities.SHORTLISTED:
+		return 2
+	case entities.PENDING:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// changedFields returns the fields of updated that differ from old, keyed by their JSON names.
+func changedFields(old, updated *entities.Student) map[string]interface{} {
+	fields := make(map[string]interface{})
+
+	if old.Name != updated.Name {
+		fields["name"] = updated.Name
+	}
+
+	if old.Phone != updated.Phone {
+		fields["phone"] = updated.Phone
+	}
+
+	if !old.DOB.Equal(updated.DOB.Time) {
+		fields["dob"] = updated.DOB
+	}
+
+	if old.Branch != updated.Branch {
+		fields["branch"] = updated.Branch
+	}
+
+	if old.Comp.ID != updated.Comp.ID {
+		fields["comp"] = updated.Comp.ID
+	}
+
+	if old.Status != updated.Status {
+		fields["status"] = updated.Status
+	}
+
+	if !reflect.DeepEqual(old.Academic, updated.Academic) {
+		fields["academic"] = updated.Academic
+	}
+
+	if old.Email != updated.Email {
+		fields["email"] = updated.Email
+	}
+
+	return fields
+}
+
 //nolint:gocognit,gocyclo    //this function has many conditions to check
 func validateBranch(category entities.Category, branch entities.Branch) error {
-	switch {
-	case category == "CORE" && branch != civil && branch != mech:
+	if !category.AllowsBranch(branch) {
 		return errors.InvalidParam{Param: "invalid branch for this company category"}
-	case category == "OPEN DREAM" && branch != cse && branch != ise && branch != ece && branch != eee:
-		return errors.InvalidParam{Param: "invalid branch for this company category"}
-	case category == "DREAM IT" && branch != cse && branch != ise:
-		return errors.InvalidParam{Param: "invalid branch for this company category"}
-	default:
-		return nil
 	}
+
+	return nil
 }
 
 func IsValidBranch(b string) bool {
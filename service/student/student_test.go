@@ -3,16 +3,38 @@ package student
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/aditi-zs/Placement-API/auth"
 	"github.com/aditi-zs/Placement-API/entities"
 	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/service"
 	"github.com/aditi-zs/Placement-API/store"
 )
 
+// seasonID is the season the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var seasonID = uuid.New()
+
+// scoped returns a context scoped to seasonID, as the season middleware sets it up.
+func scoped() context.Context {
+	return auth.WithSeason(context.Background(), seasonID)
+}
+
+// seasonStore returns a season store serving season as the season the tests run in.
+func seasonStore(t *testing.T, season entities.Season) *store.MockSeasonStore {
+	mockSeason := store.NewMockSeasonStore(gomock.NewController(t))
+	season.ID = seasonID
+	mockSeason.EXPECT().GetByID(gomock.Any(), seasonID).Return(season, nil).AnyTimes()
+
+	return mockSeason
+}
+
 func initializeTest(t *testing.T) *store.MockStudentStore {
 	ctrl := gomock.NewController(t)
 	mockStudent := store.NewMockStudentStore(ctrl)
@@ -36,21 +58,21 @@ func TestGet(t *testing.T) {
 		expErr              error
 	}{
 		{"Success case: getting all records with company details", "", "ECE", "true", 1,
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil,
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil,
 		},
 		{"Success case: when query params are valid", "Monika", "ECE", "true", 1,
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil,
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil,
 		},
 		{"Success case: get data without company details", "Monika", "ECE", "false", 1,
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{}, Status: "ACCEPTED"}}, nil,
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{}, Status: "ACCEPTED"}}, nil,
 		},
 		{"Error case: when query name is less than three characters", "ab", "", "", 0,
@@ -72,15 +94,15 @@ func TestGet(t *testing.T) {
 	}
 
 	for i, tc := range tests {
-		s := New(mockStudent)
+		s := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig())
 
 		if tc.queryIncludeCompany == "true" {
-			mockStudent.EXPECT().GetWithCompany(context.Background(), tc.queryName, tc.queryBranch).Return(tc.mockOP, tc.mockErr).Times(tc.mockTimes)
+			mockStudent.EXPECT().GetWithCompany(scoped(), tc.queryName, tc.queryBranch).Return(tc.mockOP, tc.mockErr).Times(tc.mockTimes)
 		} else {
-			mockStudent.EXPECT().Get(context.Background(), tc.queryName, tc.queryBranch).Return(tc.mockOP, tc.mockErr).Times(tc.mockTimes)
+			mockStudent.EXPECT().Get(scoped(), tc.queryName, tc.queryBranch).Return(tc.mockOP, tc.mockErr).Times(tc.mockTimes)
 		}
 
-		output, err := s.Get(context.Background(), tc.queryName, tc.queryBranch, tc.queryIncludeCompany)
+		output, err := s.Get(scoped(), tc.queryName, tc.queryBranch, tc.queryIncludeCompany)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
 		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
@@ -97,22 +119,53 @@ func TestGetByID(t *testing.T) {
 		res         entities.Student
 		err         error
 	}{
-		{"Success case: for valid id", id, entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+		{"Success case: for valid id", id, entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 			Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, nil},
 		{"Error case: when id is valid but id is not present in db", id, entities.Student{}, errors.DB{Reason: "id not found"}},
 	}
 
 	for i, tc := range tests {
-		s := New(mockStudent)
+		s := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig())
 
-		mockStudent.EXPECT().GetByID(context.Background(), tc.inputID).Return(tc.res, tc.err)
-		output, err := s.GetByID(context.Background(), tc.inputID)
+		mockStudent.EXPECT().GetByID(scoped(), tc.inputID).Return(tc.res, tc.err)
+		output, err := s.GetByID(scoped(), tc.inputID)
 
 		assert.Equal(t, tc.res, output, "Test[%d] failed\n(%s)", i, tc.description)
 		assert.Equal(t, tc.err, err, "Test[%d] failed\n(%s)", i, tc.description)
 	}
 }
 
+func TestExport(t *testing.T) {
+	mockStudent := initializeTest(t)
+	tests := []struct {
+		description    string
+		name           string
+		branch         string
+		includeCompany string
+		mockTimes      int
+		expWithCompany bool
+		mockErr        error
+		expErr         error
+	}{
+		{"Success case: company details by default", "", "CSE", "", 1, true, nil, nil},
+		{"Success case: company details excluded", "Monika", "", "false", 1, false, nil, nil},
+		{"Error case: invalid branch", "", "ABC", "", 0, false, nil, errors.InvalidParam{Param: "this branch is not allowed"}},
+		{"Error case: server error", "", "", "true", 1, true, errors.DB{Reason: "server error"}, errors.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		s := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig())
+		fn := func(entities.Student) error { return nil }
+
+		mockStudent.EXPECT().Stream(scoped(), tc.name, tc.branch, tc.expWithCompany, gomock.Any()).
+			Return(tc.mockErr).Times(tc.mockTimes)
+
+		err := s.Export(scoped(), tc.name, tc.branch, tc.includeCompany, fn)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
 func TestCreate(t *testing.T) {
 	mockStudent := initializeTest(t)
 	cmpID := uuid.New()
@@ -128,73 +181,84 @@ func TestCreate(t *testing.T) {
 		expRes                entities.Student
 		expErr                error
 	}{
-		{"Success case: All entries are present", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+		{"Success case: All entries are present", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 			Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 1, 1,
 			entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, nil,
-			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, nil,
-			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, nil,
 		},
 		{"Error case: When branch is different from given branches", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118",
-			DOB: "02/07/2000", Branch: "ABC", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"},
+			DOB: entities.NewDate(2000, 7, 2), Branch: "ABC", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"},
 			0, 0, entities.Company{}, nil, entities.Student{},
 			nil, entities.Student{}, errors.InvalidParam{Param: "this branch is not allowed"},
 		},
-		{"Error case: When name has less than 3 characters", entities.Student{Name: "Mn", Phone: "6388768118", DOB: "02/07/2000",
+		{"Error case: When name has less than 3 characters", entities.Student{Name: "Mn", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 			Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 0,
 			0, entities.Company{}, nil, entities.Student{}, nil,
 			entities.Student{}, errors.InvalidParam{Param: "name should be minimum of three characters long"},
 		},
 		{"Error case: When phone number has less than 10 numbers", entities.Student{Name: "Monika Jaiswal", Phone: "638876811",
-			DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"},
+			DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"},
 			0, 0, entities.Company{}, nil, entities.Student{},
-			nil, entities.Student{}, errors.InvalidParam{Param: "phone number must be 10-12 digit long"},
+			nil, entities.Student{}, errors.InvalidParam{Param: "phone number is not a valid IN number"},
+		},
+		{"Error case: When phone number has letters", entities.Student{Name: "Monika Jaiswal", Phone: "63887abc18",
+			DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"},
+			0, 0, entities.Company{}, nil, entities.Student{}, nil, entities.Student{},
+			errors.InvalidParam{Param: "phone number should contain only digits, spaces, dashes, brackets and a leading +"},
 		},
-		{"Error case: When age is less than 22", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2010",
+		{"Error case: When age is less than 22", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2010, 7, 2),
 			Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 0, 0,
 			entities.Company{}, nil, entities.Student{}, nil,
 			entities.Student{}, errors.InvalidParam{Param: "age should be greater than 22"},
 		},
-		{"Error case: invalid status", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+		{"Error case: invalid status", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 			Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ABC"}, 0, 0, entities.Company{},
 			nil, entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "invalid status"},
 		},
-		{"Error case: db error", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+		{"Error case: invalid email", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
+			Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED", Email: "Monika <monika@example.com>"},
+			0, 0, entities.Company{}, nil, entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "invalid email"},
+		},
+		{"Error case: db error", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 			Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 1, 1,
 			entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, nil, entities.Student{},
 			errors.DB{Reason: "server error"}, entities.Student{}, errors.DB{Reason: "server error"},
 		},
-		{"Error case: when id not found", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "CSE",
+		{"Error case: when id not found", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "CSE",
 			Comp: entities.Company{ID: cmpID, Name: "MicroSoft", Category: "DREAM IT"}, Status: "ACCEPTED"}, 1, 0,
 			entities.Company{}, errors.DB{Reason: "id not found"}, entities.Student{}, nil,
 			entities.Student{}, errors.DB{Reason: "id not found"},
 		},
 		{"Error case: When branch is different from given branches for a company category", entities.Student{Name: "Monika Jaiswal",
-			Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "MicroSoft", Category: "DREAM IT"},
+			Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "MicroSoft", Category: "DREAM IT"},
 			Status: "ACCEPTED"}, 1, 0, entities.Company{ID: cmpID, Name: "MicroSoft", Category: "DREAM IT"},
 			nil, entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "invalid branch for this company category"},
 		},
 		{"Error case: When branch is different from given branches for a company category", entities.Student{Name: "Monika Jaiswal",
-			Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "TCS", Category: "CORE"},
+			Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "TCS", Category: "CORE"},
 			Status: "ACCEPTED"}, 1, 0, entities.Company{ID: cmpID, Name: "TCS", Category: "CORE"}, nil,
 			entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "invalid branch for this company category"},
 		},
 		{"Error case: When branch is different from given branches for a company category", entities.Student{Name: "Monika Jaiswal",
-			Phone: "6388768118", DOB: "02/07/2000", Branch: "MECH", Comp: entities.Company{ID: cmpID, Name: "ZopSmart", Category: "OPEN DREAM"},
+			Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "MECH", Comp: entities.Company{ID: cmpID, Name: "ZopSmart", Category: "OPEN DREAM"},
 			Status: "ACCEPTED"}, 1, 0, entities.Company{ID: cmpID, Name: "ZopSmart", Category: "OPEN DREAM"}, nil,
 			entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "invalid branch for this company category"},
 		},
 	}
 
+	mockStudent.EXPECT().FindDuplicate(scoped(), gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).AnyTimes()
+
 	for i, tc := range tests {
-		s := New(mockStudent)
-		mockStudent.EXPECT().GetCompanyByID(context.Background(), tc.input.Comp.ID).
+		s := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig())
+		mockStudent.EXPECT().GetCompanyByID(scoped(), tc.input.Comp.ID).
 			Return(tc.mockGetCompanyByIDRes, tc.mockGetCompanyByIDErr).Times(tc.mockGetCompByIDTimes)
-		mockStudent.EXPECT().Create(context.Background(), &tc.input).
+		mockStudent.EXPECT().Create(scoped(), &tc.input).
 			Return(tc.mockPostDataRes, tc.mockPostDataErr).Times(tc.mockPostData)
 
-		output, err := s.Create(context.Background(), &tc.input)
+		output, err := s.Create(scoped(), &tc.input)
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
 		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
 	}
@@ -217,72 +281,425 @@ func TestUpdate(t *testing.T) {
 		expRes               entities.Student
 		expErr               error
 	}{
-		{"Success case: for valid id", id, entities.Student{Name: "Aditi Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+		{"Success case: for valid id", id, entities.Student{Name: "Aditi Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 			Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 1, 1,
 			entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, nil, entities.Student{ID: id, Name: "Aditi Jaiswal",
-				Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"},
-				Status: "ACCEPTED"}, nil, entities.Student{ID: id, Name: "Aditi Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+				Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"},
+				Status: "ACCEPTED"}, nil, entities.Student{ID: id, Name: "Aditi Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, nil,
 		},
 		{"Error case: When branch is different from given branches", id, entities.Student{Name: "Aditi Jaiswal", Phone: "6388768118",
-			DOB: "02/07/2000", Branch: "ABC", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"},
+			DOB: entities.NewDate(2000, 7, 2), Branch: "ABC", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"},
 			0, 0, entities.Company{}, nil, entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "this branch is not allowed"},
 		},
-		{"Error case: When name has less than 3 characters", id, entities.Student{Name: "Ad", Phone: "6388768118", DOB: "02/07/2000",
+		{"Error case: When name has less than 3 characters", id, entities.Student{Name: "Ad", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 			Branch: "ABC", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 0, 0, entities.Company{},
 			nil, entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "name should be minimum of three characters long"},
 		},
-		{"Error case: When phone number has less than 10 numbers", id, entities.Student{Name: "Aditi", Phone: "638876811", DOB: "02/07/2000",
+		{"Error case: When phone number has less than 10 numbers", id, entities.Student{Name: "Aditi", Phone: "638876811", DOB: entities.NewDate(2000, 7, 2),
 			Branch: "ABC", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 0, 0, entities.Company{},
-			nil, entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "phone number must be 10-12 digit long"},
+			nil, entities.Student{}, nil, entities.Student{}, errors.InvalidParam{Param: "phone number is not a valid IN number"},
 		},
 		{"Error case: when id is valid but id is not present in db", id, entities.Student{Name: "Aditi Jaiswal", Phone: "6388768118",
-			DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 1, 1,
+			DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, 1, 1,
 			entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, nil, entities.Student{}, errors.DB{Reason: "id not found"},
 			entities.Student{}, errors.DB{Reason: "id not found"},
 		},
 		{"Error case: When branch is different from given branches for a company category", id, entities.Student{Name: "Aditi Jaiswal",
-			Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "MicroSoft", Category: "DREAM IT"},
+			Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "MicroSoft", Category: "DREAM IT"},
 			Status: "ACCEPTED"}, 1, 0, entities.Company{ID: cmpID, Name: "MicroSoft", Category: "DREAM IT"}, nil, entities.Student{},
 			nil, entities.Student{}, errors.InvalidParam{Param: "invalid branch for this company category"},
 		},
-		{"Error case: db error", id, entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+		{"Error case: db error", id, entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 			Branch: "CSE", Comp: entities.Company{ID: cmpID, Name: "MicroSoft", Category: "DREAM IT"}, Status: "ACCEPTED"}, 1, 0, entities.Company{},
 			errors.DB{Reason: "id not found"}, entities.Student{}, nil, entities.Student{}, errors.DB{Reason: "id not found"},
 		},
 	}
 
+	mockStudent.EXPECT().FindDuplicate(scoped(), gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).AnyTimes()
+
 	for i, tc := range tests {
-		s := New(mockStudent)
-		mockStudent.EXPECT().GetCompanyByID(context.Background(), tc.input.Comp.ID).
+		s := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig())
+		mockStudent.EXPECT().GetCompanyByID(scoped(), tc.input.Comp.ID).
 			Return(tc.mockGetCompByIDRes, tc.mockGetCompByIDErr).Times(tc.mockGetCompByIDTimes)
-		mockStudent.EXPECT().Update(context.Background(), tc.inputID, &tc.input).
+		mockStudent.EXPECT().Update(scoped(), tc.inputID, &tc.input).
 			Return(tc.mockUpdateDataRes, tc.mockUpdateDataErr).Times(tc.mockUpdateDataTimes)
 
-		output, err := s.Update(context.Background(), tc.inputID, &tc.input)
+		output, err := s.Update(scoped(), tc.inputID, &tc.input)
+
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestPatch(t *testing.T) {
+	mockStudent := initializeTest(t)
+	id := uuid.New()
+	cmpID := uuid.New()
+	newCmpID := uuid.New()
+	wipro := entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}
+	google := entities.Company{ID: newCmpID, Name: "Google", Category: "DREAM IT"}
+	existing := entities.Student{ID: id, Name: "Aditi Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "CSE",
+		Comp: wipro, Status: "PENDING"}
+	tests := []struct {
+		description  string
+		patch        string
+		mockGetErr   error
+		getCompTimes int
+		mockComp     entities.Company
+		mockCompErr  error
+		patchTimes   int
+		expFields    map[string]interface{}
+		mockPatchErr error
+		expRes       entities.Student
+		expErr       error
+	}{
+		{"Success case: only status is written", `{"status":"ACCEPTED"}`, nil, 1, wipro, nil, 1,
+			map[string]interface{}{"status": entities.ACCEPTED}, nil,
+			entities.Student{ID: id, Name: "Aditi Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "CSE",
+				Comp: wipro, Status: "ACCEPTED"}, nil,
+		},
+		{"Success case: company is replaced", `{"comp":{"id":"` + newCmpID.String() + `"}}`, nil, 1, google, nil, 1,
+			map[string]interface{}{"comp": newCmpID}, nil,
+			entities.Student{ID: id, Name: "Aditi Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "CSE",
+				Comp: google, Status: "PENDING"}, nil,
+		},
+		{"Success case: nothing changed", `{"name":"Aditi Jaiswal"}`, nil, 1, wipro, nil, 0, nil, nil, existing, nil},
+		{"Error case: when id is not present in db", `{"status":"ACCEPTED"}`, errors.EntityNotFound{Reason: "id not found"},
+			0, entities.Company{}, nil, 0, nil, nil, entities.Student{}, errors.EntityNotFound{Reason: "id not found"},
+		},
+		{"Error case: merged status is invalid", `{"status":"ABC"}`, nil, 0, entities.Company{}, nil, 0, nil, nil,
+			entities.Student{}, errors.InvalidParam{Param: "invalid status"},
+		},
+		{"Error case: merged branch is invalid for the company category", `{"branch":"MECH","comp":{"id":"` +
+			newCmpID.String() + `"}}`, nil, 1, google, nil, 0, nil, nil,
+			entities.Student{}, errors.InvalidParam{Param: "invalid branch for this company category"},
+		},
+		{"Error case: patch is not a valid document", `{"status":1}`, nil, 0, entities.Company{}, nil, 0, nil, nil,
+			entities.Student{}, errors.InvalidParam{Param: "invalid merge patch document"},
+		},
+		{"Error case: server error", `{"phone":"63887 68119"}`, nil, 1, wipro, nil, 1,
+			map[string]interface{}{"phone": "+916388768119"}, errors.DB{Reason: "server error"},
+			entities.Student{}, errors.DB{Reason: "server error"},
+		},
+	}
+
+	mockStudent.EXPECT().FindDuplicate(scoped(), gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).AnyTimes()
+
+	for i, tc := range tests {
+		s := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig())
+
+		mockStudent.EXPECT().GetByID(scoped(), id).Return(existing, tc.mockGetErr)
+		mockStudent.EXPECT().GetCompanyByID(scoped(), gomock.Any()).Return(tc.mockComp, tc.mockCompErr).
+			Times(tc.getCompTimes)
+		mockStudent.EXPECT().Patch(scoped(), id, tc.expFields).Return(tc.mockPatchErr).Times(tc.patchTimes)
+
+		output, err := s.Patch(scoped(), id, []byte(tc.patch))
 
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
 		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestImport(t *testing.T) {
+	mockStudent := initializeTest(t)
+	cmpID := uuid.New()
+	dreamID := uuid.New()
+	wipro := entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}
+	google := entities.Company{ID: dreamID, Name: "Google", Category: "DREAM IT"}
+	valid := entities.Student{Name: "Monika Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+		Comp: entities.Company{ID: cmpID}, Status: "PENDING"}
+	dream := entities.Student{Name: "Aditi Jaiswal", Phone: "+916388768119", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+		Comp: entities.Company{ID: dreamID}, Status: "PENDING"}
+	young := valid
+	young.DOB = entities.NewDate(2010, 7, 2)
+
+	rows := []entities.ImportRow{
+		{Row: 2, Student: valid},
+		{Row: 3, Error: "Missing Parameter: phone"},
+		{Row: 4, Student: young},
+		{Row: 5, Student: dream},
+		{Row: 6, Student: valid},
+	}
+
+	tests := []struct {
+		description string
+		dryRun      bool
+		batchTimes  int
+		batchErr    error
+		expRes      entities.ImportReport
+		expErr      error
+	}{
+		{"Success case: dry run does not store anything", true, 0, nil,
+			entities.ImportReport{DryRun: true, Total: 5, Succeeded: 1, Failed: 4, Rows: []entities.ImportRow{
+				{Row: 2, Student: valid},
+				{Row: 3, Error: "Missing Parameter: phone"},
+				{Row: 4, Student: young, Error: "Invalid Parameter: age should be greater than 22"},
+				{Row: 5, Student: dream, Error: "Invalid Parameter: invalid branch for this company category"},
+				{Row: 6, Student: valid, Error: "Invalid Parameter: same phone as row 2"},
+			}}, nil,
+		},
+		{"Error case: batch insert fails", false, 1, errors.DB{Reason: "server error"},
+			entities.ImportReport{}, errors.DB{Reason: "server error"},
+		},
+	}
+
+	mockStudent.EXPECT().FindDuplicate(scoped(), gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).AnyTimes()
+
+	for i, tc := range tests {
+		s := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig())
+
+		mockStudent.EXPECT().GetCompanyByID(scoped(), cmpID).Return(wipro, nil)
+		mockStudent.EXPECT().GetCompanyByID(scoped(), dreamID).Return(google, nil)
+		mockStudent.EXPECT().CreateBatch(scoped(), gomock.Len(1)).Return(tc.batchErr).Times(tc.batchTimes)
+
+		output, err := s.Import(scoped(), rows, tc.dryRun)
+
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestImportStoresValidRows(t *testing.T) {
+	mockStudent := initializeTest(t)
+	cmpID := uuid.New()
+	id := uuid.New()
+	valid := entities.Student{Name: "Monika Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+		Comp: entities.Company{ID: cmpID}, Status: "PENDING"}
+
+	mockStudent.EXPECT().FindDuplicate(scoped(), gomock.Any(), gomock.Any()).Return(uuid.Nil, nil).AnyTimes()
+
+	mockStudent.EXPECT().GetCompanyByID(scoped(), cmpID).Return(entities.Company{ID: cmpID, Category: "MASS"}, nil)
+	mockStudent.EXPECT().CreateBatch(scoped(), gomock.Len(1)).DoAndReturn(
+		func(_ context.Context, students []*entities.Student) error {
+			students[0].ID = id
+			return nil
+		})
+
+	output, err := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig()).Import(scoped(),
+		[]entities.ImportRow{{Row: 2, Student: valid}, {Row: 3, Error: "invalid"}}, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.Succeeded)
+	assert.Equal(t, 1, output.Failed)
+	assert.Equal(t, &id, output.Rows[0].ID)
+	assert.Nil(t, output.Rows[1].ID)
+}
+
+func TestCreateDuplicate(t *testing.T) {
+	cmpID := uuid.New()
+	existingID := uuid.New()
+	wipro := entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}
+	tests := []struct {
+		description string
+		keys        []entities.DuplicateKey
+		phoneTimes  int
+		phoneRes    uuid.UUID
+		nameDOBRes  uuid.UUID
+		nameTimes   int
+		createTimes int
+		expErr      error
+	}{
+		{"Error case: same phone", []entities.DuplicateKey{entities.PhoneKey, entities.NameDOBKey}, 1, existingID,
+			uuid.Nil, 0, 0, errors.Duplicate{ID: existingID.String(), Key: "phone"}},
+		{"Error case: same name and dob", []entities.DuplicateKey{entities.PhoneKey, entities.NameDOBKey}, 1, uuid.Nil,
+			existingID, 1, 0, errors.Duplicate{ID: existingID.String(), Key: "name and dob"}},
+		{"Success case: name and dob not configured", []entities.DuplicateKey{entities.PhoneKey}, 1, uuid.Nil,
+			uuid.Nil, 0, 1, nil},
+	}
+
+	for i, tc := range tests {
+		mockStudent := initializeTest(t)
+		cfg := DefaultConfig()
+		cfg.DuplicateKeys = tc.keys
+		input := entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+			Comp: entities.Company{ID: cmpID}, Status: "PENDING"}
+
+		mockStudent.EXPECT().GetCompanyByID(scoped(), cmpID).Return(wipro, nil)
+		mockStudent.EXPECT().FindDuplicate(scoped(), gomock.Any(), entities.PhoneKey).Return(tc.phoneRes, nil).
+			Times(tc.phoneTimes)
+		mockStudent.EXPECT().FindDuplicate(scoped(), gomock.Any(), entities.NameDOBKey).Return(tc.nameDOBRes, nil).
+			Times(tc.nameTimes)
+		mockStudent.EXPECT().Create(scoped(), gomock.Any()).Return(input, nil).Times(tc.createTimes)
+
+		_, err := New(mockStudent, seasonStore(t, entities.Season{}), nil, cfg).Create(scoped(), &input)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreateIneligible(t *testing.T) {
+	mockStudent := initializeTest(t)
+	cmpID := uuid.New()
+	google := entities.Company{ID: cmpID, Name: "Google", Category: "DREAM IT",
+		Criteria: &entities.EligibilityCriteria{MinCGPA: 8, AllowedBranches: []entities.Branch{entities.CSE}}}
+	input := entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ISE",
+		Comp: entities.Company{ID: cmpID}, Status: "PENDING",
+		Academic: &entities.AcademicProfile{CGPA: 7.2, GraduationYear: 2023}}
+
+	mockStudent.EXPECT().GetCompanyByID(scoped(), cmpID).Return(google, nil)
+
+	_, err := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig()).Create(scoped(), &input)
+
+	assert.Equal(t, errors.Ineligible{Criteria: []string{"branch ISE is not one of CSE", "cgpa 7.20 is below the minimum of 8.00"}},
+		err)
+}
+
+func TestImportDuplicateInDB(t *testing.T) {
+	mockStudent := initializeTest(t)
+	cmpID := uuid.New()
+	existingID := uuid.New()
+	valid := entities.Student{Name: "Monika Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+		Comp: entities.Company{ID: cmpID}, Status: "PENDING"}
+
+	mockStudent.EXPECT().GetCompanyByID(scoped(), cmpID).Return(entities.Company{ID: cmpID, Category: "MASS"}, nil)
+	mockStudent.EXPECT().FindDuplicate(scoped(), gomock.Any(), entities.PhoneKey).Return(existingID, nil)
+
+	output, err := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig()).Import(scoped(), []entities.ImportRow{{Row: 2, Student: valid}}, true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, output.Failed)
+	assert.Equal(t, errors.Duplicate{ID: existingID.String(), Key: "phone"}.Error(), output.Rows[0].Error)
+}
+
+func TestMerge(t *testing.T) {
+	mockStudent := initializeTest(t)
+	id := uuid.New()
+	otherID := uuid.New()
+	wipro := entities.Company{ID: uuid.New(), Name: "Wipro", Category: "MASS"}
+	google := entities.Company{ID: uuid.New(), Name: "Google", Category: "DREAM IT"}
+	pending := entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2),
+		Branch: "CSE", Comp: wipro, Status: "PENDING"}
+	accepted := entities.Student{ID: otherID, Name: "Monika Jaiswal", Phone: "+916388768119", DOB: entities.NewDate(2000, 7, 2),
+		Branch: "CSE", Comp: google, Status: "ACCEPTED"}
+	rejected := accepted
+	rejected.Comp, rejected.Status = google, "REJECTED"
+
+	merged := pending
+	merged.Comp, merged.Status = google, "ACCEPTED"
+
+	tests := []struct {
+		description string
+		otherID     uuid.UUID
+		getTimes    int
+		keep        entities.Student
+		duplicate   entities.Student
+		getErr      error
+		mergeTimes  int
+		mergeErr    error
+		expRes      entities.Student
+		expErr      error
+	}{
+		{"Success case: company link of an accepted duplicate is kept", otherID, 1, pending, accepted, nil, 1, nil, merged, nil},
+		{"Success case: company link of a rejected duplicate is dropped", otherID, 1, pending, rejected, nil, 1, nil, pending, nil},
+		{"Error case: same student", id, 0, pending, pending, nil, 0, nil, entities.Student{},
+			errors.InvalidParam{Param: "a student cannot be merged with itself"}},
+		{"Error case: duplicate not found", otherID, 1, pending, entities.Student{}, errors.EntityNotFound{Reason: "id not found"},
+			0, nil, entities.Student{}, errors.EntityNotFound{Reason: "id not found"}},
+		{"Error case: db error", otherID, 1, pending, accepted, nil, 1, errors.DB{Reason: "server error"}, entities.Student{},
+			errors.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		s := New(mockStudent, seasonStore(t, entities.Season{}), nil, DefaultConfig())
+
+		mockStudent.EXPECT().GetByID(scoped(), id).Return(tc.keep, nil).Times(tc.getTimes)
+		mockStudent.EXPECT().GetByID(scoped(), otherID).Return(tc.duplicate, tc.getErr).Times(tc.getTimes)
+		mockStudent.EXPECT().Merge(scoped(), gomock.Any(), otherID).Return(tc.mergeErr).Times(tc.mergeTimes)
+
+		output, err := s.Merge(scoped(), id, tc.otherID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
 	}
 }
 
 func TestDelete(t *testing.T) {
 	mockStudent := initializeTest(t)
 	id := uuid.New()
+	docs := []entities.Document{{ID: uuid.New(), StudentID: id, Checksum: "ab12"}}
+	notFound := errors.EntityNotFound{Reason: "id not found"}
+
 	tests := []struct {
 		description string
-		inputID     uuid.UUID
-		res         error
+		getErr      error
+		deleteTimes int
+		deleteErr   error
+		purgeTimes  int
+		expErr      error
+	}{
+		{"Success case: documents are purged", nil, 1, nil, 1, nil},
+		{"Error case: student not found", notFound, 0, nil, 0, notFound},
+		{"Error case: delete fails", nil, 1, errors.DB{Reason: "server error"}, 0, errors.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		mockDocument := service.NewMockDocumentSvc(gomock.NewController(t))
+		s := New(mockStudent, seasonStore(t, entities.Season{}), mockDocument, DefaultConfig())
+
+		mockDocument.EXPECT().Get(scoped(), id).Return(docs, tc.getErr)
+		mockStudent.EXPECT().Delete(scoped(), id).Return(tc.deleteErr).Times(tc.deleteTimes)
+		mockDocument.EXPECT().Purge(scoped(), docs).Times(tc.purgeTimes)
+
+		err := s.Delete(scoped(), id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestValidateAge(t *testing.T) {
+	cfg := Config{MinAge: 22, AgeReferenceDate: time.Date(2022, time.July, 2, 0, 0, 0, 0, time.UTC), PhoneRegion: "IN"}
+	tests := []struct {
+		description string
+		dob         entities.Date
+		expErr      error
 	}{
-		{"for valid id", id, nil},
-		{"when id is valid but id is not present in db", id, errors.DB{Reason: "id not found"}},
+		{"Success case: turns 22 on the reference date", entities.NewDate(2000, 7, 2), nil},
+		{"Error case: turns 22 the day after the reference date", entities.NewDate(2000, 7, 3),
+			errors.InvalidParam{Param: "age should be greater than 22"}},
+		{"Error case: same year but later month", entities.NewDate(2000, 12, 1),
+			errors.InvalidParam{Param: "age should be greater than 22"}},
+		{"Error case: missing dob", entities.Date{}, errors.InvalidParam{Param: "dob is required"}},
 	}
 
 	for i, tc := range tests {
-		s := New(mockStudent)
-		mockStudent.EXPECT().Delete(context.Background(), tc.inputID).Return(tc.res)
-		err := s.Delete(context.Background(), tc.inputID)
+		s := New(nil, seasonStore(t, entities.Season{}), nil, cfg)
+		st := entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: tc.dob, Branch: "ECE", Status: "PENDING"}
 
-		assert.Equal(t, tc.res, err, "Test[%d] failed\n(%s)", i, tc.description)
+		err := s.validateStudent(scoped(), &st)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
 	}
 }
+
+func TestValidateAgeBySeason(t *testing.T) {
+	cfg := Config{MinAge: 22, AgeReferenceDate: time.Date(2022, time.July, 2, 0, 0, 0, 0, time.UTC), PhoneRegion: "IN"}
+	minAge, refDate := 18, entities.NewDate(2023, time.July, 2)
+	tests := []struct {
+		description string
+		season      entities.Season
+		dob         entities.Date
+		expErr      error
+	}{
+		{"Success case: minimum age of the season", entities.Season{MinAge: &minAge}, entities.NewDate(2004, 7, 2), nil},
+		{"Error case: reference date of the season", entities.Season{MinAge: &minAge, AgeReferenceDate: &refDate},
+			entities.NewDate(2005, 7, 3), errors.InvalidParam{Param: "age should be greater than 18"}},
+		{"Success case: reference date of the season with the default age", entities.Season{AgeReferenceDate: &refDate},
+			entities.NewDate(2001, 7, 2), nil},
+		{"Error case: default age without a season rule", entities.Season{}, entities.NewDate(2001, 7, 2),
+			errors.InvalidParam{Param: "age should be greater than 22"}},
+	}
+
+	for i, tc := range tests {
+		s := New(nil, seasonStore(t, tc.season), nil, cfg)
+		st := entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: tc.dob, Branch: "ECE", Status: "PENDING"}
+
+		err := s.validateStudent(scoped(), &st)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+
+	_, err := New(nil, seasonStore(t, entities.Season{}), nil, cfg).Create(context.Background(), &entities.Student{})
+
+	assert.Equal(t, errors.MissingParam{Param: []string{"season"}}, err, "a student is only checked within a season")
+}
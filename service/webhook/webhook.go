@@ -0,0 +1,131 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+	"github.com/aditi-zs/Placement-API/webhooks"
+)
+
+type handler struct {
+	store store.WebhookStore
+	// now is replaced in tests to fix the creation time of webhooks and deliveries.
+	now func() time.Time
+	// lookup resolves the host of a webhook URL; it is replaced in tests.
+	lookup func(ctx context.Context, host string) ([]netip.Addr, error)
+}
+
+//nolint:revive // it's a factory function
+func New(s store.WebhookStore) handler {
+	return handler{store: s, now: time.Now, lookup: func(ctx context.Context, host string) ([]netip.Addr, error) {
+		return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	}}
+}
+
+func (h handler) Get(ctx context.Context) ([]entities.Webhook, error) {
+	return h.store.Get(ctx)
+}
+
+func (h handler) GetByID(ctx context.Context, id uuid.UUID) (entities.Webhook, error) {
+	return h.store.GetByID(ctx, id)
+}
+
+// Create subscribes a webhook to the event types listed with a new signing secret. The secret is
+// only returned here.
+func (h handler) Create(ctx context.Context, w *entities.Webhook) (entities.Webhook, error) {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return entities.Webhook{}, errors.InvalidParam{Param: "url should be an absolute http or https URL"}
+	}
+
+	if err = h.checkHost(ctx, u.Hostname()); err != nil {
+		return entities.Webhook{}, err
+	}
+
+	if len(w.Events) == 0 {
+		return entities.Webhook{}, errors.MissingParam{Param: []string{"events"}}
+	}
+
+	seen := make(map[entities.EventType]bool)
+	events := make([]entities.EventType, 0, len(w.Events))
+
+	for _, e := range w.Events {
+		if !entities.IsValidEventType(e) {
+			return entities.Webhook{}, errors.InvalidParam{Param: "unknown event type " + string(e)}
+		}
+
+		if !seen[e] {
+			seen[e] = true
+			events = append(events, e)
+		}
+	}
+
+	w.Events = events
+
+	w.Secret, _, err = auth.NewKey()
+	if err != nil {
+		return entities.Webhook{}, err
+	}
+
+	w.CreatedAt = h.now().UTC().Truncate(time.Second)
+
+	return h.store.Create(ctx, w)
+}
+
+// checkHost refuses webhook hosts that resolve to an address that is not public. The dispatcher
+// checks the address again when connecting, as the host may resolve differently by then.
+func (h handler) checkHost(ctx context.Context, host string) error {
+	addrs, err := h.lookup(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return errors.InvalidParam{Param: "url host " + host + " could not be resolved"}
+	}
+
+	for _, addr := range addrs {
+		if !webhooks.IsPublic(addr) {
+			return errors.InvalidParam{Param: "url should point to a public address"}
+		}
+	}
+
+	return nil
+}
+
+func (h handler) Delete(ctx context.Context, id uuid.UUID) error {
+	return h.store.Delete(ctx, id)
+}
+
+// GetDeliveries returns the delivery history of a webhook, the latest first.
+func (h handler) GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]entities.Delivery, error) {
+	if _, err := h.store.GetByID(ctx, webhookID); err != nil {
+		return []entities.Delivery{}, err
+	}
+
+	return h.store.GetDeliveries(ctx, webhookID)
+}
+
+// Redeliver queues the event of a past delivery to the webhook again, whatever the outcome of that
+// delivery. The new delivery keeps the event id so the receiver can recognize the event.
+func (h handler) Redeliver(ctx context.Context, webhookID, id uuid.UUID) (entities.Delivery, error) {
+	past, err := h.store.GetDelivery(ctx, webhookID, id)
+	if err != nil {
+		return entities.Delivery{}, err
+	}
+
+	now := h.now().UTC()
+	deliveries := []entities.Delivery{{WebhookID: past.WebhookID, EventID: past.EventID, EventType: past.EventType,
+		Payload: past.Payload, Status: entities.DeliveryPending, NextAttemptAt: now, CreatedAt: now}}
+
+	if err = h.store.Enqueue(ctx, deliveries); err != nil {
+		return entities.Delivery{}, err
+	}
+
+	return deliveries[0], nil
+}
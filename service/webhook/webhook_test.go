@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func initializeTest(t *testing.T) *store.MockWebhookStore {
+	ctrl := gomock.NewController(t)
+
+	return store.NewMockWebhookStore(ctrl)
+}
+
+// lookup resolves erp.example.com to a public and intranet.example.com to a private address, and IP
+// literals to themselves.
+func lookup(_ context.Context, host string) ([]netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr}, nil
+	}
+
+	switch host {
+	case "erp.example.com":
+		return []netip.Addr{netip.MustParseAddr("93.184.216.34")}, nil
+	case "intranet.example.com":
+		return []netip.Addr{netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.5")}, nil
+	default:
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	events := []entities.EventType{entities.StudentCreated}
+	notPublic := errors.InvalidParam{Param: "url should point to a public address"}
+
+	tests := []struct {
+		description string
+		input       entities.Webhook
+		createTimes int
+		expEvents   []entities.EventType
+		expErr      error
+	}{
+		{"Success case: duplicate event types are dropped", entities.Webhook{URL: "https://erp.example.com/hooks",
+			Events: []entities.EventType{entities.StudentCreated, entities.StudentStatusChanged, entities.StudentCreated}}, 1,
+			[]entities.EventType{entities.StudentCreated, entities.StudentStatusChanged}, nil},
+		{"Error case: relative url", entities.Webhook{URL: "/hooks", Events: []entities.EventType{entities.StudentCreated}}, 0, nil,
+			errors.InvalidParam{Param: "url should be an absolute http or https URL"}},
+		{"Error case: unsupported scheme", entities.Webhook{URL: "ftp://erp.example.com", Events: []entities.EventType{entities.StudentCreated}},
+			0, nil, errors.InvalidParam{Param: "url should be an absolute http or https URL"}},
+		{"Error case: no events", entities.Webhook{URL: "https://erp.example.com/hooks"}, 0, nil,
+			errors.MissingParam{Param: []string{"events"}}},
+		{"Error case: unknown event", entities.Webhook{URL: "https://erp.example.com/hooks", Events: []entities.EventType{"student.deleted"}},
+			0, nil, errors.InvalidParam{Param: "unknown event type student.deleted"}},
+		{"Error case: loopback address", entities.Webhook{URL: "http://127.0.0.1:8080/hooks", Events: events}, 0, nil, notPublic},
+		{"Error case: IPv6 loopback", entities.Webhook{URL: "https://[::1]/hooks", Events: events}, 0, nil, notPublic},
+		{"Error case: metadata service", entities.Webhook{URL: "http://169.254.169.254/latest/meta-data", Events: events}, 0, nil,
+			notPublic},
+		{"Error case: host resolving to a private address", entities.Webhook{URL: "https://intranet.example.com/hooks", Events: events},
+			0, nil, notPublic},
+		{"Error case: unknown host", entities.Webhook{URL: "https://nowhere.invalid/hooks", Events: events}, 0, nil,
+			errors.InvalidParam{Param: "url host nowhere.invalid could not be resolved"}},
+	}
+
+	for i, tc := range tests {
+		mockStore := initializeTest(t)
+		mockStore.EXPECT().Create(context.Background(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, w *entities.Webhook) (entities.Webhook, error) { return *w, nil }).
+			Times(tc.createTimes)
+
+		h := New(mockStore)
+		h.now = func() time.Time { return now }
+		h.lookup = lookup
+
+		output, err := h.Create(context.Background(), &tc.input)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.Equal(t, tc.expEvents, output.Events, "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Len(t, output.Secret, 64, "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Equal(t, now, output.CreatedAt, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestGetDeliveries(t *testing.T) {
+	id := uuid.New()
+	notFound := errors.EntityNotFound{Reason: "id not found: " + id.String()}
+
+	tests := []struct {
+		description string
+		getErr      error
+		listTimes   int
+		expErr      error
+	}{
+		{"Success case", nil, 1, nil},
+		{"Error case: webhook not found", notFound, 0, notFound},
+	}
+
+	for i, tc := range tests {
+		mockStore := initializeTest(t)
+		mockStore.EXPECT().GetByID(context.Background(), id).Return(entities.Webhook{ID: id}, tc.getErr)
+		mockStore.EXPECT().GetDeliveries(context.Background(), id).Return([]entities.Delivery{}, nil).Times(tc.listTimes)
+
+		_, err := New(mockStore).GetDeliveries(context.Background(), id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRedeliver(t *testing.T) {
+	now := time.Date(2023, 7, 2, 9, 0, 0, 0, time.UTC)
+	webhookID, id, eventID := uuid.New(), uuid.New(), uuid.New()
+	failed := entities.Delivery{ID: id, WebhookID: webhookID, EventID: eventID, EventType: entities.StudentCreated, Payload: "{}",
+		Status: entities.DeliveryFailed, Attempts: 5, ResponseStatus: 500, LastError: "webhook responded 500 Internal Server Error"}
+	notFound := errors.EntityNotFound{Reason: "delivery not found: " + id.String()}
+
+	tests := []struct {
+		description  string
+		getErr       error
+		enqueueTimes int
+		expRes       entities.Delivery
+		expErr       error
+	}{
+		{"Success case: a fresh delivery of the same event", nil, 1, entities.Delivery{WebhookID: webhookID, EventID: eventID,
+			EventType: entities.StudentCreated, Payload: "{}", Status: entities.DeliveryPending, NextAttemptAt: now, CreatedAt: now}, nil},
+		{"Error case: delivery not found", notFound, 0, entities.Delivery{}, notFound},
+	}
+
+	for i, tc := range tests {
+		mockStore := initializeTest(t)
+		mockStore.EXPECT().GetDelivery(context.Background(), webhookID, id).Return(failed, tc.getErr)
+		mockStore.EXPECT().Enqueue(context.Background(), []entities.Delivery{tc.expRes}).Return(nil).Times(tc.enqueueTimes)
+
+		h := New(mockStore)
+		h.now = func() time.Time { return now }
+
+		output, err := h.Redeliver(context.Background(), webhookID, id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
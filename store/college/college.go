@@ -0,0 +1,111 @@
+package college
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+// errCollegeExists is returned when a college violates the unique name on colleges.
+//
+//nolint:gochecknoglobals // sentinel error
+var errCollegeExists = errors.Conflict{Reason: "a college with this name already exists"}
+
+// store reads the colleges themselves, so unlike every other store it is not scoped to one.
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+func (s store) Get(ctx context.Context) ([]entities.College, error) {
+	rows, err := s.db.QueryContext(ctx, getQuery)
+	if err != nil {
+		return []entities.College{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var colleges []entities.College
+
+	for rows.Next() {
+		var c entities.College
+
+		if err = rows.Scan(&c.ID, &c.Name, &c.CreatedAt); err != nil {
+			return []entities.College{}, errors.DB{Reason: "scan error"}
+		}
+
+		colleges = append(colleges, c)
+	}
+
+	return colleges, nil
+}
+
+func (s store) GetByID(ctx context.Context, id uuid.UUID) (entities.College, error) {
+	var c entities.College
+
+	err := s.db.QueryRowContext(ctx, getByIDQuery, id).Scan(&c.ID, &c.Name, &c.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.College{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+		}
+
+		return entities.College{}, errors.DB{Reason: "server error"}
+	}
+
+	return c, nil
+}
+
+// GetByKeyHash returns the college whose API key hashes to hash.
+func (s store) GetByKeyHash(ctx context.Context, hash string) (entities.College, error) {
+	var c entities.College
+
+	err := s.db.QueryRowContext(ctx, getByKeyHashQuery, hash).Scan(&c.ID, &c.Name, &c.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.College{}, errors.EntityNotFound{Reason: "unknown api key"}
+		}
+
+		return entities.College{}, errors.DB{Reason: "server error"}
+	}
+
+	return c, nil
+}
+
+// Create stores the college with the hash of its API key; the key itself is never stored.
+func (s store) Create(ctx context.Context, c *entities.College, keyHash string) (entities.College, error) {
+	c.ID = uuid.New()
+
+	_, err := s.db.ExecContext(ctx, postQuery, c.ID, c.Name, c.CreatedAt, keyHash)
+	if err != nil {
+		if pkgstore.IsDuplicateEntry(err) {
+			return entities.College{}, errCollegeExists
+		}
+
+		return entities.College{}, errors.DB{Reason: "server error"}
+	}
+
+	return *c, nil
+}
+
+// SetKeyHash replaces the API key of the college with the key hashing to hash.
+func (s store) SetKeyHash(ctx context.Context, id uuid.UUID, hash string) error {
+	res, err := s.db.ExecContext(ctx, setKeyHashQuery, hash, id)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package college
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+//nolint:gochecknoglobals // column names shared by the tests
+var collegeColumns = []string{"college_id", "name", "created_at"}
+
+func TestGet(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.College
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getQuery).WillReturnRows(sqlmock.NewRows(collegeColumns).AddRow(id, "RVCE", created))
+		}, []entities.College{{ID: id, Name: "RVCE", CreatedAt: created}}, nil},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getQuery).WillReturnError(errors.New("connection refused"))
+		}, []entities.College{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Get(context.TODO())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetByID(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.College
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(id).WillReturnRows(sqlmock.NewRows(collegeColumns).AddRow(id, "RVCE", created))
+		}, entities.College{ID: id, Name: "RVCE", CreatedAt: created}, nil},
+		{"Error case: when id is not present in db", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(id).WillReturnRows(sqlmock.NewRows(collegeColumns))
+		}, entities.College{}, errors2.EntityNotFound{Reason: "id not found: " + id.String()}},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(id).WillReturnError(errors.New("connection refused"))
+		}, entities.College{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByID(context.TODO(), id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", nil, nil},
+		{"Error case: name taken", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, errCollegeExists},
+		{"Error case: insert fails", errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		c := entities.College{Name: "RVCE", CreatedAt: created}
+
+		mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "RVCE", created, "hash").
+			WillReturnResult(sqlmock.NewResult(1, 1)).WillReturnError(tc.mockErr)
+
+		output, err := New(db).Create(context.TODO(), &c, "hash")
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestGetByKeyHash(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.College
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getByKeyHashQuery).WithArgs("hash").WillReturnRows(sqlmock.NewRows(collegeColumns).AddRow(id, "RVCE", created))
+		}, entities.College{ID: id, Name: "RVCE", CreatedAt: created}, nil},
+		{"Error case: unknown key", func() {
+			mock.ExpectQuery(getByKeyHashQuery).WithArgs("hash").WillReturnRows(sqlmock.NewRows(collegeColumns))
+		}, entities.College{}, errors2.EntityNotFound{Reason: "unknown api key"}},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getByKeyHashQuery).WithArgs("hash").WillReturnError(errors.New("connection refused"))
+		}, entities.College{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByKeyHash(context.TODO(), "hash")
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestSetKeyHash(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		affected    int64
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", 1, nil, nil},
+		{"Error case: unknown college", 0, nil, errors2.EntityNotFound{Reason: "id not found: " + id.String()}},
+		{"Error case: update fails", 0, errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(setKeyHashQuery).WithArgs("hash", id).WillReturnResult(sqlmock.NewResult(0, tc.affected)).WillReturnError(tc.mockErr)
+
+		err := New(db).SetKeyHash(context.TODO(), id, "hash")
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,10 @@
+package college
+
+const (
+	selectQuery       = "SELECT college_id,name,created_at from colleges"
+	getQuery          = selectQuery + " ORDER BY name"
+	getByIDQuery      = selectQuery + " where college_id=?"
+	getByKeyHashQuery = selectQuery + " where key_hash=?"
+	postQuery         = "INSERT INTO colleges values (?,?,?,?)"
+	setKeyHashQuery   = "UPDATE colleges SET key_hash=? WHERE college_id=?"
+)
@@ -3,11 +3,13 @@ package company
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/google/uuid"
 
 	"github.com/aditi-zs/Placement-API/entities"
 	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
 )
 
 type store struct {
@@ -22,9 +24,14 @@ func New(d *sql.DB) store {
 func (c store) GetByID(ctx context.Context, id uuid.UUID) (entities.Company, error) {
 	var company entities.Company
 
-	row := c.db.QueryRowContext(ctx, getByIDQuery, id)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
+	row := c.db.QueryRowContext(ctx, getByIDQuery, id, college)
 
-	err := row.Scan(&company.ID, &company.Name, &company.Category)
+	err = row.Scan(&company.ID, &company.Name, &company.Category, &company.Criteria)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return entities.Company{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
@@ -36,7 +43,17 @@ func (c store) GetByID(ctx context.Context, id uuid.UUID) (entities.Company, err
 	return company, nil
 }
 func (c store) Get(ctx context.Context) ([]entities.Company, error) {
-	rows, err := c.db.QueryContext(ctx, getQuery)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Company{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.Company{}, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, getQuery, college, season)
 	if err != nil {
 		return []entities.Company{}, errors.DB{Reason: "no rows found"}
 	}
@@ -48,7 +65,7 @@ func (c store) Get(ctx context.Context) ([]entities.Company, error) {
 	for rows.Next() {
 		var company entities.Company
 
-		err = rows.Scan(&company.ID, &company.Name, &company.Category)
+		err = rows.Scan(&company.ID, &company.Name, &company.Category, &company.Criteria)
 		if err != nil {
 			return []entities.Company{}, errors.DB{Reason: "scan error"}
 		}
@@ -58,19 +75,59 @@ func (c store) Get(ctx context.Context) ([]entities.Company, error) {
 
 	return companies, nil
 }
+
+// Create stores the company as taking part in the season of ctx, along with an
+// entities.CompanyCreated event.
 func (c store) Create(ctx context.Context, cmp entities.Company) (entities.Company, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
 	cmp.ID = uuid.New()
 
-	_, err := c.db.ExecContext(ctx, postQuery, cmp.ID, cmp.Name, cmp.Category)
+	tx, err := c.db.BeginTx(ctx, nil)
 	if err != nil {
 		return entities.Company{}, errors.DB{Reason: "server error"}
 	}
 
+	if _, err = tx.ExecContext(ctx, postQuery, cmp.ID, cmp.Name, cmp.Category, cmp.Criteria, college); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Company{}, errors.DB{Reason: "server error"}
+	}
+
+	if _, err = tx.ExecContext(ctx, participateQuery, season, cmp.ID); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Company{}, errors.DB{Reason: "server error"}
+	}
+
+	if err = pkgstore.WriteEvents(ctx, tx, &entities.Event{Type: entities.CompanyCreated, Company: &cmp}); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Company{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entities.Company{}, errors.DB{Reason: "server error"}
+	}
+
 	return cmp, nil
 }
 
 func (c store) Update(ctx context.Context, id uuid.UUID, cmp entities.Company) (entities.Company, error) {
-	res, err := c.db.ExecContext(ctx, updateQuery, cmp.Name, cmp.Category, id)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
+	res, err := c.db.ExecContext(ctx, updateQuery, cmp.Name, cmp.Category, cmp.Criteria, id, college)
 	if err != nil {
 		return entities.Company{}, errors.DB{Reason: err.Error()}
 	}
@@ -84,8 +141,38 @@ func (c store) Update(ctx context.Context, id uuid.UUID, cmp entities.Company) (
 	return cmp, nil
 }
 
+func (c store) Patch(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	set, args, err := pkgstore.PatchSet(fields, patchColumn)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(patchQuery, set)
+
+	res, err := c.db.ExecContext(ctx, query, append(args, id, college)...)
+	if err != nil {
+		return errors.DB{Reason: err.Error()}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	return nil
+}
+
 func (c store) Delete(ctx context.Context, id uuid.UUID) error {
-	res, err := c.db.ExecContext(ctx, deleteQuery, id)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.db.ExecContext(ctx, deleteQuery, id, college)
 	if err != nil {
 		return errors.DB{Reason: err.Error()}
 	}
@@ -96,3 +183,78 @@ func (c store) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return nil
 }
+
+func (c store) GetRounds(ctx context.Context, id uuid.UUID) ([]entities.Round, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Round{}, err
+	}
+
+	rows, err := c.db.QueryContext(ctx, getRoundsQuery, id, college)
+	if err != nil {
+		return []entities.Round{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var rounds []entities.Round
+
+	for rows.Next() {
+		var r entities.Round
+
+		if err = rows.Scan(&r.Number, &r.Name); err != nil {
+			return []entities.Round{}, errors.DB{Reason: "scan error"}
+		}
+
+		rounds = append(rounds, r)
+	}
+
+	return rounds, nil
+}
+
+// SetRounds replaces the default rounds of a company in one transaction. Drives that already copied
+// the rounds keep their own.
+func (c store) SetRounds(ctx context.Context, id uuid.UUID, rounds []entities.Round) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteRoundsQuery, id, college); err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	for _, r := range rounds {
+		if _, err = tx.ExecContext(ctx, postRoundQuery, r.Number, r.Name, id, college); err != nil {
+			_ = tx.Rollback()
+
+			return errors.DB{Reason: "server error"}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+func patchColumn(field string) (string, bool) {
+	switch field {
+	case "name":
+		return "company_name", true
+	case "category":
+		return "category", true
+	case "criteria":
+		return "eligibility", true
+	default:
+		return "", false
+	}
+}
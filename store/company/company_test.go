@@ -10,10 +10,26 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/aditi-zs/Placement-API/auth"
 	"github.com/aditi-zs/Placement-API/entities"
 	errors2 "github.com/aditi-zs/Placement-API/errors"
 )
 
+// collegeID and seasonID are the college and season the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID, seasonID = uuid.New(), uuid.New()
+
+// writeEventQuery is the outbox insert of a single event written by pkgstore.WriteEvents.
+const writeEventQuery = "INSERT INTO outbox (event_id,college_id,event_type,payload,attempts,last_error,next_attempt_at,created_at) " +
+	"values (?,?,?,?,0,'',?,?)"
+
+// scoped returns a context scoped to collegeID and seasonID, as the college and season middleware set
+// it up.
+func scoped() context.Context {
+	return auth.WithSeason(auth.WithCollege(context.TODO(), collegeID), seasonID)
+}
+
 func TestGet(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
@@ -33,25 +49,25 @@ func TestGet(t *testing.T) {
 		expErr      error
 	}{
 		{"Success case: All entries are present",
-			sqlmock.NewRows([]string{"ID", "Name", "Category"}).AddRow(cmpID.String(), "Wipro", "MASS"),
+			sqlmock.NewRows([]string{"ID", "Name", "Category", "eligibility"}).AddRow(cmpID.String(), "Wipro", "MASS", nil),
 			[]entities.Company{{ID: cmpID, Name: "Wipro", Category: "MASS"}},
 			nil, nil,
 		},
 		{"Error case: server error",
-			sqlmock.NewRows([]string{"ID", "Name", "Category"}).AddRow(cmpID, "Wipro", "MASS"),
+			sqlmock.NewRows([]string{"ID", "Name", "Category", "eligibility"}).AddRow(cmpID, "Wipro", "MASS", nil),
 			[]entities.Company{}, errors.New("no rows found"), errors2.DB{Reason: "no rows found"},
 		},
 		{"Error case: scan error",
-			sqlmock.NewRows([]string{"ID", "Name", "Category"}).AddRow(nil, nil, nil),
+			sqlmock.NewRows([]string{"ID", "Name", "Category", "eligibility"}).AddRow(nil, nil, nil, nil),
 			[]entities.Company{}, nil, errors2.DB{Reason: "scan error"},
 		},
 	}
 	for i, tc := range tests {
 		store := New(db)
 
-		mock.ExpectQuery(getQuery).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
+		mock.ExpectQuery(getQuery).WithArgs(collegeID, seasonID).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
 
-		ctx := context.TODO()
+		ctx := scoped()
 		output, err := store.Get(ctx)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
@@ -68,6 +84,7 @@ func TestGetByID(t *testing.T) {
 
 	cmpID := uuid.New()
 	valID := uuid.New()
+	noBacklogs := 0
 
 	tests := []struct {
 		description string
@@ -78,25 +95,31 @@ func TestGetByID(t *testing.T) {
 		expErr      error
 	}{
 		{"Success case: for valid id", cmpID,
-			sqlmock.NewRows([]string{"ID", "Name", "category"}).AddRow(cmpID, "Wipro", "MASS"),
+			sqlmock.NewRows([]string{"ID", "Name", "category", "eligibility"}).AddRow(cmpID, "Wipro", "MASS", nil),
 			entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, nil, nil,
 		},
+		{"Success case: with eligibility criteria", cmpID,
+			sqlmock.NewRows([]string{"ID", "Name", "category", "eligibility"}).
+				AddRow(cmpID, "Google", "DREAM IT", []byte(`{"minCgpa":7.5,"maxBacklogs":0,"allowedYears":[2023]}`)),
+			entities.Company{ID: cmpID, Name: "Google", Category: "DREAM IT",
+				Criteria: &entities.EligibilityCriteria{MinCGPA: 7.5, MaxBacklogs: &noBacklogs, AllowedYears: []int{2023}}}, nil, nil,
+		},
 		{"Error case: when id is not present in db", valID,
-			sqlmock.NewRows([]string{"ID", "Name", "category"}), entities.Company{},
+			sqlmock.NewRows([]string{"ID", "Name", "category", "eligibility"}), entities.Company{},
 			nil, errors2.EntityNotFound{Reason: "id not found: " + valID.String()},
 		},
 		{"error case: server error", cmpID,
-			sqlmock.NewRows([]string{"ID", "Name", "category"}).AddRow(cmpID, "Wipro", "MASS"),
+			sqlmock.NewRows([]string{"ID", "Name", "category", "eligibility"}).AddRow(cmpID, "Wipro", "MASS", nil),
 			entities.Company{}, errors.New("server error"), errors2.DB{Reason: "server error"},
 		},
 	}
 
 	for i, tc := range tests {
-		mock.ExpectQuery(getByIDQuery).WithArgs(tc.inputID).
+		mock.ExpectQuery(getByIDQuery).WithArgs(tc.inputID, collegeID).
 			WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
 
 		store := New(db)
-		ctx := context.TODO()
+		ctx := scoped()
 		output, err := store.GetByID(ctx, tc.inputID)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
@@ -116,25 +139,53 @@ func TestCreate(t *testing.T) {
 	tests := []struct {
 		description string
 		input       entities.Company
-		res         driver.Result
+		mock        func()
 		expRes      entities.Company
 		err         error
 	}{
-		{"Success case: All entries are present", entities.Company{Name: "Wipro", Category: "MASS"}, sqlmock.NewResult(1, 1),
-			entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, nil,
+		{"Success case: All entries are present", entities.Company{Name: "Wipro", Category: "MASS"}, func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "Wipro", "MASS", nil, collegeID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(participateQuery).WithArgs(seasonID, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventQuery).WithArgs(sqlmock.AnyArg(), collegeID, entities.CompanyCreated, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, nil,
+		},
+		{"Error case :server error", entities.Company{Name: "Wipro", Category: "MASS"}, func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "Wipro", "MASS", nil, collegeID).
+				WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, entities.Company{}, errors2.DB{Reason: "server error"},
 		},
-		{"Error case :server error", entities.Company{Name: "Wipro", Category: "MASS"},
-			sqlmock.NewResult(0, 0), entities.Company{}, errors2.DB{Reason: "server error"},
+		{"Error case: participation fails", entities.Company{Name: "Wipro", Category: "MASS"}, func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "Wipro", "MASS", nil, collegeID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(participateQuery).WithArgs(seasonID, sqlmock.AnyArg()).WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, entities.Company{}, errors2.DB{Reason: "server error"},
+		},
+		{"Error case: event is not recorded", entities.Company{Name: "Wipro", Category: "MASS"}, func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "Wipro", "MASS", nil, collegeID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(participateQuery).WithArgs(seasonID, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventQuery).WithArgs(sqlmock.AnyArg(), collegeID, entities.CompanyCreated, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, entities.Company{}, errors2.DB{Reason: "server error"},
 		},
 	}
 
 	for i, tc := range tests {
 		store := New(db)
 
-		mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), tc.input.Name, tc.input.Category).
-			WillReturnResult(tc.res).WillReturnError(tc.err)
+		tc.mock()
 
-		ctx := context.TODO()
+		ctx := scoped()
 		output, err := store.Create(ctx, tc.input)
 
 		assert.Equal(t, tc.err, err, "Test[%d] failed\n(%s)", i, tc.description)
@@ -176,10 +227,10 @@ func TestUpdate(t *testing.T) {
 		c := New(db)
 
 		mock.ExpectExec(updateQuery).
-			WithArgs(tc.input.Name, tc.input.Category, tc.inputID).
+			WithArgs(tc.input.Name, tc.input.Category, tc.input.Criteria, tc.inputID, collegeID).
 			WillReturnResult(tc.res).WillReturnError(tc.mockErr)
 
-		ctx := context.TODO()
+		ctx := scoped()
 		output, err := c.Update(ctx, tc.inputID, tc.input)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
@@ -187,6 +238,60 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestPatch(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	cmpID := uuid.New()
+
+	tests := []struct {
+		description string
+		input       map[string]interface{}
+		mockTimes   int
+		query       string
+		args        []driver.Value
+		res         driver.Result
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case: only the changed column is written", map[string]interface{}{"category": entities.CORE}, 1,
+			"UPDATE companies SET category=? WHERE company_id=? AND college_id=?", []driver.Value{entities.CORE, cmpID, collegeID},
+			sqlmock.NewResult(1, 1), nil, nil,
+		},
+		{"Success case: both columns are written", map[string]interface{}{"name": "Google", "category": entities.DREAMIT}, 1,
+			"UPDATE companies SET category=?,company_name=? WHERE company_id=? AND college_id=?",
+			[]driver.Value{entities.DREAMIT, "Google", cmpID, collegeID},
+			sqlmock.NewResult(1, 1), nil, nil,
+		},
+		{"Error case: when id is valid but it doesn't exist in db", map[string]interface{}{"name": "Google"}, 1,
+			"UPDATE companies SET company_name=? WHERE company_id=? AND college_id=?", []driver.Value{"Google", cmpID, collegeID},
+			sqlmock.NewResult(0, 0), nil, errors2.EntityNotFound{Reason: "id not found: " + cmpID.String()},
+		},
+		{"Error case: server error", map[string]interface{}{"name": "Google"}, 1,
+			"UPDATE companies SET company_name=? WHERE company_id=? AND college_id=?", []driver.Value{"Google", cmpID, collegeID},
+			sqlmock.NewResult(0, 0), errors.New("server error"), errors2.DB{Reason: "server error"},
+		},
+		{"Error case: unknown field", map[string]interface{}{"id": cmpID}, 0, "", nil, nil, nil,
+			errors2.InvalidParam{Param: "id"},
+		},
+	}
+
+	for i, tc := range tests {
+		if tc.mockTimes != 0 {
+			mock.ExpectExec(tc.query).WithArgs(tc.args...).WillReturnResult(tc.res).WillReturnError(tc.mockErr)
+		}
+
+		c := New(db)
+		ctx := scoped()
+		err := c.Patch(ctx, cmpID, tc.input)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
@@ -216,12 +321,66 @@ func TestDelete(t *testing.T) {
 	}
 
 	for i, tc := range tests {
-		mock.ExpectExec(deleteQuery).WithArgs(tc.inputID).WillReturnResult(tc.res).WillReturnError(tc.mockErr)
+		mock.ExpectExec(deleteQuery).WithArgs(tc.inputID, collegeID).WillReturnResult(tc.res).WillReturnError(tc.mockErr)
 
 		c := New(db)
-		ctx := context.TODO()
+		ctx := scoped()
 		err := c.Delete(ctx, tc.inputID)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
 	}
 }
+
+func TestSetRounds(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	cmpID := uuid.New()
+	rounds := []entities.Round{{Number: 1, Name: "Aptitude"}, {Number: 2, Name: "HR"}}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: rounds replaced", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(deleteRoundsQuery).WithArgs(cmpID, collegeID).WillReturnResult(sqlmock.NewResult(0, 3))
+			mock.ExpectExec(postRoundQuery).WithArgs(1, "Aptitude", cmpID, collegeID).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(postRoundQuery).WithArgs(2, "HR", cmpID, collegeID).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: insert fails and the transaction is rolled back", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(deleteRoundsQuery).WithArgs(cmpID, collegeID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(postRoundQuery).WithArgs(1, "Aptitude", cmpID, collegeID).WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		err := New(db).SetRounds(scoped(), cmpID, rounds)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestNoCollege(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	output, err := New(db).Get(context.TODO())
+
+	assert.Equal(t, errors2.MissingParam{Param: []string{"college"}}, err)
+	assert.Equal(t, []entities.Company{}, output)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
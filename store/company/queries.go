@@ -1,9 +1,20 @@
 package company
 
 const (
-	getQuery     = "SELECT company_id,company_name,category from companies"
-	getByIDQuery = "SELECT c.company_id,c.company_name,c.category from companies c where c.company_id=?"
-	postQuery    = "INSERT INTO companies values (?,?,?)"
-	updateQuery  = "UPDATE companies SET company_name=?,category=? WHERE company_id=?"
-	deleteQuery  = "DELETE FROM companies  WHERE company_id=?"
+	getQuery = "SELECT c.company_id,c.company_name,c.category,c.eligibility from companies c " +
+		"join season_companies sc on sc.company_id=c.company_id where c.college_id=? and sc.season_id=?"
+	getByIDQuery = "SELECT c.company_id,c.company_name,c.category,c.eligibility from companies c where c.company_id=? " +
+		"and c.college_id=?"
+	postQuery = "INSERT INTO companies values (?,?,?,?,?)"
+	// participateQuery records a company as taking part in a season.
+	participateQuery = "INSERT INTO season_companies values (?,?)"
+	updateQuery      = "UPDATE companies SET company_name=?,category=?,eligibility=? WHERE company_id=? AND college_id=?"
+	patchQuery       = "UPDATE companies SET %s WHERE company_id=? AND college_id=?"
+	deleteQuery      = "DELETE FROM companies  WHERE company_id=? AND college_id=?"
+
+	getRoundsQuery = "SELECT r.round_number,r.round_name FROM company_rounds r join companies c on r.company_id=c.company_id " +
+		"WHERE r.company_id=? AND c.college_id=? ORDER BY r.round_number"
+	deleteRoundsQuery = "DELETE r FROM company_rounds r join companies c on r.company_id=c.company_id " +
+		"WHERE r.company_id=? AND c.college_id=?"
+	postRoundQuery = "INSERT INTO company_rounds SELECT company_id,?,? FROM companies WHERE company_id=? AND college_id=?"
 )
@@ -0,0 +1,210 @@
+package document
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+func (s store) Get(ctx context.Context, studentID uuid.UUID) ([]entities.Document, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Document{}, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, getQuery, studentID, college)
+	if err != nil {
+		return []entities.Document{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var docs []entities.Document
+
+	for rows.Next() {
+		doc, err := scanDocument(rows)
+		if err != nil {
+			return []entities.Document{}, errors.DB{Reason: "scan error"}
+		}
+
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+func (s store) GetByID(ctx context.Context, studentID, id uuid.UUID) (entities.Document, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Document{}, err
+	}
+
+	doc, err := scanDocument(s.db.QueryRowContext(ctx, getByIDQuery, studentID, id, college))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Document{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+		}
+
+		return entities.Document{}, errors.DB{Reason: "server error"}
+	}
+
+	return doc, nil
+}
+
+// GetByChecksum returns the document of the student with the given contents.
+func (s store) GetByChecksum(ctx context.Context, studentID uuid.UUID, checksum string) (entities.Document, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Document{}, err
+	}
+
+	doc, err := scanDocument(s.db.QueryRowContext(ctx, getByChecksumQuery, studentID, checksum, college))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Document{}, errors.EntityNotFound{Reason: "checksum not found: " + checksum}
+		}
+
+		return entities.Document{}, errors.DB{Reason: "server error"}
+	}
+
+	return doc, nil
+}
+
+// Create records doc with its contents locked, and calls put to store them before committing. A
+// failing put leaves nothing recorded.
+func (s store) Create(ctx context.Context, doc *entities.Document, put func() error) (entities.Document, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Document{}, err
+	}
+
+	doc.ID = uuid.New()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entities.Document{}, errors.DB{Reason: "server error"}
+	}
+
+	if _, err = tx.ExecContext(ctx, lockBlobQuery, doc.Checksum); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Document{}, errors.DB{Reason: "server error"}
+	}
+
+	res, err := tx.ExecContext(ctx, postQuery, doc.ID, doc.Kind, doc.FileName, doc.ContentType, doc.Size, doc.Checksum,
+		doc.UploadedAt, doc.StudentID, college)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return entities.Document{}, errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		_ = tx.Rollback()
+
+		return entities.Document{}, errors.EntityNotFound{Reason: "id not found: " + doc.StudentID.String()}
+	}
+
+	if err = put(); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Document{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entities.Document{}, errors.DB{Reason: "server error"}
+	}
+
+	return *doc, nil
+}
+
+func (s store) Delete(ctx context.Context, studentID, id uuid.UUID) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, studentID, id, college)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	return nil
+}
+
+// Release calls remove to delete the contents under checksum when no document, of any student,
+// refers to them. The contents stay locked meanwhile, so an upload of the same file waits for it.
+func (s store) Release(ctx context.Context, checksum string, remove func() error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if _, err = tx.ExecContext(ctx, lockBlobQuery, checksum); err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	var n int
+
+	if err = tx.QueryRowContext(ctx, countChecksumQuery, checksum).Scan(&n); err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n == 0 {
+		if err = remove(); err != nil {
+			_ = tx.Rollback()
+
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, deleteBlobQuery, checksum); err != nil {
+			_ = tx.Rollback()
+
+			return errors.DB{Reason: "server error"}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDocument(row scanner) (entities.Document, error) {
+	var doc entities.Document
+
+	err := row.Scan(&doc.ID, &doc.StudentID, &doc.Kind, &doc.FileName, &doc.ContentType, &doc.Size, &doc.Checksum,
+		&doc.UploadedAt)
+	if err != nil {
+		return entities.Document{}, err
+	}
+
+	return doc, nil
+}
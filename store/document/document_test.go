@@ -0,0 +1,252 @@
+package document
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID is the college the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID = uuid.New()
+
+// scoped returns a context scoped to collegeID, as the college middleware sets it up.
+func scoped() context.Context {
+	return auth.WithCollege(context.TODO(), collegeID)
+}
+
+//nolint:gochecknoglobals // column names shared by the tests
+var documentColumns = []string{"document_id", "student_id", "kind", "file_name", "content_type", "size", "checksum",
+	"uploaded_at"}
+
+const checksum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestGet(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	docID := uuid.New()
+	stuID := uuid.New()
+	uploaded := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.Document
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getQuery).WithArgs(stuID, collegeID).WillReturnRows(sqlmock.NewRows(documentColumns).
+				AddRow(docID, stuID, "RESUME", "resume.pdf", "application/pdf", 2048, checksum, uploaded))
+		}, []entities.Document{{ID: docID, StudentID: stuID, Kind: entities.Resume, FileName: "resume.pdf",
+			ContentType: "application/pdf", Size: 2048, Checksum: checksum, UploadedAt: uploaded}}, nil},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getQuery).WithArgs(stuID, collegeID).WillReturnError(errors.New("connection refused"))
+		}, []entities.Document{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Get(scoped(), stuID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetByChecksum(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	docID := uuid.New()
+	stuID := uuid.New()
+	uploaded := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.Document
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getByChecksumQuery).WithArgs(stuID, checksum, collegeID).WillReturnRows(sqlmock.NewRows(documentColumns).
+				AddRow(docID, stuID, "OTHER", "id.png", "image/png", 10, checksum, uploaded))
+		}, entities.Document{ID: docID, StudentID: stuID, Kind: entities.OtherDoc, FileName: "id.png", ContentType: "image/png",
+			Size: 10, Checksum: checksum, UploadedAt: uploaded}, nil},
+		{"Error case: no document with the checksum", func() {
+			mock.ExpectQuery(getByChecksumQuery).WithArgs(stuID, checksum, collegeID).WillReturnRows(sqlmock.NewRows(documentColumns))
+		}, entities.Document{}, errors2.EntityNotFound{Reason: "checksum not found: " + checksum}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByChecksum(scoped(), stuID, checksum)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	input := entities.Document{StudentID: uuid.New(), Kind: entities.Resume, FileName: "resume.pdf",
+		ContentType: "application/pdf", Size: 2048, Checksum: checksum, UploadedAt: time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)}
+	putErr := errors2.DB{Reason: "could not store file"}
+
+	expectInsert := func() *sqlmock.ExpectedExec {
+		mock.ExpectBegin()
+		mock.ExpectExec(lockBlobQuery).WithArgs(checksum).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		return mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "RESUME", "resume.pdf", "application/pdf", 2048, checksum,
+			input.UploadedAt, input.StudentID, collegeID)
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		putErr      error
+		putTimes    int
+		expErr      error
+	}{
+		{"Success case", func() {
+			expectInsert().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil, 1, nil},
+		{"Error case: insert fails", func() {
+			expectInsert().WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, nil, 0, errors2.DB{Reason: "server error"}},
+		{"Error case: student not in the college", func() {
+			expectInsert().WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+		}, nil, 0, errors2.EntityNotFound{Reason: "id not found: " + input.StudentID.String()}},
+		{"Error case: storing the contents fails", func() {
+			expectInsert().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectRollback()
+		}, putErr, 1, putErr},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		puts := 0
+		doc := input
+		output, err := New(db).Create(scoped(), &doc, func() error {
+			puts++
+
+			return tc.putErr
+		})
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.putTimes, puts, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	stuID := uuid.New()
+	docID := uuid.New()
+
+	tests := []struct {
+		description string
+		rows        int64
+		expErr      error
+	}{
+		{"Success case", 1, nil},
+		{"Error case: when id is not present in db", 0, errors2.EntityNotFound{Reason: "id not found: " + docID.String()}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(deleteQuery).WithArgs(stuID, docID, collegeID).WillReturnResult(sqlmock.NewResult(0, tc.rows))
+
+		err := New(db).Delete(scoped(), stuID, docID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	removeErr := errors.New("permission denied")
+
+	tests := []struct {
+		description string
+		references  int
+		removeErr   error
+		expRemoved  int
+		expErr      error
+	}{
+		{"Success case: contents removed with the last reference", 0, nil, 1, nil},
+		{"Success case: contents kept while referenced", 2, nil, 0, nil},
+		{"Error case: removing the contents fails", 0, removeErr, 1, removeErr},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectBegin()
+		mock.ExpectExec(lockBlobQuery).WithArgs(checksum).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(countChecksumQuery).WithArgs(checksum).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tc.references))
+
+		switch {
+		case tc.removeErr != nil:
+			mock.ExpectRollback()
+		case tc.references == 0:
+			mock.ExpectExec(deleteBlobQuery).WithArgs(checksum).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+		default:
+			mock.ExpectCommit()
+		}
+
+		removed := 0
+		err := New(db).Release(scoped(), checksum, func() error {
+			removed++
+
+			return tc.removeErr
+		})
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRemoved, removed, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
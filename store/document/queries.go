@@ -0,0 +1,19 @@
+package document
+
+// Documents are scoped by the college of their student. The blob queries are not: blobs are shared
+// by contents across the whole store, so every reference to one has to be counted.
+const (
+	selectQuery = "SELECT document_id,student_id,kind,file_name,content_type,size,checksum,uploaded_at from documents"
+
+	collegeStudents = " and student_id IN (SELECT student_id FROM students WHERE college_id=?)"
+
+	getQuery           = selectQuery + " where student_id=?" + collegeStudents + " ORDER BY uploaded_at"
+	getByIDQuery       = selectQuery + " where student_id=? and document_id=?" + collegeStudents
+	getByChecksumQuery = selectQuery + " where student_id=? and checksum=?" + collegeStudents
+	postQuery          = "INSERT INTO documents SELECT ?,student_id,?,?,?,?,?,? FROM students WHERE student_id=? AND college_id=?"
+	deleteQuery        = "DELETE FROM documents WHERE student_id=? and document_id=?" + collegeStudents
+	countChecksumQuery = "SELECT COUNT(*) FROM documents WHERE checksum=?"
+	// lockBlobQuery records the contents under checksum, locking their row until the transaction ends.
+	lockBlobQuery   = "INSERT INTO document_blobs VALUES (?) ON DUPLICATE KEY UPDATE checksum=checksum"
+	deleteBlobQuery = "DELETE FROM document_blobs WHERE checksum=?"
+)
@@ -0,0 +1,600 @@
+package drive
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+// errAlreadyRegistered is returned when a registration violates the primary key on drive_registrations.
+//
+//nolint:gochecknoglobals // sentinel error
+var errAlreadyRegistered = errors.Conflict{Reason: "student already registered for this drive"}
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+// Get returns every drive, or only the drives of companyID when it is not uuid.Nil.
+func (s store) Get(ctx context.Context, companyID uuid.UUID) ([]entities.Drive, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Drive{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.Drive{}, err
+	}
+
+	var rows *sql.Rows
+
+	if companyID == uuid.Nil {
+		rows, err = s.db.QueryContext(ctx, getQuery, college, season)
+	} else {
+		rows, err = s.db.QueryContext(ctx, getByCompanyQuery, college, season, companyID)
+	}
+
+	if err != nil {
+		return []entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var drives []entities.Drive
+
+	for rows.Next() {
+		drive, err := scanDrive(rows)
+		if err != nil {
+			return []entities.Drive{}, errors.DB{Reason: "scan error"}
+		}
+
+		drives = append(drives, drive)
+	}
+
+	if len(drives) == 0 {
+		return drives, nil
+	}
+
+	rounds, err := s.allRounds(ctx, college)
+	if err != nil {
+		return []entities.Drive{}, err
+	}
+
+	for i := range drives {
+		drives[i].Rounds = rounds[drives[i].ID]
+	}
+
+	return drives, nil
+}
+
+func (s store) GetByID(ctx context.Context, id uuid.UUID) (entities.Drive, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Drive{}, err
+	}
+
+	drive, err := scanDrive(s.db.QueryRowContext(ctx, getByIDQuery, college, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Drive{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+		}
+
+		return entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	rows, err := s.db.QueryContext(ctx, getRoundsQuery, id)
+	if err != nil {
+		return entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var r entities.Round
+
+		if err = rows.Scan(&r.Number, &r.Name); err != nil {
+			return entities.Drive{}, errors.DB{Reason: "scan error"}
+		}
+
+		drive.Rounds = append(drive.Rounds, r)
+	}
+
+	return drive, nil
+}
+
+// Create stores the drive, its rounds and an entities.DriveScheduled event in one transaction, in
+// the season of ctx. Nothing is stored unless the company belongs to the college.
+func (s store) Create(ctx context.Context, drive *entities.Drive) (entities.Drive, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Drive{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return entities.Drive{}, err
+	}
+
+	drive.ID = uuid.New()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	res, err := tx.ExecContext(ctx, postQuery, drive.ID, drive.Date, drive.RegistrationOpens, drive.RegistrationCloses,
+		joinBranches(drive.Branches), season, drive.Comp.ID, college)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		_ = tx.Rollback()
+
+		return entities.Drive{}, errors.EntityNotFound{Reason: "id not found: " + drive.Comp.ID.String()}
+	}
+
+	if err = insertRounds(ctx, tx, drive); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Drive{}, err
+	}
+
+	if err = pkgstore.WriteEvents(ctx, tx, &entities.Event{Type: entities.DriveScheduled, Drive: drive}); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Drive{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	return *drive, nil
+}
+
+// Update replaces the drive and its rounds in one transaction. Rounds are renamed in place so their
+// results survive; results of rounds past the new last round are dropped with them.
+func (s store) Update(ctx context.Context, id uuid.UUID, drive *entities.Drive) (entities.Drive, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Drive{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return entities.Drive{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	res, err := tx.ExecContext(ctx, updateQuery, drive.Comp.ID, drive.Date, drive.RegistrationOpens,
+		drive.RegistrationCloses, joinBranches(drive.Branches), id, college, season)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return entities.Drive{}, errors.DB{Reason: err.Error()}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		_ = tx.Rollback()
+
+		return entities.Drive{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	if _, err = tx.ExecContext(ctx, trimRoundsQuery, id, len(drive.Rounds)); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	drive.ID = id
+
+	if err = insertRounds(ctx, tx, drive); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Drive{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entities.Drive{}, errors.DB{Reason: "server error"}
+	}
+
+	return *drive, nil
+}
+
+func (s store) Delete(ctx context.Context, id uuid.UUID) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, id, college, season)
+	if err != nil {
+		return errors.DB{Reason: err.Error()}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	return nil
+}
+
+// Register stores the registration when both the drive and the student belong to the college and
+// season.
+func (s store) Register(ctx context.Context, reg *entities.Registration) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, registerQuery, reg.RegisteredAt, reg.DriveID, reg.StudentID, college, season)
+	if err != nil {
+		if pkgstore.IsDuplicateEntry(err) {
+			return errAlreadyRegistered
+		}
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "drive or student not found"}
+	}
+
+	return nil
+}
+
+func (s store) GetRegistrations(ctx context.Context, driveID uuid.UUID) ([]entities.Registration, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Registration{}, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, getRegistrationsQuery, driveID, college)
+	if err != nil {
+		return []entities.Registration{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var registrations []entities.Registration
+
+	for rows.Next() {
+		var reg entities.Registration
+
+		if err = rows.Scan(&reg.DriveID, &reg.StudentID, &reg.RegisteredAt); err != nil {
+			return []entities.Registration{}, errors.DB{Reason: "scan error"}
+		}
+
+		registrations = append(registrations, reg)
+	}
+
+	return registrations, nil
+}
+
+func (s store) IsRegistered(ctx context.Context, driveID, studentID uuid.UUID) (bool, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var n int
+
+	if err = s.db.QueryRowContext(ctx, isRegisteredQuery, driveID, studentID, college).Scan(&n); err != nil {
+		return false, errors.DB{Reason: "server error"}
+	}
+
+	return n != 0, nil
+}
+
+// GetApplicants returns the students registered for the drives of companyID, or only for driveID
+// when it is not uuid.Nil.
+func (s store) GetApplicants(ctx context.Context, companyID, driveID uuid.UUID) ([]entities.Applicant, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Applicant{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.Applicant{}, err
+	}
+
+	var rows *sql.Rows
+
+	if driveID == uuid.Nil {
+		rows, err = s.db.QueryContext(ctx, getAllApplicantsQuery, companyID, college, season)
+	} else {
+		rows, err = s.db.QueryContext(ctx, getDriveApplicantsQuery, companyID, college, season, driveID)
+	}
+
+	if err != nil {
+		return []entities.Applicant{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var applicants []entities.Applicant
+
+	for rows.Next() {
+		var (
+			a  entities.Applicant
+			st = &a.Student
+		)
+
+		err = rows.Scan(&st.ID, &st.Name, &st.Phone, &st.DOB, &st.Branch, &st.Comp.ID, &st.Comp.Name, &st.Comp.Category,
+			&st.Status, &st.Academic, &st.Email, &a.DriveID, &a.RegisteredAt)
+		if err != nil {
+			return []entities.Applicant{}, errors.DB{Reason: "scan error"}
+		}
+
+		applicants = append(applicants, a)
+	}
+
+	return applicants, nil
+}
+
+// IsApplicant reports whether studentID is registered for any drive of companyID.
+func (s store) IsApplicant(ctx context.Context, companyID, studentID uuid.UUID) (bool, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var n int
+
+	if err = s.db.QueryRowContext(ctx, isApplicantQuery, companyID, studentID, college).Scan(&n); err != nil {
+		return false, errors.DB{Reason: "server error"}
+	}
+
+	return n != 0, nil
+}
+
+// GetCompanyRounds returns the rounds a company holds by default, used for drives created without
+// rounds of their own.
+func (s store) GetCompanyRounds(ctx context.Context, companyID uuid.UUID) ([]entities.Round, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, getCompanyRoundsQuery, companyID, college)
+	if err != nil {
+		return nil, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var rounds []entities.Round
+
+	for rows.Next() {
+		var r entities.Round
+
+		if err = rows.Scan(&r.Number, &r.Name); err != nil {
+			return nil, errors.DB{Reason: "scan error"}
+		}
+
+		rounds = append(rounds, r)
+	}
+
+	return rounds, nil
+}
+
+// GetResults returns the round results of a drive, or only those of studentID when it is not uuid.Nil.
+func (s store) GetResults(ctx context.Context, driveID, studentID uuid.UUID) ([]entities.RoundResult, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.RoundResult{}, err
+	}
+
+	var rows *sql.Rows
+
+	if studentID == uuid.Nil {
+		rows, err = s.db.QueryContext(ctx, getResultsQuery, driveID, college)
+	} else {
+		rows, err = s.db.QueryContext(ctx, getStudentResultsQuery, driveID, studentID, college)
+	}
+
+	if err != nil {
+		return []entities.RoundResult{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var results []entities.RoundResult
+
+	for rows.Next() {
+		var (
+			res         entities.RoundResult
+			remarks     sql.NullString
+			interviewer sql.NullString
+		)
+
+		err = rows.Scan(&res.DriveID, &res.Round, &res.StudentID, &res.Score, &res.Passed, &remarks, &interviewer,
+			&res.RecordedAt)
+		if err != nil {
+			return []entities.RoundResult{}, errors.DB{Reason: "scan error"}
+		}
+
+		res.Remarks, res.Interviewer = remarks.String, interviewer.String
+		results = append(results, res)
+	}
+
+	return results, nil
+}
+
+// RecordResult stores a round result and, when stu is not nil, the company link and status derived
+// from it in one transaction, with an entities.StudentStatusChanged event when the status changes.
+// The student's row is locked while its previous status is read.
+func (s store) RecordResult(ctx context.Context, res *entities.RoundResult, stu *entities.Student) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	_, err = tx.ExecContext(ctx, recordResultQuery, res.Round, res.StudentID, res.Score, res.Passed, res.Remarks,
+		res.Interviewer, res.RecordedAt, res.DriveID, college, season)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	if stu != nil {
+		if err = updateStatus(ctx, tx, stu, college, season); err != nil {
+			_ = tx.Rollback()
+
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// updateStatus links stu to its company with its status on tx and records the status change.
+func updateStatus(ctx context.Context, tx *sql.Tx, stu *entities.Student, college, season uuid.UUID) error {
+	var previous entities.Status
+
+	if err := tx.QueryRowContext(ctx, lockStatusQuery, stu.ID, college, season).Scan(&previous); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.EntityNotFound{Reason: "id not found"}
+		}
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	if _, err := tx.ExecContext(ctx, updateStatusQuery, stu.Comp.ID, stu.Status, stu.ID, college, season); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if stu.Status == previous {
+		return nil
+	}
+
+	return pkgstore.WriteEvents(ctx, tx, &entities.Event{Type: entities.StudentStatusChanged, Student: stu, PreviousStatus: previous})
+}
+
+// allRounds returns the rounds of every drive of the college keyed by drive id.
+func (s store) allRounds(ctx context.Context, college uuid.UUID) (map[uuid.UUID][]entities.Round, error) {
+	rows, err := s.db.QueryContext(ctx, getAllRoundsQuery, college)
+	if err != nil {
+		return nil, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	rounds := make(map[uuid.UUID][]entities.Round)
+
+	for rows.Next() {
+		var (
+			driveID uuid.UUID
+			r       entities.Round
+		)
+
+		if err = rows.Scan(&driveID, &r.Number, &r.Name); err != nil {
+			return nil, errors.DB{Reason: "scan error"}
+		}
+
+		rounds[driveID] = append(rounds[driveID], r)
+	}
+
+	return rounds, nil
+}
+
+func insertRounds(ctx context.Context, tx *sql.Tx, drive *entities.Drive) error {
+	for _, r := range drive.Rounds {
+		if _, err := tx.ExecContext(ctx, postRoundQuery, drive.ID, r.Number, r.Name); err != nil {
+			return errors.DB{Reason: "server error"}
+		}
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanDrive(row scanner) (entities.Drive, error) {
+	var (
+		drive    entities.Drive
+		branches string
+	)
+
+	err := row.Scan(&drive.ID, &drive.Comp.ID, &drive.Comp.Name, &drive.Comp.Category, &drive.Comp.Criteria, &drive.Date,
+		&drive.RegistrationOpens, &drive.RegistrationCloses, &branches)
+	if err != nil {
+		return entities.Drive{}, err
+	}
+
+	drive.Branches = splitBranches(branches)
+
+	return drive, nil
+}
+
+// Branches are stored as a comma separated list; an empty list means every branch.
+func joinBranches(branches []entities.Branch) string {
+	s := make([]string, len(branches))
+	for i, b := range branches {
+		s[i] = string(b)
+	}
+
+	return strings.Join(s, ",")
+}
+
+func splitBranches(s string) []entities.Branch {
+	if s == "" {
+		return nil
+	}
+
+	parts := strings.Split(s, ",")
+	branches := make([]entities.Branch, len(parts))
+
+	for i, p := range parts {
+		branches[i] = entities.Branch(p)
+	}
+
+	return branches
+}
@@ -0,0 +1,501 @@
+package drive
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID and seasonID are the college and season the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID, seasonID = uuid.New(), uuid.New()
+
+// writeEventQuery is the outbox insert of a single event written by pkgstore.WriteEvents.
+const writeEventQuery = "INSERT INTO outbox (event_id,college_id,event_type,payload,attempts,last_error,next_attempt_at,created_at) " +
+	"values (?,?,?,?,0,'',?,?)"
+
+// scoped returns a context scoped to collegeID and seasonID, as the college and season middleware set
+// it up.
+func scoped() context.Context {
+	return auth.WithSeason(auth.WithCollege(context.TODO(), collegeID), seasonID)
+}
+
+//nolint:gochecknoglobals // column names shared by the tests
+var driveColumns = []string{"drive_id", "company_id", "company_name", "category", "eligibility", "drive_date",
+	"registration_opens", "registration_closes", "branches"}
+
+func TestGet(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	driveID := uuid.New()
+	cmpID := uuid.New()
+	opens := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	closes := time.Date(2023, 7, 10, 18, 0, 0, 0, time.UTC)
+	drive := entities.Drive{ID: driveID, Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"},
+		Date: entities.NewDate(2023, 7, 15), RegistrationOpens: opens, RegistrationCloses: closes,
+		Rounds:   []entities.Round{{Number: 1, Name: "Aptitude"}, {Number: 2, Name: "HR"}},
+		Branches: []entities.Branch{"CSE", "ISE"}}
+
+	tests := []struct {
+		description string
+		companyID   uuid.UUID
+		mock        func()
+		expRes      []entities.Drive
+		expErr      error
+	}{
+		{"Success case: all drives with their rounds", uuid.Nil, func() {
+			mock.ExpectQuery(getQuery).WithArgs(collegeID, seasonID).WillReturnRows(sqlmock.NewRows(driveColumns).
+				AddRow(driveID, cmpID, "Wipro", "MASS", nil, "2023-07-15", opens, closes, "CSE,ISE"))
+			mock.ExpectQuery(getAllRoundsQuery).WithArgs(collegeID).WillReturnRows(sqlmock.NewRows([]string{"drive_id", "round_number", "round_name"}).
+				AddRow(driveID, 1, "Aptitude").AddRow(driveID, 2, "HR"))
+		}, []entities.Drive{drive}, nil},
+		{"Success case: no drives for the company", cmpID, func() {
+			mock.ExpectQuery(getByCompanyQuery).WithArgs(collegeID, seasonID, cmpID).WillReturnRows(sqlmock.NewRows(driveColumns))
+		}, nil, nil},
+		{"Error case: server error", uuid.Nil, func() {
+			mock.ExpectQuery(getQuery).WithArgs(collegeID, seasonID).WillReturnError(errors.New("connection refused"))
+		}, []entities.Drive{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Get(scoped(), tc.companyID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetByID(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	driveID := uuid.New()
+	cmpID := uuid.New()
+	opens := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	closes := time.Date(2023, 7, 10, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.Drive
+		expErr      error
+	}{
+		{"Success case: drive open to every branch", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(collegeID, driveID).WillReturnRows(sqlmock.NewRows(driveColumns).
+				AddRow(driveID, cmpID, "Google", "DREAM IT", []byte(`{"minCgpa":8}`), "2023-07-15", opens, closes, ""))
+			mock.ExpectQuery(getRoundsQuery).WithArgs(driveID).
+				WillReturnRows(sqlmock.NewRows([]string{"round_number", "round_name"}).AddRow(1, "Technical"))
+		}, entities.Drive{ID: driveID, Comp: entities.Company{ID: cmpID, Name: "Google", Category: "DREAM IT",
+			Criteria: &entities.EligibilityCriteria{MinCGPA: 8}}, Date: entities.NewDate(2023, 7, 15),
+			RegistrationOpens: opens, RegistrationCloses: closes, Rounds: []entities.Round{{Number: 1, Name: "Technical"}}}, nil},
+		{"Error case: when id is not present in db", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(collegeID, driveID).WillReturnRows(sqlmock.NewRows(driveColumns))
+		}, entities.Drive{}, errors2.EntityNotFound{Reason: "id not found: " + driveID.String()}},
+		{"Error case: rounds cannot be read", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(collegeID, driveID).WillReturnRows(sqlmock.NewRows(driveColumns).
+				AddRow(driveID, cmpID, "Google", "DREAM IT", nil, "2023-07-15", opens, closes, ""))
+			mock.ExpectQuery(getRoundsQuery).WithArgs(driveID).WillReturnError(errors.New("connection refused"))
+		}, entities.Drive{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByID(scoped(), driveID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	cmpID := uuid.New()
+	opens := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	closes := time.Date(2023, 7, 10, 18, 0, 0, 0, time.UTC)
+	input := entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 15),
+		RegistrationOpens: opens, RegistrationCloses: closes, Rounds: []entities.Round{{Number: 1, Name: "Aptitude"}},
+		Branches: []entities.Branch{"CSE"}}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: drive and rounds are stored", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), input.Date, opens, closes, "CSE", seasonID, cmpID, collegeID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(postRoundQuery).WithArgs(sqlmock.AnyArg(), 1, "Aptitude").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventQuery).WithArgs(sqlmock.AnyArg(), collegeID, entities.DriveScheduled, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: company of another college", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), input.Date, opens, closes, "CSE", seasonID, cmpID, collegeID).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "id not found: " + cmpID.String()}},
+		{"Error case: round insert fails and the transaction is rolled back", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), input.Date, opens, closes, "CSE", seasonID, cmpID, collegeID).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(postRoundQuery).WithArgs(sqlmock.AnyArg(), 1, "Aptitude").WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		drive := input
+		output, err := New(db).Create(scoped(), &drive)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	driveID := uuid.New()
+	cmpID := uuid.New()
+	opens := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	closes := time.Date(2023, 7, 10, 18, 0, 0, 0, time.UTC)
+	input := entities.Drive{Comp: entities.Company{ID: cmpID}, Date: entities.NewDate(2023, 7, 15),
+		RegistrationOpens: opens, RegistrationCloses: closes, Rounds: []entities.Round{{Number: 1, Name: "HR"}}}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: rounds are renamed and trimmed", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(updateQuery).WithArgs(cmpID, input.Date, opens, closes, "", driveID, collegeID, seasonID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(trimRoundsQuery).WithArgs(driveID, 1).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(postRoundQuery).WithArgs(driveID, 1, "HR").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: when id is valid but it doesn't exist in db", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(updateQuery).WithArgs(cmpID, input.Date, opens, closes, "", driveID, collegeID, seasonID).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "id not found: " + driveID.String()}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		drive := input
+		_, err := New(db).Update(scoped(), driveID, &drive)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	driveID := uuid.New()
+
+	tests := []struct {
+		description string
+		res         driver.Result
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case: for valid id", sqlmock.NewResult(0, 1), nil, nil},
+		{"Error case: when id is valid but it doesn't exist in db", sqlmock.NewResult(0, 0), nil,
+			errors2.EntityNotFound{Reason: "id not found: " + driveID.String()}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(deleteQuery).WithArgs(driveID, collegeID, seasonID).WillReturnResult(tc.res).WillReturnError(tc.mockErr)
+
+		err := New(db).Delete(scoped(), driveID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	reg := entities.Registration{DriveID: uuid.New(), StudentID: uuid.New(), RegisteredAt: time.Date(2023, 7, 5, 10, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		description string
+		rows        int64
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case: student registered", 1, nil, nil},
+		{"Error case: student already registered", 1, &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"},
+			errors2.Conflict{Reason: "student already registered for this drive"}},
+		{"Error case: server error", 1, errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+		{"Error case: drive or student of another college or season", 0, nil, errors2.EntityNotFound{Reason: "drive or student not found"}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(registerQuery).WithArgs(reg.RegisteredAt, reg.DriveID, reg.StudentID, collegeID, seasonID).
+			WillReturnResult(sqlmock.NewResult(1, tc.rows)).WillReturnError(tc.mockErr)
+
+		err := New(db).Register(scoped(), &reg)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetRegistrations(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	driveID := uuid.New()
+	stuID := uuid.New()
+	at := time.Date(2023, 7, 5, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(getRegistrationsQuery).WithArgs(driveID, collegeID).
+		WillReturnRows(sqlmock.NewRows([]string{"drive_id", "student_id", "registered_at"}).AddRow(driveID, stuID, at))
+
+	output, err := New(db).GetRegistrations(scoped(), driveID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.Registration{{DriveID: driveID, StudentID: stuID, RegisteredAt: at}}, output)
+}
+
+func TestGetApplicants(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	cmpID := uuid.New()
+	driveID := uuid.New()
+	stuID := uuid.New()
+	at := time.Date(2023, 7, 5, 10, 0, 0, 0, time.UTC)
+	columns := []string{"student_id", "student_name", "student_phone", "dob", "branch", "company_id", "company_name",
+		"category", "status", "academic", "email", "drive_id", "registered_at"}
+	applicant := entities.Applicant{Student: entities.Student{ID: stuID, Name: "Monika Jaiswal", Phone: "6388768118",
+		DOB: entities.NewDate(2000, 7, 2), Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"},
+		Status: "PENDING"}, DriveID: driveID, RegisteredAt: at}
+
+	tests := []struct {
+		description string
+		driveID     uuid.UUID
+		mock        func()
+		expRes      []entities.Applicant
+		expErr      error
+	}{
+		{"Success case: every drive of the company", uuid.Nil, func() {
+			mock.ExpectQuery(getAllApplicantsQuery).WithArgs(cmpID, collegeID, seasonID).WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(stuID, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "PENDING", nil,
+					"", driveID, at))
+		}, []entities.Applicant{applicant}, nil},
+		{"Success case: one drive", driveID, func() {
+			mock.ExpectQuery(getDriveApplicantsQuery).WithArgs(cmpID, collegeID, seasonID, driveID).WillReturnRows(sqlmock.NewRows(columns))
+		}, nil, nil},
+		{"Error case: query fails", driveID, func() {
+			mock.ExpectQuery(getDriveApplicantsQuery).WithArgs(cmpID, collegeID, seasonID, driveID).WillReturnError(errors.New("connection refused"))
+		}, []entities.Applicant{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetApplicants(scoped(), cmpID, tc.driveID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestIsApplicant(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	cmpID := uuid.New()
+	stuID := uuid.New()
+
+	mock.ExpectQuery(isApplicantQuery).WithArgs(cmpID, stuID, collegeID).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	output, err := New(db).IsApplicant(scoped(), cmpID, stuID)
+
+	assert.NoError(t, err)
+	assert.True(t, output)
+}
+
+func TestGetResults(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	driveID := uuid.New()
+	stuID := uuid.New()
+	at := time.Date(2023, 7, 15, 11, 0, 0, 0, time.UTC)
+	score := 72.5
+	columns := []string{"drive_id", "round_number", "student_id", "score", "passed", "remarks", "interviewer", "recorded_at"}
+
+	tests := []struct {
+		description string
+		studentID   uuid.UUID
+		mock        func()
+		expRes      []entities.RoundResult
+		expErr      error
+	}{
+		{"Success case: results of one student", stuID, func() {
+			mock.ExpectQuery(getStudentResultsQuery).WithArgs(driveID, stuID, collegeID).WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(driveID, 1, stuID, score, true, "good reasoning", "R. Rao", at).
+				AddRow(driveID, 2, stuID, nil, false, nil, nil, at))
+		}, []entities.RoundResult{
+			{DriveID: driveID, Round: 1, StudentID: stuID, Score: &score, Passed: true, Remarks: "good reasoning",
+				Interviewer: "R. Rao", RecordedAt: at},
+			{DriveID: driveID, Round: 2, StudentID: stuID, RecordedAt: at},
+		}, nil},
+		{"Error case: server error", uuid.Nil, func() {
+			mock.ExpectQuery(getResultsQuery).WithArgs(driveID, collegeID).WillReturnError(errors.New("connection refused"))
+		}, []entities.RoundResult{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetResults(scoped(), driveID, tc.studentID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestRecordResult(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	res := entities.RoundResult{DriveID: uuid.New(), Round: 1, StudentID: uuid.New(), Passed: true, Interviewer: "R. Rao",
+		RecordedAt: time.Date(2023, 7, 15, 11, 0, 0, 0, time.UTC)}
+	stu := entities.Student{ID: res.StudentID, Comp: entities.Company{ID: uuid.New()}, Status: "PENDING"}
+
+	record := func() *sqlmock.ExpectedExec {
+		return mock.ExpectExec(recordResultQuery).
+			WithArgs(1, res.StudentID, nil, true, "", "R. Rao", res.RecordedAt, res.DriveID, collegeID, seasonID)
+	}
+	lock := func(status string) {
+		mock.ExpectQuery(lockStatusQuery).WithArgs(stu.ID, collegeID, seasonID).WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(status))
+	}
+	update := func() *sqlmock.ExpectedExec {
+		return mock.ExpectExec(updateStatusQuery).WithArgs(stu.Comp.ID, stu.Status, stu.ID, collegeID, seasonID)
+	}
+
+	tests := []struct {
+		description string
+		student     *entities.Student
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: result and status stored with the status change", &stu, func() {
+			mock.ExpectBegin()
+			record().WillReturnResult(sqlmock.NewResult(1, 1))
+			lock("SHORTLISTED")
+			update().WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(writeEventQuery).WithArgs(sqlmock.AnyArg(), collegeID, entities.StudentStatusChanged, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Success case: status unchanged, no event", &stu, func() {
+			mock.ExpectBegin()
+			record().WillReturnResult(sqlmock.NewResult(1, 1))
+			lock("PENDING")
+			update().WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Success case: status left alone", nil, func() {
+			mock.ExpectBegin()
+			record().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: student of another season, such as an archived one, is not placed", &stu, func() {
+			mock.ExpectBegin()
+			record().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(lockStatusQuery).WithArgs(stu.ID, collegeID, seasonID).WillReturnRows(sqlmock.NewRows([]string{"status"}))
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "id not found"}},
+		{"Error case: status update fails and the transaction is rolled back", &stu, func() {
+			mock.ExpectBegin()
+			record().WillReturnResult(sqlmock.NewResult(1, 1))
+			lock("SHORTLISTED")
+			update().WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		err := New(db).RecordResult(scoped(), &res, tc.student)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,56 @@
+package drive
+
+// Drives and the tables hanging off them have no college of their own; queries are scoped by the
+// college of the drive's company. The round queries by drive id only run once the drive itself has
+// been read or written within the college. Drives are listed for one season, and drives, their
+// registrations and results and the students placed by them are only written within that season.
+const (
+	selectQuery = "SELECT d.drive_id,c.company_id,c.company_name,c.category,c.eligibility,d.drive_date,d.registration_opens," +
+		"d.registration_closes,d.branches from drives d join companies c on d.company_id=c.company_id where c.college_id=?"
+	getQuery          = selectQuery + " and d.season_id=?"
+	getByCompanyQuery = getQuery + " and d.company_id=?"
+	getByIDQuery      = selectQuery + " and d.drive_id=?"
+	postQuery         = "INSERT INTO drives SELECT ?,company_id,?,?,?,?,? FROM companies WHERE company_id=? AND college_id=?"
+	updateQuery       = "UPDATE drives SET company_id=?,drive_date=?,registration_opens=?,registration_closes=?,branches=? " +
+		"WHERE drive_id=? AND company_id IN (SELECT company_id FROM companies WHERE college_id=?) AND season_id=?"
+	deleteQuery = "DELETE FROM drives WHERE drive_id=? AND company_id IN (SELECT company_id FROM companies WHERE college_id=?) " +
+		"AND season_id=?"
+
+	collegeDrivesQuery = "SELECT d.drive_id FROM drives d join companies c on d.company_id=c.company_id WHERE c.college_id=?"
+
+	getRoundsQuery    = "SELECT round_number,round_name FROM drive_rounds WHERE drive_id=? ORDER BY round_number"
+	getAllRoundsQuery = "SELECT drive_id,round_number,round_name FROM drive_rounds WHERE drive_id IN (" + collegeDrivesQuery + ") " +
+		"ORDER BY drive_id,round_number"
+	postRoundQuery        = "INSERT INTO drive_rounds values (?,?,?) ON DUPLICATE KEY UPDATE round_name=VALUES(round_name)"
+	trimRoundsQuery       = "DELETE FROM drive_rounds WHERE drive_id=? AND round_number>?"
+	getCompanyRoundsQuery = "SELECT r.round_number,r.round_name FROM company_rounds r join companies c on r.company_id=c.company_id " +
+		"WHERE r.company_id=? AND c.college_id=? ORDER BY r.round_number"
+
+	registerQuery = "INSERT INTO drive_registrations SELECT d.drive_id,s.student_id,? FROM drives d " +
+		"join companies c on d.company_id=c.company_id join students s on s.college_id=c.college_id " +
+		"WHERE d.drive_id=? AND s.student_id=? AND c.college_id=? AND d.season_id=? AND s.season_id=d.season_id"
+	getRegistrationsQuery = "SELECT drive_id,student_id,registered_at FROM drive_registrations WHERE drive_id=? " +
+		"AND drive_id IN (" + collegeDrivesQuery + ") ORDER BY registered_at"
+	isRegisteredQuery = "SELECT COUNT(*) FROM drive_registrations WHERE drive_id=? AND student_id=? " +
+		"AND drive_id IN (" + collegeDrivesQuery + ")"
+
+	getApplicantsQuery = "SELECT s.student_id,s.student_name,s.student_phone,s.dob,s.branch,c.company_id,c.company_name," +
+		"c.category,s.status,s.academic,s.email,r.drive_id,r.registered_at from drive_registrations r " +
+		"join drives d on r.drive_id=d.drive_id join students s on r.student_id=s.student_id " +
+		"join companies c on s.company_id=c.company_id where d.company_id=? and s.college_id=? and d.season_id=?"
+	getAllApplicantsQuery   = getApplicantsQuery + " ORDER BY r.registered_at"
+	getDriveApplicantsQuery = getApplicantsQuery + " AND r.drive_id=? ORDER BY r.registered_at"
+	isApplicantQuery        = "SELECT COUNT(*) FROM drive_registrations r join drives d on r.drive_id=d.drive_id " +
+		"join students s on r.student_id=s.student_id WHERE d.company_id=? AND r.student_id=? AND s.college_id=?"
+
+	getResultsQuery = "SELECT drive_id,round_number,student_id,score,passed,remarks,interviewer,recorded_at " +
+		"FROM round_results WHERE drive_id=? AND drive_id IN (" + collegeDrivesQuery + ") ORDER BY student_id,round_number"
+	getStudentResultsQuery = "SELECT drive_id,round_number,student_id,score,passed,remarks,interviewer,recorded_at " +
+		"FROM round_results WHERE drive_id=? AND student_id=? AND drive_id IN (" + collegeDrivesQuery + ") ORDER BY round_number"
+	recordResultQuery = "INSERT INTO round_results SELECT d.drive_id,?,?,?,?,?,?,? FROM drives d " +
+		"join companies c on d.company_id=c.company_id WHERE d.drive_id=? AND c.college_id=? AND d.season_id=? " +
+		"ON DUPLICATE KEY UPDATE score=VALUES(score),passed=VALUES(passed),remarks=VALUES(remarks)," +
+		"interviewer=VALUES(interviewer),recorded_at=VALUES(recorded_at)"
+	lockStatusQuery   = "SELECT status FROM students WHERE student_id=? AND college_id=? AND season_id=? FOR UPDATE"
+	updateStatusQuery = "UPDATE students SET company_id=?,status=? WHERE student_id=? AND college_id=? AND season_id=?"
+)
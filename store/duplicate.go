@@ -0,0 +1,28 @@
+package store
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const mysqlDuplicateEntry = 1062
+
+// IsDuplicateEntry reports whether err was caused by a row violating a unique key.
+func IsDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry
+}
+
+// IsDuplicateKey reports whether err was caused by a row violating the unique key with the given
+// name. MySQL names the key at the end of the message, prefixed with its table since 8.0.
+func IsDuplicateKey(err error, key string) bool {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlDuplicateEntry {
+		return false
+	}
+
+	return strings.HasSuffix(mysqlErr.Message, " for key '"+key+"'") || strings.HasSuffix(mysqlErr.Message, "."+key+"'")
+}
@@ -0,0 +1,34 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsDuplicateKey(t *testing.T) {
+	duplicate := func(key string) error {
+		return &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'x-6388768118' for key '" + key + "'"}
+	}
+
+	tests := []struct {
+		description string
+		err         error
+		exp         bool
+	}{
+		{"Success case: key with table name", duplicate("students.uq_students_phone"), true},
+		{"Success case: key without table name", duplicate("uq_students_phone"), true},
+		{"Success case: wrapped", fmt.Errorf("insert: %w", duplicate("students.uq_students_phone")), true},
+		{"Error case: another key", duplicate("students.PRIMARY"), false},
+		{"Error case: key sharing a suffix", duplicate("students.old_uq_students_phone"), false},
+		{"Error case: another error number", &mysql.MySQLError{Number: 1452, Message: "uq_students_phone'"}, false},
+		{"Error case: not a MySQL error", errors.New("uq_students_phone'"), false},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.exp, IsDuplicateKey(tc.err, "uq_students_phone"), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,118 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+// errKeyTaken is returned when a key violates the primary key of idempotency_keys.
+//
+//nolint:gochecknoglobals // sentinel error
+var errKeyTaken = errors.Conflict{Reason: "idempotency key already used"}
+
+type store struct {
+	db *sql.DB
+	// now is replaced in tests to decide which keys have expired.
+	now func() time.Time
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d, now: time.Now}
+}
+
+// scope returns the college and the client keys are kept for. A key belongs to the client that sent
+// it, so two clients of a college may use the same key for their own requests.
+func scope(ctx context.Context) (college uuid.UUID, principal string, err error) {
+	if college, err = pkgstore.College(ctx); err != nil {
+		return uuid.Nil, "", err
+	}
+
+	if principal, err = pkgstore.Principal(ctx); err != nil {
+		return uuid.Nil, "", err
+	}
+
+	return college, principal, nil
+}
+
+// GetByKey returns the request the client sent with key, unless it has expired.
+func (s store) GetByKey(ctx context.Context, key string) (entities.IdempotentRequest, error) {
+	college, principal, err := scope(ctx)
+	if err != nil {
+		return entities.IdempotentRequest{}, err
+	}
+
+	var req entities.IdempotentRequest
+
+	err = s.db.QueryRowContext(ctx, getByKeyQuery, college, principal, key, s.now().UTC()).
+		Scan(&req.Key, &req.RequestHash, &req.Status, &req.ContentType, &req.Body, &req.CreatedAt, &req.ExpiresAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.IdempotentRequest{}, errors.EntityNotFound{Reason: "idempotency key not found"}
+		}
+
+		return entities.IdempotentRequest{}, errors.DB{Reason: "server error"}
+	}
+
+	return req, nil
+}
+
+// Create records req as being handled. The expired keys of the college are removed first, so an
+// expired key can be used again.
+func (s store) Create(ctx context.Context, req *entities.IdempotentRequest) error {
+	college, principal, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.db.ExecContext(ctx, deleteExpiredQuery, college, s.now().UTC()); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	_, err = s.db.ExecContext(ctx, postQuery, college, principal, req.Key, req.RequestHash, req.CreatedAt, req.ExpiresAt)
+	if err != nil {
+		if pkgstore.IsDuplicateEntry(err) {
+			return errKeyTaken
+		}
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// Update records the response of req.
+func (s store) Update(ctx context.Context, req *entities.IdempotentRequest) error {
+	college, principal, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, updateQuery, req.Status, req.ContentType, req.Body, college, principal, req.Key)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// Delete frees key, so the request can be sent again.
+func (s store) Delete(ctx context.Context, key string) error {
+	college, principal, err := scope(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err = s.db.ExecContext(ctx, deleteQuery, college, principal, key); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
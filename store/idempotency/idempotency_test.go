@@ -0,0 +1,172 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID is the college the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID = uuid.New()
+
+// principal is the client of collegeID the tests send keys as.
+const principal = "recruiter:1"
+
+// scoped returns a context scoped to collegeID and authenticated as principal, as the middleware of
+// a route group sets it up.
+func scoped() context.Context {
+	return auth.WithPrincipal(auth.WithCollege(context.TODO(), collegeID), principal)
+}
+
+func TestGetByKey(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	columns := []string{"idem_key", "request_hash", "status", "content_type", "body", "created_at", "expires_at"}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.IdempotentRequest
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getByKeyQuery).WithArgs(collegeID, principal, "retry-1", now).WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("retry-1", "abc", 201, "application/json", []byte(`{"id":"1"}`), now, now.Add(time.Hour)))
+		}, entities.IdempotentRequest{Key: "retry-1", RequestHash: "abc", Status: 201, ContentType: "application/json",
+			Body: []byte(`{"id":"1"}`), CreatedAt: now, ExpiresAt: now.Add(time.Hour)}, nil},
+		{"Error case: not found or expired", func() {
+			mock.ExpectQuery(getByKeyQuery).WithArgs(collegeID, principal, "retry-1", now).WillReturnRows(sqlmock.NewRows(columns))
+		}, entities.IdempotentRequest{}, errors2.EntityNotFound{Reason: "idempotency key not found"}},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getByKeyQuery).WithArgs(collegeID, principal, "retry-1", now).WillReturnError(errors.New("connection refused"))
+		}, entities.IdempotentRequest{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := store{db: db, now: func() time.Time { return now }}.GetByKey(scoped(), "retry-1")
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	req := entities.IdempotentRequest{Key: "retry-1", RequestHash: "abc", CreatedAt: now, ExpiresAt: now.Add(time.Hour)}
+
+	tests := []struct {
+		description string
+		deleteErr   error
+		insertErr   error
+		expErr      error
+	}{
+		{"Success case", nil, nil, nil},
+		{"Error case: key taken", nil, &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, errKeyTaken},
+		{"Error case: insert fails", nil, errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+		{"Error case: removing expired keys fails", errors.New("connection refused"), nil, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		expDelete := mock.ExpectExec(deleteExpiredQuery).WithArgs(collegeID, now)
+
+		if tc.deleteErr != nil {
+			expDelete.WillReturnError(tc.deleteErr)
+		} else {
+			expDelete.WillReturnResult(sqlmock.NewResult(0, 1))
+
+			expInsert := mock.ExpectExec(postQuery).WithArgs(collegeID, principal, "retry-1", "abc", now, now.Add(time.Hour))
+			if tc.insertErr != nil {
+				expInsert.WillReturnError(tc.insertErr)
+			} else {
+				expInsert.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+		}
+
+		err := store{db: db, now: func() time.Time { return now }}.Create(scoped(), &req)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	req := entities.IdempotentRequest{Key: "retry-1", Status: 201, ContentType: "application/json", Body: []byte(`{"id":"1"}`)}
+
+	tests := []struct {
+		description string
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", nil, nil},
+		{"Error case: update fails", errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		exp := mock.ExpectExec(updateQuery).WithArgs(201, "application/json", []byte(`{"id":"1"}`), collegeID, principal, "retry-1")
+		if tc.mockErr != nil {
+			exp.WillReturnError(tc.mockErr)
+		} else {
+			exp.WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+
+		err := New(db).Update(scoped(), &req)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestWithoutScope(t *testing.T) {
+	db, _, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		description string
+		ctx         context.Context
+		expErr      error
+	}{
+		{"Error case: no college", auth.WithPrincipal(context.TODO(), principal), errors2.MissingParam{Param: []string{"college"}}},
+		{"Error case: no client", auth.WithCollege(context.TODO(), collegeID), errors2.MissingParam{Param: []string{"principal"}}},
+	}
+
+	for i, tc := range tests {
+		err = New(db).Delete(tc.ctx, "retry-1")
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,12 @@
+package idempotency
+
+const (
+	getByKeyQuery = "SELECT idem_key,request_hash,status,content_type,body,created_at,expires_at from idempotency_keys " +
+		"where college_id=? and principal=? and idem_key=? and expires_at>?"
+	// deleteExpiredQuery frees the keys of the college that have expired, so they can be used again.
+	deleteExpiredQuery = "DELETE FROM idempotency_keys WHERE college_id=? AND expires_at<=?"
+	postQuery          = "INSERT INTO idempotency_keys (college_id,principal,idem_key,request_hash,status,content_type,body," +
+		"created_at,expires_at) values (?,?,?,?,0,'','',?,?)"
+	updateQuery = "UPDATE idempotency_keys SET status=?,content_type=?,body=? WHERE college_id=? AND principal=? AND idem_key=?"
+	deleteQuery = "DELETE FROM idempotency_keys WHERE college_id=? AND principal=? AND idem_key=?"
+)
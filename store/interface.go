@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -11,16 +12,137 @@ import (
 type StudentStore interface {
 	GetWithCompany(ctx context.Context, name string, branch string) ([]entities.Student, error)
 	Get(ctx context.Context, name string, branch string) ([]entities.Student, error)
+	Stream(ctx context.Context, name string, branch string, withCompany bool, fn func(entities.Student) error) error
 	GetByID(ctx context.Context, id uuid.UUID) (entities.Student, error)
 	Create(ctx context.Context, stu *entities.Student) (entities.Student, error)
+	CreateBatch(ctx context.Context, students []*entities.Student) error
 	Update(ctx context.Context, id uuid.UUID, stu *entities.Student) (entities.Student, error)
+	Patch(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetCompanyByID(ctx context.Context, id uuid.UUID) (entities.Company, error)
+	FindDuplicate(ctx context.Context, stu *entities.Student, key entities.DuplicateKey) (uuid.UUID, error)
+	Merge(ctx context.Context, keep *entities.Student, duplicateID uuid.UUID) error
 }
 type CompanyStore interface {
 	Get(ctx context.Context) ([]entities.Company, error)
 	GetByID(ctx context.Context, id uuid.UUID) (entities.Company, error)
 	Create(ctx context.Context, cmp entities.Company) (entities.Company, error)
 	Update(ctx context.Context, id uuid.UUID, cmp entities.Company) (entities.Company, error)
+	Patch(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	GetRounds(ctx context.Context, id uuid.UUID) ([]entities.Round, error)
+	SetRounds(ctx context.Context, id uuid.UUID, rounds []entities.Round) error
+}
+
+type DriveStore interface {
+	Get(ctx context.Context, companyID uuid.UUID) ([]entities.Drive, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Drive, error)
+	Create(ctx context.Context, drive *entities.Drive) (entities.Drive, error)
+	Update(ctx context.Context, id uuid.UUID, drive *entities.Drive) (entities.Drive, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Register(ctx context.Context, reg *entities.Registration) error
+	GetRegistrations(ctx context.Context, driveID uuid.UUID) ([]entities.Registration, error)
+	IsRegistered(ctx context.Context, driveID uuid.UUID, studentID uuid.UUID) (bool, error)
+	GetCompanyRounds(ctx context.Context, companyID uuid.UUID) ([]entities.Round, error)
+	GetResults(ctx context.Context, driveID uuid.UUID, studentID uuid.UUID) ([]entities.RoundResult, error)
+	RecordResult(ctx context.Context, res *entities.RoundResult, stu *entities.Student) error
+	GetApplicants(ctx context.Context, companyID, driveID uuid.UUID) ([]entities.Applicant, error)
+	IsApplicant(ctx context.Context, companyID, studentID uuid.UUID) (bool, error)
+}
+
+type OfferStore interface {
+	Get(ctx context.Context, studentID uuid.UUID) ([]entities.Offer, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Offer, error)
+	Create(ctx context.Context, offer *entities.Offer) (entities.Offer, error)
+	Accept(ctx context.Context, offer *entities.Offer, released []uuid.UUID) error
+	Decline(ctx context.Context, offer *entities.Offer) error
+}
+
+type DocumentStore interface {
+	Get(ctx context.Context, studentID uuid.UUID) ([]entities.Document, error)
+	GetByID(ctx context.Context, studentID, id uuid.UUID) (entities.Document, error)
+	GetByChecksum(ctx context.Context, studentID uuid.UUID, checksum string) (entities.Document, error)
+	Create(ctx context.Context, doc *entities.Document, put func() error) (entities.Document, error)
+	Delete(ctx context.Context, studentID, id uuid.UUID) error
+	Release(ctx context.Context, checksum string, remove func() error) error
+}
+
+type LetterStore interface {
+	Get(ctx context.Context) ([]entities.LetterTemplate, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.LetterTemplate, error)
+	GetByCompany(ctx context.Context, companyID *uuid.UUID) (entities.LetterTemplate, error)
+	Create(ctx context.Context, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error)
+	Update(ctx context.Context, id uuid.UUID, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type ReportStore interface {
+	CountByBranchStatus(ctx context.Context) ([]entities.BranchStatusCount, error)
+	CountByCategoryStatus(ctx context.Context) ([]entities.CategoryStatusCount, error)
+	TopRecruiters(ctx context.Context, limit int) ([]entities.RecruiterCount, error)
+	AcceptedCTCs(ctx context.Context) ([]entities.BranchCTC, error)
+}
+
+type RecruiterStore interface {
+	GetByCompany(ctx context.Context, companyID uuid.UUID) ([]entities.Recruiter, error)
+	GetByKeyHash(ctx context.Context, hash string) (entities.Recruiter, error)
+	Create(ctx context.Context, r *entities.Recruiter, keyHash string) (entities.Recruiter, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type CollegeStore interface {
+	Get(ctx context.Context) ([]entities.College, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.College, error)
+	GetByKeyHash(ctx context.Context, hash string) (entities.College, error)
+	Create(ctx context.Context, c *entities.College, keyHash string) (entities.College, error)
+	SetKeyHash(ctx context.Context, id uuid.UUID, hash string) error
+}
+
+type SeasonStore interface {
+	Get(ctx context.Context) ([]entities.Season, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Season, error)
+	GetCurrent(ctx context.Context) (entities.Season, error)
+	Create(ctx context.Context, season *entities.Season) (entities.Season, error)
+	Rollover(ctx context.Context, from uuid.UUID, next *entities.Season) (entities.Season, error)
+}
+
+// NotificationStore is the queue of notifications waiting for delivery. Enqueue is scoped to the
+// college of the context; Claim and Update serve the dispatchers and work across colleges.
+type NotificationStore interface {
+	Enqueue(ctx context.Context, notifications []entities.Notification) error
+	Claim(ctx context.Context, now, until time.Time, limit int) ([]entities.Notification, error)
+	Update(ctx context.Context, n *entities.Notification) error
+}
+
+// WebhookStore keeps the webhook subscriptions of a college and their delivery history. Claim and
+// UpdateDelivery serve the dispatchers and work across colleges.
+type WebhookStore interface {
+	Get(ctx context.Context) ([]entities.Webhook, error)
+	GetByID(ctx context.Context, id uuid.UUID) (entities.Webhook, error)
+	GetByEvent(ctx context.Context, t entities.EventType) ([]entities.Webhook, error)
+	Create(ctx context.Context, w *entities.Webhook) (entities.Webhook, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]entities.Delivery, error)
+	GetDelivery(ctx context.Context, webhookID, id uuid.UUID) (entities.Delivery, error)
+	Enqueue(ctx context.Context, deliveries []entities.Delivery) error
+	Claim(ctx context.Context, now, until time.Time, limit int) ([]entities.Delivery, error)
+	UpdateDelivery(ctx context.Context, d *entities.Delivery) error
+}
+
+// OutboxStore serves the relay publishing the events the stores write to the outbox along with
+// their changes. It works across colleges.
+type OutboxStore interface {
+	Pending(ctx context.Context, now time.Time, limit int) ([]entities.OutboxEntry, error)
+	MarkDelivered(ctx context.Context, eventID uuid.UUID, sink string, at time.Time) error
+	Update(ctx context.Context, entry *entities.OutboxEntry) error
+}
+
+// IdempotencyStore keeps the requests a client of a college sent with an idempotency key until they
+// expire.
+// Create returns errors.Conflict when the key is taken by a request that has not expired.
+type IdempotencyStore interface {
+	GetByKey(ctx context.Context, key string) (entities.IdempotentRequest, error)
+	Create(ctx context.Context, req *entities.IdempotentRequest) error
+	Update(ctx context.Context, req *entities.IdempotentRequest) error
+	Delete(ctx context.Context, key string) error
 }
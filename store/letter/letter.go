@@ -0,0 +1,176 @@
+package letter
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+// errTemplateExists is returned when a template violates the one template per company key on
+// letter_templates.
+//
+//nolint:gochecknoglobals // sentinel error
+var errTemplateExists = errors.Conflict{Reason: "a letter template already exists for this company"}
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+func (s store) Get(ctx context.Context) ([]entities.LetterTemplate, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.LetterTemplate{}, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, getQuery, college)
+	if err != nil {
+		return []entities.LetterTemplate{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var templates []entities.LetterTemplate
+
+	for rows.Next() {
+		tmpl, err := scanTemplate(rows)
+		if err != nil {
+			return []entities.LetterTemplate{}, errors.DB{Reason: "scan error"}
+		}
+
+		templates = append(templates, tmpl)
+	}
+
+	return templates, nil
+}
+
+func (s store) GetByID(ctx context.Context, id uuid.UUID) (entities.LetterTemplate, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.LetterTemplate{}, err
+	}
+
+	tmpl, err := scanTemplate(s.db.QueryRowContext(ctx, getByIDQuery, college, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.LetterTemplate{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+		}
+
+		return entities.LetterTemplate{}, errors.DB{Reason: "server error"}
+	}
+
+	return tmpl, nil
+}
+
+// GetByCompany returns the template of the company, or the default template when companyID is nil.
+func (s store) GetByCompany(ctx context.Context, companyID *uuid.UUID) (entities.LetterTemplate, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.LetterTemplate{}, err
+	}
+
+	var row *sql.Row
+
+	if companyID == nil {
+		row = s.db.QueryRowContext(ctx, getDefaultQuery, college)
+	} else {
+		row = s.db.QueryRowContext(ctx, getByCompanyQuery, college, *companyID)
+	}
+
+	tmpl, err := scanTemplate(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.LetterTemplate{}, errors.EntityNotFound{Reason: "letter template not found"}
+		}
+
+		return entities.LetterTemplate{}, errors.DB{Reason: "server error"}
+	}
+
+	return tmpl, nil
+}
+
+func (s store) Create(ctx context.Context, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.LetterTemplate{}, err
+	}
+
+	tmpl.ID = uuid.New()
+
+	_, err = s.db.ExecContext(ctx, postQuery, tmpl.ID, tmpl.Name, tmpl.CompanyID, tmpl.Title, tmpl.Body, tmpl.UpdatedAt, college)
+	if err != nil {
+		if pkgstore.IsDuplicateEntry(err) {
+			return entities.LetterTemplate{}, errTemplateExists
+		}
+
+		return entities.LetterTemplate{}, errors.DB{Reason: "server error"}
+	}
+
+	return *tmpl, nil
+}
+
+func (s store) Update(ctx context.Context, id uuid.UUID, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.LetterTemplate{}, err
+	}
+
+	res, err := s.db.ExecContext(ctx, updateQuery, tmpl.Name, tmpl.CompanyID, tmpl.Title, tmpl.Body, tmpl.UpdatedAt, id, college)
+	if err != nil {
+		if pkgstore.IsDuplicateEntry(err) {
+			return entities.LetterTemplate{}, errTemplateExists
+		}
+
+		return entities.LetterTemplate{}, errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.LetterTemplate{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	tmpl.ID = id
+
+	return *tmpl, nil
+}
+
+func (s store) Delete(ctx context.Context, id uuid.UUID) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, id, college)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanTemplate(row scanner) (entities.LetterTemplate, error) {
+	var tmpl entities.LetterTemplate
+
+	err := row.Scan(&tmpl.ID, &tmpl.Name, &tmpl.CompanyID, &tmpl.Title, &tmpl.Body, &tmpl.UpdatedAt)
+	if err != nil {
+		return entities.LetterTemplate{}, err
+	}
+
+	return tmpl, nil
+}
@@ -0,0 +1,227 @@
+package letter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID is the college the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID = uuid.New()
+
+// scoped returns a context scoped to collegeID, as the college middleware sets it up.
+func scoped() context.Context {
+	return auth.WithCollege(context.TODO(), collegeID)
+}
+
+//nolint:gochecknoglobals // column names shared by the tests
+var templateColumns = []string{"template_id", "name", "company_id", "title", "body", "updated_at"}
+
+func TestGet(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	defaultID := uuid.New()
+	googleID := uuid.New()
+	cmpID := uuid.New()
+	updated := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.LetterTemplate
+		expErr      error
+	}{
+		{"Success case: default and company templates", func() {
+			mock.ExpectQuery(getQuery).WithArgs(collegeID).WillReturnRows(sqlmock.NewRows(templateColumns).
+				AddRow(defaultID, "default", nil, "Offer", "Dear {{.StudentName}}", updated).
+				AddRow(googleID, "google", cmpID, "", "Hi {{.StudentName}}", updated))
+		}, []entities.LetterTemplate{
+			{ID: defaultID, Name: "default", Title: "Offer", Body: "Dear {{.StudentName}}", UpdatedAt: updated},
+			{ID: googleID, Name: "google", CompanyID: &cmpID, Body: "Hi {{.StudentName}}", UpdatedAt: updated}}, nil},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getQuery).WithArgs(collegeID).WillReturnError(errors.New("connection refused"))
+		}, []entities.LetterTemplate{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Get(scoped())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetByCompany(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	tmplID := uuid.New()
+	cmpID := uuid.New()
+	updated := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		companyID   *uuid.UUID
+		mock        func()
+		expRes      entities.LetterTemplate
+		expErr      error
+	}{
+		{"Success case: company template", &cmpID, func() {
+			mock.ExpectQuery(getByCompanyQuery).WithArgs(collegeID, cmpID).WillReturnRows(sqlmock.NewRows(templateColumns).
+				AddRow(tmplID, "google", cmpID, "", "Hi", updated))
+		}, entities.LetterTemplate{ID: tmplID, Name: "google", CompanyID: &cmpID, Body: "Hi", UpdatedAt: updated}, nil},
+		{"Success case: default template", nil, func() {
+			mock.ExpectQuery(getDefaultQuery).WithArgs(collegeID).WillReturnRows(sqlmock.NewRows(templateColumns).
+				AddRow(tmplID, "default", nil, "", "Dear", updated))
+		}, entities.LetterTemplate{ID: tmplID, Name: "default", Body: "Dear", UpdatedAt: updated}, nil},
+		{"Error case: company has no template", &cmpID, func() {
+			mock.ExpectQuery(getByCompanyQuery).WithArgs(collegeID, cmpID).WillReturnRows(sqlmock.NewRows(templateColumns))
+		}, entities.LetterTemplate{}, errors2.EntityNotFound{Reason: "letter template not found"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByCompany(scoped(), tc.companyID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	input := entities.LetterTemplate{Name: "default", Title: "Offer", Body: "Dear {{.StudentName}}",
+		UpdatedAt: time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "default", nil, "Offer", "Dear {{.StudentName}}",
+				input.UpdatedAt, collegeID).WillReturnResult(sqlmock.NewResult(1, 1))
+		}, nil},
+		{"Error case: a default template exists", func() {
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "default", nil, "Offer", "Dear {{.StudentName}}",
+				input.UpdatedAt, collegeID).WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+		}, errTemplateExists},
+		{"Error case: insert fails", func() {
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "default", nil, "Offer", "Dear {{.StudentName}}",
+				input.UpdatedAt, collegeID).WillReturnError(errors.New("server error"))
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		tmpl := input
+		output, err := New(db).Create(scoped(), &tmpl)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	cmpID := uuid.New()
+	input := entities.LetterTemplate{Name: "google", CompanyID: &cmpID, Body: "Hi",
+		UpdatedAt: time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectExec(updateQuery).WithArgs("google", cmpID, "", "Hi", input.UpdatedAt, id, collegeID).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}, nil},
+		{"Error case: when id is not present in db", func() {
+			mock.ExpectExec(updateQuery).WithArgs("google", cmpID, "", "Hi", input.UpdatedAt, id, collegeID).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+		}, errors2.EntityNotFound{Reason: "id not found: " + id.String()}},
+		{"Error case: company already has a template", func() {
+			mock.ExpectExec(updateQuery).WithArgs("google", cmpID, "", "Hi", input.UpdatedAt, id, collegeID).
+				WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+		}, errTemplateExists},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		tmpl := input
+		_, err := New(db).Update(scoped(), id, &tmpl)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		rows        int64
+		expErr      error
+	}{
+		{"Success case", 1, nil},
+		{"Error case: when id is not present in db", 0, errors2.EntityNotFound{Reason: "id not found: " + id.String()}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(deleteQuery).WithArgs(id, collegeID).WillReturnResult(sqlmock.NewResult(0, tc.rows))
+
+		err := New(db).Delete(scoped(), id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,13 @@
+package letter
+
+const (
+	selectQuery       = "SELECT template_id,name,company_id,title,body,updated_at from letter_templates where college_id=?"
+	getQuery          = selectQuery + " ORDER BY name"
+	getByIDQuery      = selectQuery + " and template_id=?"
+	getByCompanyQuery = selectQuery + " and company_id=?"
+	getDefaultQuery   = selectQuery + " and company_id IS NULL"
+	postQuery         = "INSERT INTO letter_templates (template_id,name,company_id,title,body,updated_at,college_id) " +
+		"values (?,?,?,?,?,?,?)"
+	updateQuery = "UPDATE letter_templates SET name=?,company_id=?,title=?,body=?,updated_at=? WHERE template_id=? AND college_id=?"
+	deleteQuery = "DELETE FROM letter_templates WHERE template_id=? AND college_id=?"
+)
@@ -7,6 +7,7 @@ package store
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entities "github.com/aditi-zs/Placement-API/entities"
 	gomock "github.com/golang/mock/gomock"
@@ -51,6 +52,20 @@ func (mr *MockStudentStoreMockRecorder) Create(ctx, stu interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStudentStore)(nil).Create), ctx, stu)
 }
 
+// CreateBatch mocks base method.
+func (m *MockStudentStore) CreateBatch(ctx context.Context, students []*entities.Student) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, students)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockStudentStoreMockRecorder) CreateBatch(ctx, students interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockStudentStore)(nil).CreateBatch), ctx, students)
+}
+
 // Delete mocks base method.
 func (m *MockStudentStore) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -65,6 +80,21 @@ func (mr *MockStudentStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStudentStore)(nil).Delete), ctx, id)
 }
 
+// FindDuplicate mocks base method.
+func (m *MockStudentStore) FindDuplicate(ctx context.Context, stu *entities.Student, key entities.DuplicateKey) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDuplicate", ctx, stu, key)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDuplicate indicates an expected call of FindDuplicate.
+func (mr *MockStudentStoreMockRecorder) FindDuplicate(ctx, stu, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDuplicate", reflect.TypeOf((*MockStudentStore)(nil).FindDuplicate), ctx, stu, key)
+}
+
 // Get mocks base method.
 func (m *MockStudentStore) Get(ctx context.Context, name, branch string) ([]entities.Student, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +155,48 @@ func (mr *MockStudentStoreMockRecorder) GetWithCompany(ctx, name, branch interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithCompany", reflect.TypeOf((*MockStudentStore)(nil).GetWithCompany), ctx, name, branch)
 }
 
+// Merge mocks base method.
+func (m *MockStudentStore) Merge(ctx context.Context, keep *entities.Student, duplicateID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, keep, duplicateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockStudentStoreMockRecorder) Merge(ctx, keep, duplicateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockStudentStore)(nil).Merge), ctx, keep, duplicateID)
+}
+
+// Patch mocks base method.
+func (m *MockStudentStore) Patch(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockStudentStoreMockRecorder) Patch(ctx, id, fields interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockStudentStore)(nil).Patch), ctx, id, fields)
+}
+
+// Stream mocks base method.
+func (m *MockStudentStore) Stream(ctx context.Context, name, branch string, withCompany bool, fn func(entities.Student) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stream", ctx, name, branch, withCompany, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockStudentStoreMockRecorder) Stream(ctx, name, branch, withCompany, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockStudentStore)(nil).Stream), ctx, name, branch, withCompany, fn)
+}
+
 // Update mocks base method.
 func (m *MockStudentStore) Update(ctx context.Context, id uuid.UUID, stu *entities.Student) (entities.Student, error) {
 	m.ctrl.T.Helper()
@@ -222,6 +294,49 @@ func (mr *MockCompanyStoreMockRecorder) GetByID(ctx, id interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCompanyStore)(nil).GetByID), ctx, id)
 }
 
+// GetRounds mocks base method.
+func (m *MockCompanyStore) GetRounds(ctx context.Context, id uuid.UUID) ([]entities.Round, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRounds", ctx, id)
+	ret0, _ := ret[0].([]entities.Round)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRounds indicates an expected call of GetRounds.
+func (mr *MockCompanyStoreMockRecorder) GetRounds(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRounds", reflect.TypeOf((*MockCompanyStore)(nil).GetRounds), ctx, id)
+}
+
+// Patch mocks base method.
+func (m *MockCompanyStore) Patch(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, id, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockCompanyStoreMockRecorder) Patch(ctx, id, fields interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockCompanyStore)(nil).Patch), ctx, id, fields)
+}
+
+// SetRounds mocks base method.
+func (m *MockCompanyStore) SetRounds(ctx context.Context, id uuid.UUID, rounds []entities.Round) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRounds", ctx, id, rounds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRounds indicates an expected call of SetRounds.
+func (mr *MockCompanyStoreMockRecorder) SetRounds(ctx, id, rounds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRounds", reflect.TypeOf((*MockCompanyStore)(nil).SetRounds), ctx, id, rounds)
+}
+
 // Update mocks base method.
 func (m *MockCompanyStore) Update(ctx context.Context, id uuid.UUID, cmp entities.Company) (entities.Company, error) {
 	m.ctrl.T.Helper()
@@ -236,3 +351,1279 @@ func (mr *MockCompanyStoreMockRecorder) Update(ctx, id, cmp interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCompanyStore)(nil).Update), ctx, id, cmp)
 }
+
+// MockDriveStore is a mock of DriveStore interface.
+type MockDriveStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDriveStoreMockRecorder
+}
+
+// MockDriveStoreMockRecorder is the mock recorder for MockDriveStore.
+type MockDriveStoreMockRecorder struct {
+	mock *MockDriveStore
+}
+
+// NewMockDriveStore creates a new mock instance.
+func NewMockDriveStore(ctrl *gomock.Controller) *MockDriveStore {
+	mock := &MockDriveStore{ctrl: ctrl}
+	mock.recorder = &MockDriveStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDriveStore) EXPECT() *MockDriveStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDriveStore) Create(ctx context.Context, drive *entities.Drive) (entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, drive)
+	ret0, _ := ret[0].(entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDriveStoreMockRecorder) Create(ctx, drive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDriveStore)(nil).Create), ctx, drive)
+}
+
+// Delete mocks base method.
+func (m *MockDriveStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDriveStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDriveStore)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockDriveStore) Get(ctx context.Context, companyID uuid.UUID) ([]entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, companyID)
+	ret0, _ := ret[0].([]entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDriveStoreMockRecorder) Get(ctx, companyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDriveStore)(nil).Get), ctx, companyID)
+}
+
+// GetApplicants mocks base method.
+func (m *MockDriveStore) GetApplicants(ctx context.Context, companyID, driveID uuid.UUID) ([]entities.Applicant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicants", ctx, companyID, driveID)
+	ret0, _ := ret[0].([]entities.Applicant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicants indicates an expected call of GetApplicants.
+func (mr *MockDriveStoreMockRecorder) GetApplicants(ctx, companyID, driveID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicants", reflect.TypeOf((*MockDriveStore)(nil).GetApplicants), ctx, companyID, driveID)
+}
+
+// GetByID mocks base method.
+func (m *MockDriveStore) GetByID(ctx context.Context, id uuid.UUID) (entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDriveStoreMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDriveStore)(nil).GetByID), ctx, id)
+}
+
+// GetCompanyRounds mocks base method.
+func (m *MockDriveStore) GetCompanyRounds(ctx context.Context, companyID uuid.UUID) ([]entities.Round, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyRounds", ctx, companyID)
+	ret0, _ := ret[0].([]entities.Round)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyRounds indicates an expected call of GetCompanyRounds.
+func (mr *MockDriveStoreMockRecorder) GetCompanyRounds(ctx, companyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyRounds", reflect.TypeOf((*MockDriveStore)(nil).GetCompanyRounds), ctx, companyID)
+}
+
+// GetRegistrations mocks base method.
+func (m *MockDriveStore) GetRegistrations(ctx context.Context, driveID uuid.UUID) ([]entities.Registration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistrations", ctx, driveID)
+	ret0, _ := ret[0].([]entities.Registration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistrations indicates an expected call of GetRegistrations.
+func (mr *MockDriveStoreMockRecorder) GetRegistrations(ctx, driveID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistrations", reflect.TypeOf((*MockDriveStore)(nil).GetRegistrations), ctx, driveID)
+}
+
+// GetResults mocks base method.
+func (m *MockDriveStore) GetResults(ctx context.Context, driveID, studentID uuid.UUID) ([]entities.RoundResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResults", ctx, driveID, studentID)
+	ret0, _ := ret[0].([]entities.RoundResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResults indicates an expected call of GetResults.
+func (mr *MockDriveStoreMockRecorder) GetResults(ctx, driveID, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResults", reflect.TypeOf((*MockDriveStore)(nil).GetResults), ctx, driveID, studentID)
+}
+
+// IsApplicant mocks base method.
+func (m *MockDriveStore) IsApplicant(ctx context.Context, companyID, studentID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsApplicant", ctx, companyID, studentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsApplicant indicates an expected call of IsApplicant.
+func (mr *MockDriveStoreMockRecorder) IsApplicant(ctx, companyID, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsApplicant", reflect.TypeOf((*MockDriveStore)(nil).IsApplicant), ctx, companyID, studentID)
+}
+
+// IsRegistered mocks base method.
+func (m *MockDriveStore) IsRegistered(ctx context.Context, driveID, studentID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRegistered", ctx, driveID, studentID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRegistered indicates an expected call of IsRegistered.
+func (mr *MockDriveStoreMockRecorder) IsRegistered(ctx, driveID, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRegistered", reflect.TypeOf((*MockDriveStore)(nil).IsRegistered), ctx, driveID, studentID)
+}
+
+// RecordResult mocks base method.
+func (m *MockDriveStore) RecordResult(ctx context.Context, res *entities.RoundResult, stu *entities.Student) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordResult", ctx, res, stu)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordResult indicates an expected call of RecordResult.
+func (mr *MockDriveStoreMockRecorder) RecordResult(ctx, res, stu interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordResult", reflect.TypeOf((*MockDriveStore)(nil).RecordResult), ctx, res, stu)
+}
+
+// Register mocks base method.
+func (m *MockDriveStore) Register(ctx context.Context, reg *entities.Registration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, reg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockDriveStoreMockRecorder) Register(ctx, reg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockDriveStore)(nil).Register), ctx, reg)
+}
+
+// Update mocks base method.
+func (m *MockDriveStore) Update(ctx context.Context, id uuid.UUID, drive *entities.Drive) (entities.Drive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, drive)
+	ret0, _ := ret[0].(entities.Drive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDriveStoreMockRecorder) Update(ctx, id, drive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDriveStore)(nil).Update), ctx, id, drive)
+}
+
+// MockOfferStore is a mock of OfferStore interface.
+type MockOfferStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockOfferStoreMockRecorder
+}
+
+// MockOfferStoreMockRecorder is the mock recorder for MockOfferStore.
+type MockOfferStoreMockRecorder struct {
+	mock *MockOfferStore
+}
+
+// NewMockOfferStore creates a new mock instance.
+func NewMockOfferStore(ctrl *gomock.Controller) *MockOfferStore {
+	mock := &MockOfferStore{ctrl: ctrl}
+	mock.recorder = &MockOfferStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOfferStore) EXPECT() *MockOfferStoreMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockOfferStore) Accept(ctx context.Context, offer *entities.Offer, released []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, offer, released)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockOfferStoreMockRecorder) Accept(ctx, offer, released interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockOfferStore)(nil).Accept), ctx, offer, released)
+}
+
+// Create mocks base method.
+func (m *MockOfferStore) Create(ctx context.Context, offer *entities.Offer) (entities.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, offer)
+	ret0, _ := ret[0].(entities.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOfferStoreMockRecorder) Create(ctx, offer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOfferStore)(nil).Create), ctx, offer)
+}
+
+// Decline mocks base method.
+func (m *MockOfferStore) Decline(ctx context.Context, offer *entities.Offer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decline", ctx, offer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decline indicates an expected call of Decline.
+func (mr *MockOfferStoreMockRecorder) Decline(ctx, offer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decline", reflect.TypeOf((*MockOfferStore)(nil).Decline), ctx, offer)
+}
+
+// Get mocks base method.
+func (m *MockOfferStore) Get(ctx context.Context, studentID uuid.UUID) ([]entities.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, studentID)
+	ret0, _ := ret[0].([]entities.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockOfferStoreMockRecorder) Get(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOfferStore)(nil).Get), ctx, studentID)
+}
+
+// GetByID mocks base method.
+func (m *MockOfferStore) GetByID(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.Offer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOfferStoreMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOfferStore)(nil).GetByID), ctx, id)
+}
+
+// MockDocumentStore is a mock of DocumentStore interface.
+type MockDocumentStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentStoreMockRecorder
+}
+
+// MockDocumentStoreMockRecorder is the mock recorder for MockDocumentStore.
+type MockDocumentStoreMockRecorder struct {
+	mock *MockDocumentStore
+}
+
+// NewMockDocumentStore creates a new mock instance.
+func NewMockDocumentStore(ctrl *gomock.Controller) *MockDocumentStore {
+	mock := &MockDocumentStore{ctrl: ctrl}
+	mock.recorder = &MockDocumentStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDocumentStore) EXPECT() *MockDocumentStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDocumentStore) Create(ctx context.Context, doc *entities.Document, put func() error) (entities.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, doc, put)
+	ret0, _ := ret[0].(entities.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDocumentStoreMockRecorder) Create(ctx, doc, put interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDocumentStore)(nil).Create), ctx, doc, put)
+}
+
+// Delete mocks base method.
+func (m *MockDocumentStore) Delete(ctx context.Context, studentID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, studentID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDocumentStoreMockRecorder) Delete(ctx, studentID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDocumentStore)(nil).Delete), ctx, studentID, id)
+}
+
+// Get mocks base method.
+func (m *MockDocumentStore) Get(ctx context.Context, studentID uuid.UUID) ([]entities.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, studentID)
+	ret0, _ := ret[0].([]entities.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDocumentStoreMockRecorder) Get(ctx, studentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDocumentStore)(nil).Get), ctx, studentID)
+}
+
+// GetByChecksum mocks base method.
+func (m *MockDocumentStore) GetByChecksum(ctx context.Context, studentID uuid.UUID, checksum string) (entities.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByChecksum", ctx, studentID, checksum)
+	ret0, _ := ret[0].(entities.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByChecksum indicates an expected call of GetByChecksum.
+func (mr *MockDocumentStoreMockRecorder) GetByChecksum(ctx, studentID, checksum interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByChecksum", reflect.TypeOf((*MockDocumentStore)(nil).GetByChecksum), ctx, studentID, checksum)
+}
+
+// GetByID mocks base method.
+func (m *MockDocumentStore) GetByID(ctx context.Context, studentID, id uuid.UUID) (entities.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, studentID, id)
+	ret0, _ := ret[0].(entities.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDocumentStoreMockRecorder) GetByID(ctx, studentID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDocumentStore)(nil).GetByID), ctx, studentID, id)
+}
+
+// Release mocks base method.
+func (m *MockDocumentStore) Release(ctx context.Context, checksum string, remove func() error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, checksum, remove)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockDocumentStoreMockRecorder) Release(ctx, checksum, remove interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockDocumentStore)(nil).Release), ctx, checksum, remove)
+}
+
+// MockLetterStore is a mock of LetterStore interface.
+type MockLetterStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockLetterStoreMockRecorder
+}
+
+// MockLetterStoreMockRecorder is the mock recorder for MockLetterStore.
+type MockLetterStoreMockRecorder struct {
+	mock *MockLetterStore
+}
+
+// NewMockLetterStore creates a new mock instance.
+func NewMockLetterStore(ctrl *gomock.Controller) *MockLetterStore {
+	mock := &MockLetterStore{ctrl: ctrl}
+	mock.recorder = &MockLetterStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLetterStore) EXPECT() *MockLetterStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLetterStore) Create(ctx context.Context, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tmpl)
+	ret0, _ := ret[0].(entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLetterStoreMockRecorder) Create(ctx, tmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLetterStore)(nil).Create), ctx, tmpl)
+}
+
+// Delete mocks base method.
+func (m *MockLetterStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLetterStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLetterStore)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockLetterStore) Get(ctx context.Context) ([]entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLetterStoreMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLetterStore)(nil).Get), ctx)
+}
+
+// GetByCompany mocks base method.
+func (m *MockLetterStore) GetByCompany(ctx context.Context, companyID *uuid.UUID) (entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCompany", ctx, companyID)
+	ret0, _ := ret[0].(entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCompany indicates an expected call of GetByCompany.
+func (mr *MockLetterStoreMockRecorder) GetByCompany(ctx, companyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCompany", reflect.TypeOf((*MockLetterStore)(nil).GetByCompany), ctx, companyID)
+}
+
+// GetByID mocks base method.
+func (m *MockLetterStore) GetByID(ctx context.Context, id uuid.UUID) (entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLetterStoreMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLetterStore)(nil).GetByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockLetterStore) Update(ctx context.Context, id uuid.UUID, tmpl *entities.LetterTemplate) (entities.LetterTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, tmpl)
+	ret0, _ := ret[0].(entities.LetterTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLetterStoreMockRecorder) Update(ctx, id, tmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLetterStore)(nil).Update), ctx, id, tmpl)
+}
+
+// MockReportStore is a mock of ReportStore interface.
+type MockReportStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportStoreMockRecorder
+}
+
+// MockReportStoreMockRecorder is the mock recorder for MockReportStore.
+type MockReportStoreMockRecorder struct {
+	mock *MockReportStore
+}
+
+// NewMockReportStore creates a new mock instance.
+func NewMockReportStore(ctrl *gomock.Controller) *MockReportStore {
+	mock := &MockReportStore{ctrl: ctrl}
+	mock.recorder = &MockReportStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportStore) EXPECT() *MockReportStoreMockRecorder {
+	return m.recorder
+}
+
+// AcceptedCTCs mocks base method.
+func (m *MockReportStore) AcceptedCTCs(ctx context.Context) ([]entities.BranchCTC, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptedCTCs", ctx)
+	ret0, _ := ret[0].([]entities.BranchCTC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptedCTCs indicates an expected call of AcceptedCTCs.
+func (mr *MockReportStoreMockRecorder) AcceptedCTCs(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptedCTCs", reflect.TypeOf((*MockReportStore)(nil).AcceptedCTCs), ctx)
+}
+
+// CountByBranchStatus mocks base method.
+func (m *MockReportStore) CountByBranchStatus(ctx context.Context) ([]entities.BranchStatusCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByBranchStatus", ctx)
+	ret0, _ := ret[0].([]entities.BranchStatusCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByBranchStatus indicates an expected call of CountByBranchStatus.
+func (mr *MockReportStoreMockRecorder) CountByBranchStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByBranchStatus", reflect.TypeOf((*MockReportStore)(nil).CountByBranchStatus), ctx)
+}
+
+// CountByCategoryStatus mocks base method.
+func (m *MockReportStore) CountByCategoryStatus(ctx context.Context) ([]entities.CategoryStatusCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByCategoryStatus", ctx)
+	ret0, _ := ret[0].([]entities.CategoryStatusCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByCategoryStatus indicates an expected call of CountByCategoryStatus.
+func (mr *MockReportStoreMockRecorder) CountByCategoryStatus(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByCategoryStatus", reflect.TypeOf((*MockReportStore)(nil).CountByCategoryStatus), ctx)
+}
+
+// TopRecruiters mocks base method.
+func (m *MockReportStore) TopRecruiters(ctx context.Context, limit int) ([]entities.RecruiterCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopRecruiters", ctx, limit)
+	ret0, _ := ret[0].([]entities.RecruiterCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopRecruiters indicates an expected call of TopRecruiters.
+func (mr *MockReportStoreMockRecorder) TopRecruiters(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopRecruiters", reflect.TypeOf((*MockReportStore)(nil).TopRecruiters), ctx, limit)
+}
+
+// MockRecruiterStore is a mock of RecruiterStore interface.
+type MockRecruiterStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecruiterStoreMockRecorder
+}
+
+// MockRecruiterStoreMockRecorder is the mock recorder for MockRecruiterStore.
+type MockRecruiterStoreMockRecorder struct {
+	mock *MockRecruiterStore
+}
+
+// NewMockRecruiterStore creates a new mock instance.
+func NewMockRecruiterStore(ctrl *gomock.Controller) *MockRecruiterStore {
+	mock := &MockRecruiterStore{ctrl: ctrl}
+	mock.recorder = &MockRecruiterStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecruiterStore) EXPECT() *MockRecruiterStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRecruiterStore) Create(ctx context.Context, r *entities.Recruiter, keyHash string) (entities.Recruiter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r, keyHash)
+	ret0, _ := ret[0].(entities.Recruiter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRecruiterStoreMockRecorder) Create(ctx, r, keyHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRecruiterStore)(nil).Create), ctx, r, keyHash)
+}
+
+// Delete mocks base method.
+func (m *MockRecruiterStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRecruiterStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRecruiterStore)(nil).Delete), ctx, id)
+}
+
+// GetByCompany mocks base method.
+func (m *MockRecruiterStore) GetByCompany(ctx context.Context, companyID uuid.UUID) ([]entities.Recruiter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCompany", ctx, companyID)
+	ret0, _ := ret[0].([]entities.Recruiter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCompany indicates an expected call of GetByCompany.
+func (mr *MockRecruiterStoreMockRecorder) GetByCompany(ctx, companyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCompany", reflect.TypeOf((*MockRecruiterStore)(nil).GetByCompany), ctx, companyID)
+}
+
+// GetByKeyHash mocks base method.
+func (m *MockRecruiterStore) GetByKeyHash(ctx context.Context, hash string) (entities.Recruiter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKeyHash", ctx, hash)
+	ret0, _ := ret[0].(entities.Recruiter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKeyHash indicates an expected call of GetByKeyHash.
+func (mr *MockRecruiterStoreMockRecorder) GetByKeyHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKeyHash", reflect.TypeOf((*MockRecruiterStore)(nil).GetByKeyHash), ctx, hash)
+}
+
+// MockCollegeStore is a mock of CollegeStore interface.
+type MockCollegeStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollegeStoreMockRecorder
+}
+
+// MockCollegeStoreMockRecorder is the mock recorder for MockCollegeStore.
+type MockCollegeStoreMockRecorder struct {
+	mock *MockCollegeStore
+}
+
+// NewMockCollegeStore creates a new mock instance.
+func NewMockCollegeStore(ctrl *gomock.Controller) *MockCollegeStore {
+	mock := &MockCollegeStore{ctrl: ctrl}
+	mock.recorder = &MockCollegeStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCollegeStore) EXPECT() *MockCollegeStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCollegeStore) Create(ctx context.Context, c *entities.College, keyHash string) (entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c, keyHash)
+	ret0, _ := ret[0].(entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCollegeStoreMockRecorder) Create(ctx, c, keyHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCollegeStore)(nil).Create), ctx, c, keyHash)
+}
+
+// Get mocks base method.
+func (m *MockCollegeStore) Get(ctx context.Context) ([]entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCollegeStoreMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCollegeStore)(nil).Get), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockCollegeStore) GetByID(ctx context.Context, id uuid.UUID) (entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockCollegeStoreMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockCollegeStore)(nil).GetByID), ctx, id)
+}
+
+// GetByKeyHash mocks base method.
+func (m *MockCollegeStore) GetByKeyHash(ctx context.Context, hash string) (entities.College, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKeyHash", ctx, hash)
+	ret0, _ := ret[0].(entities.College)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKeyHash indicates an expected call of GetByKeyHash.
+func (mr *MockCollegeStoreMockRecorder) GetByKeyHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKeyHash", reflect.TypeOf((*MockCollegeStore)(nil).GetByKeyHash), ctx, hash)
+}
+
+// SetKeyHash mocks base method.
+func (m *MockCollegeStore) SetKeyHash(ctx context.Context, id uuid.UUID, hash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKeyHash", ctx, id, hash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKeyHash indicates an expected call of SetKeyHash.
+func (mr *MockCollegeStoreMockRecorder) SetKeyHash(ctx, id, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKeyHash", reflect.TypeOf((*MockCollegeStore)(nil).SetKeyHash), ctx, id, hash)
+}
+
+// MockSeasonStore is a mock of SeasonStore interface.
+type MockSeasonStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeasonStoreMockRecorder
+}
+
+// MockSeasonStoreMockRecorder is the mock recorder for MockSeasonStore.
+type MockSeasonStoreMockRecorder struct {
+	mock *MockSeasonStore
+}
+
+// NewMockSeasonStore creates a new mock instance.
+func NewMockSeasonStore(ctrl *gomock.Controller) *MockSeasonStore {
+	mock := &MockSeasonStore{ctrl: ctrl}
+	mock.recorder = &MockSeasonStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeasonStore) EXPECT() *MockSeasonStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSeasonStore) Create(ctx context.Context, season *entities.Season) (entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, season)
+	ret0, _ := ret[0].(entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeasonStoreMockRecorder) Create(ctx, season interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeasonStore)(nil).Create), ctx, season)
+}
+
+// Get mocks base method.
+func (m *MockSeasonStore) Get(ctx context.Context) ([]entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSeasonStoreMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSeasonStore)(nil).Get), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockSeasonStore) GetByID(ctx context.Context, id uuid.UUID) (entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockSeasonStoreMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockSeasonStore)(nil).GetByID), ctx, id)
+}
+
+// GetCurrent mocks base method.
+func (m *MockSeasonStore) GetCurrent(ctx context.Context) (entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrent", ctx)
+	ret0, _ := ret[0].(entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrent indicates an expected call of GetCurrent.
+func (mr *MockSeasonStoreMockRecorder) GetCurrent(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrent", reflect.TypeOf((*MockSeasonStore)(nil).GetCurrent), ctx)
+}
+
+// Rollover mocks base method.
+func (m *MockSeasonStore) Rollover(ctx context.Context, from uuid.UUID, next *entities.Season) (entities.Season, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollover", ctx, from, next)
+	ret0, _ := ret[0].(entities.Season)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rollover indicates an expected call of Rollover.
+func (mr *MockSeasonStoreMockRecorder) Rollover(ctx, from, next interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollover", reflect.TypeOf((*MockSeasonStore)(nil).Rollover), ctx, from, next)
+}
+
+// MockNotificationStore is a mock of NotificationStore interface.
+type MockNotificationStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationStoreMockRecorder
+}
+
+// MockNotificationStoreMockRecorder is the mock recorder for MockNotificationStore.
+type MockNotificationStoreMockRecorder struct {
+	mock *MockNotificationStore
+}
+
+// NewMockNotificationStore creates a new mock instance.
+func NewMockNotificationStore(ctrl *gomock.Controller) *MockNotificationStore {
+	mock := &MockNotificationStore{ctrl: ctrl}
+	mock.recorder = &MockNotificationStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationStore) EXPECT() *MockNotificationStoreMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockNotificationStore) Claim(ctx context.Context, now, until time.Time, limit int) ([]entities.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, now, until, limit)
+	ret0, _ := ret[0].([]entities.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockNotificationStoreMockRecorder) Claim(ctx, now, until, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockNotificationStore)(nil).Claim), ctx, now, until, limit)
+}
+
+// Enqueue mocks base method.
+func (m *MockNotificationStore) Enqueue(ctx context.Context, notifications []entities.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, notifications)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockNotificationStoreMockRecorder) Enqueue(ctx, notifications interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockNotificationStore)(nil).Enqueue), ctx, notifications)
+}
+
+// Update mocks base method.
+func (m *MockNotificationStore) Update(ctx context.Context, n *entities.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockNotificationStoreMockRecorder) Update(ctx, n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNotificationStore)(nil).Update), ctx, n)
+}
+
+// MockWebhookStore is a mock of WebhookStore interface.
+type MockWebhookStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookStoreMockRecorder
+}
+
+// MockWebhookStoreMockRecorder is the mock recorder for MockWebhookStore.
+type MockWebhookStoreMockRecorder struct {
+	mock *MockWebhookStore
+}
+
+// NewMockWebhookStore creates a new mock instance.
+func NewMockWebhookStore(ctrl *gomock.Controller) *MockWebhookStore {
+	mock := &MockWebhookStore{ctrl: ctrl}
+	mock.recorder = &MockWebhookStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookStore) EXPECT() *MockWebhookStoreMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockWebhookStore) Claim(ctx context.Context, now, until time.Time, limit int) ([]entities.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", ctx, now, until, limit)
+	ret0, _ := ret[0].([]entities.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockWebhookStoreMockRecorder) Claim(ctx, now, until, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockWebhookStore)(nil).Claim), ctx, now, until, limit)
+}
+
+// Create mocks base method.
+func (m *MockWebhookStore) Create(ctx context.Context, w *entities.Webhook) (entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, w)
+	ret0, _ := ret[0].(entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookStoreMockRecorder) Create(ctx, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookStore)(nil).Create), ctx, w)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookStoreMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookStore)(nil).Delete), ctx, id)
+}
+
+// Enqueue mocks base method.
+func (m *MockWebhookStore) Enqueue(ctx context.Context, deliveries []entities.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockWebhookStoreMockRecorder) Enqueue(ctx, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockWebhookStore)(nil).Enqueue), ctx, deliveries)
+}
+
+// Get mocks base method.
+func (m *MockWebhookStore) Get(ctx context.Context) ([]entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookStoreMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookStore)(nil).Get), ctx)
+}
+
+// GetByEvent mocks base method.
+func (m *MockWebhookStore) GetByEvent(ctx context.Context, t entities.EventType) ([]entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByEvent", ctx, t)
+	ret0, _ := ret[0].([]entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByEvent indicates an expected call of GetByEvent.
+func (mr *MockWebhookStoreMockRecorder) GetByEvent(ctx, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEvent", reflect.TypeOf((*MockWebhookStore)(nil).GetByEvent), ctx, t)
+}
+
+// GetByID mocks base method.
+func (m *MockWebhookStore) GetByID(ctx context.Context, id uuid.UUID) (entities.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(entities.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWebhookStoreMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWebhookStore)(nil).GetByID), ctx, id)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhookStore) GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]entities.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, webhookID)
+	ret0, _ := ret[0].([]entities.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookStoreMockRecorder) GetDeliveries(ctx, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhookStore)(nil).GetDeliveries), ctx, webhookID)
+}
+
+// GetDelivery mocks base method.
+func (m *MockWebhookStore) GetDelivery(ctx context.Context, webhookID, id uuid.UUID) (entities.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, webhookID, id)
+	ret0, _ := ret[0].(entities.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockWebhookStoreMockRecorder) GetDelivery(ctx, webhookID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookStore)(nil).GetDelivery), ctx, webhookID, id)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockWebhookStore) UpdateDelivery(ctx context.Context, d *entities.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockWebhookStoreMockRecorder) UpdateDelivery(ctx, d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockWebhookStore)(nil).UpdateDelivery), ctx, d)
+}
+
+// MockOutboxStore is a mock of OutboxStore interface.
+type MockOutboxStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxStoreMockRecorder
+}
+
+// MockOutboxStoreMockRecorder is the mock recorder for MockOutboxStore.
+type MockOutboxStoreMockRecorder struct {
+	mock *MockOutboxStore
+}
+
+// NewMockOutboxStore creates a new mock instance.
+func NewMockOutboxStore(ctrl *gomock.Controller) *MockOutboxStore {
+	mock := &MockOutboxStore{ctrl: ctrl}
+	mock.recorder = &MockOutboxStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxStore) EXPECT() *MockOutboxStoreMockRecorder {
+	return m.recorder
+}
+
+// MarkDelivered mocks base method.
+func (m *MockOutboxStore) MarkDelivered(ctx context.Context, eventID uuid.UUID, sink string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDelivered", ctx, eventID, sink, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDelivered indicates an expected call of MarkDelivered.
+func (mr *MockOutboxStoreMockRecorder) MarkDelivered(ctx, eventID, sink, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDelivered", reflect.TypeOf((*MockOutboxStore)(nil).MarkDelivered), ctx, eventID, sink, at)
+}
+
+// Pending mocks base method.
+func (m *MockOutboxStore) Pending(ctx context.Context, now time.Time, limit int) ([]entities.OutboxEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pending", ctx, now, limit)
+	ret0, _ := ret[0].([]entities.OutboxEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pending indicates an expected call of Pending.
+func (mr *MockOutboxStoreMockRecorder) Pending(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pending", reflect.TypeOf((*MockOutboxStore)(nil).Pending), ctx, now, limit)
+}
+
+// Update mocks base method.
+func (m *MockOutboxStore) Update(ctx context.Context, entry *entities.OutboxEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOutboxStoreMockRecorder) Update(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOutboxStore)(nil).Update), ctx, entry)
+}
+
+// MockIdempotencyStore is a mock of IdempotencyStore interface.
+type MockIdempotencyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyStoreMockRecorder
+}
+
+// MockIdempotencyStoreMockRecorder is the mock recorder for MockIdempotencyStore.
+type MockIdempotencyStoreMockRecorder struct {
+	mock *MockIdempotencyStore
+}
+
+// NewMockIdempotencyStore creates a new mock instance.
+func NewMockIdempotencyStore(ctrl *gomock.Controller) *MockIdempotencyStore {
+	mock := &MockIdempotencyStore{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyStore) EXPECT() *MockIdempotencyStoreMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockIdempotencyStore) Create(ctx context.Context, req *entities.IdempotentRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockIdempotencyStoreMockRecorder) Create(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIdempotencyStore)(nil).Create), ctx, req)
+}
+
+// Delete mocks base method.
+func (m *MockIdempotencyStore) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdempotencyStoreMockRecorder) Delete(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdempotencyStore)(nil).Delete), ctx, key)
+}
+
+// GetByKey mocks base method.
+func (m *MockIdempotencyStore) GetByKey(ctx context.Context, key string) (entities.IdempotentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByKey", ctx, key)
+	ret0, _ := ret[0].(entities.IdempotentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByKey indicates an expected call of GetByKey.
+func (mr *MockIdempotencyStoreMockRecorder) GetByKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByKey", reflect.TypeOf((*MockIdempotencyStore)(nil).GetByKey), ctx, key)
+}
+
+// Update mocks base method.
+func (m *MockIdempotencyStore) Update(ctx context.Context, req *entities.IdempotentRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockIdempotencyStoreMockRecorder) Update(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockIdempotencyStore)(nil).Update), ctx, req)
+}
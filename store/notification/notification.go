@@ -0,0 +1,138 @@
+package notification
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+// Enqueue queues the notifications for the college of ctx in one transaction, so an event either
+// notifies all of its recipients or none.
+func (s store) Enqueue(ctx context.Context, notifications []entities.Notification) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	for start := 0; start < len(notifications); start += batchSize {
+		end := start + batchSize
+		if end > len(notifications) {
+			end = len(notifications)
+		}
+
+		batch := notifications[start:end]
+		placeholders := make([]string, len(batch))
+		args := make([]interface{}, 0, len(batch)*insertColumns)
+
+		for i := range batch {
+			n := &batch[i]
+			n.ID = uuid.New()
+			n.CollegeID = college
+			placeholders[i] = insertPlaceholder
+			args = append(args, n.ID, n.CollegeID, n.Channel, n.Recipient, n.Subject, n.Body, n.Status, n.Attempts,
+				n.NextAttemptAt, n.LastError, n.CreatedAt, n.SentAt)
+		}
+
+		if _, err = tx.ExecContext(ctx, fmt.Sprintf(batchPostQuery, strings.Join(placeholders, ",")), args...); err != nil {
+			_ = tx.Rollback()
+
+			return errors.DB{Reason: "server error"}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// Claim returns up to limit pending notifications of every college whose next send is due at now,
+// the longest waiting first, and moves their next send to until so no other dispatcher claims them while they are sent. A
+// notification whose outcome is never recorded, as when the dispatcher stops, is claimed again once
+// until has passed.
+func (s store) Claim(ctx context.Context, now, until time.Time, limit int) ([]entities.Notification, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []entities.Notification{}, errors.DB{Reason: "server error"}
+	}
+
+	notifications, err := due(ctx, tx, now, limit)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return []entities.Notification{}, err
+	}
+
+	for i := range notifications {
+		if _, err = tx.ExecContext(ctx, claimQuery, until, notifications[i].ID); err != nil {
+			_ = tx.Rollback()
+
+			return []entities.Notification{}, errors.DB{Reason: "server error"}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []entities.Notification{}, errors.DB{Reason: "server error"}
+	}
+
+	return notifications, nil
+}
+
+// due returns up to limit pending notifications due at now, locking their rows until tx ends.
+func due(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]entities.Notification, error) {
+	rows, err := tx.QueryContext(ctx, dueQuery, entities.NotificationPending, now, limit)
+	if err != nil {
+		return nil, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var notifications []entities.Notification
+
+	for rows.Next() {
+		var n entities.Notification
+
+		err = rows.Scan(&n.ID, &n.CollegeID, &n.Channel, &n.Recipient, &n.Subject, &n.Body, &n.Status, &n.Attempts,
+			&n.NextAttemptAt, &n.LastError, &n.CreatedAt, &n.SentAt)
+		if err != nil {
+			return nil, errors.DB{Reason: "scan error"}
+		}
+
+		notifications = append(notifications, n)
+	}
+
+	return notifications, nil
+}
+
+// Update records the outcome of a delivery attempt on n.
+func (s store) Update(ctx context.Context, n *entities.Notification) error {
+	_, err := s.db.ExecContext(ctx, updateQuery, n.Status, n.Attempts, n.NextAttemptAt, n.LastError, n.SentAt, n.ID)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+package notification
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID is the college the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID = uuid.New()
+
+// scoped returns a context scoped to collegeID, as the college middleware sets it up.
+func scoped() context.Context {
+	return auth.WithCollege(context.TODO(), collegeID)
+}
+
+//nolint:gochecknoglobals // column names shared by the tests
+var notificationColumns = []string{"notification_id", "college_id", "channel", "recipient", "subject", "body", "status",
+	"attempts", "next_attempt_at", "last_error", "created_at", "sent_at"}
+
+func TestEnqueue(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	query := "INSERT INTO notifications values " + insertPlaceholder + "," + insertPlaceholder
+	args := []driver.Value{
+		sqlmock.AnyArg(), collegeID, entities.EmailChannel, "aditi@example.com", "Offer", "Congratulations", entities.NotificationPending,
+		0, now, "", now, nil,
+		sqlmock.AnyArg(), collegeID, entities.SMSChannel, "+916388768118", "", "Congratulations", entities.NotificationPending,
+		0, now, "", now, nil,
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(query).WithArgs(args...).WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: insert fails", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(query).WithArgs(args...).WillReturnError(errors.New("connection refused"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		notifications := []entities.Notification{
+			{Channel: entities.EmailChannel, Recipient: "aditi@example.com", Subject: "Offer", Body: "Congratulations",
+				Status: entities.NotificationPending, NextAttemptAt: now, CreatedAt: now},
+			{Channel: entities.SMSChannel, Recipient: "+916388768118", Body: "Congratulations",
+				Status: entities.NotificationPending, NextAttemptAt: now, CreatedAt: now},
+		}
+
+		err := New(db).Enqueue(scoped(), notifications)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestEnqueueUnscoped(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	err = New(db).Enqueue(context.TODO(), []entities.Notification{{Channel: entities.SMSChannel}})
+
+	assert.Equal(t, errors2.MissingParam{Param: []string{"college"}}, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaim(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	created := now.Add(-time.Hour)
+	until := now.Add(10 * time.Minute)
+
+	due := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(dueQuery).WithArgs(entities.NotificationPending, now, 10)
+	}
+	dueRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(notificationColumns).
+			AddRow(id, collegeID, "SMS", "+916388768118", "", "Drive on 15 Jul", "PENDING", 1, now, "timeout", created, nil)
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.Notification
+		expErr      error
+	}{
+		{"Success case: due notifications are claimed until the lease ends", func() {
+			mock.ExpectBegin()
+			due().WillReturnRows(dueRows())
+			mock.ExpectExec(claimQuery).WithArgs(until, id).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+		}, []entities.Notification{{ID: id, CollegeID: collegeID, Channel: entities.SMSChannel, Recipient: "+916388768118",
+			Body: "Drive on 15 Jul", Status: entities.NotificationPending, Attempts: 1, NextAttemptAt: now, LastError: "timeout",
+			CreatedAt: created}}, nil},
+		{"Error case: claim fails and the transaction is rolled back", func() {
+			mock.ExpectBegin()
+			due().WillReturnRows(dueRows())
+			mock.ExpectExec(claimQuery).WithArgs(until, id).WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+		}, []entities.Notification{}, errors2.DB{Reason: "server error"}},
+		{"Error case: query fails", func() {
+			mock.ExpectBegin()
+			due().WillReturnError(errors.New("connection refused"))
+			mock.ExpectRollback()
+		}, []entities.Notification{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Claim(context.TODO(), now, until, 10)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	sent := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	n := entities.Notification{ID: uuid.New(), Status: entities.NotificationSent, Attempts: 1, NextAttemptAt: sent, SentAt: &sent}
+
+	tests := []struct {
+		description string
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", nil, nil},
+		{"Error case: update fails", errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		exp := mock.ExpectExec(updateQuery).WithArgs(n.Status, n.Attempts, n.NextAttemptAt, n.LastError, n.SentAt, n.ID)
+		if tc.mockErr != nil {
+			exp.WillReturnError(tc.mockErr)
+		} else {
+			exp.WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+
+		err := New(db).Update(context.TODO(), &n)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,16 @@
+package notification
+
+const (
+	batchPostQuery = "INSERT INTO notifications values %s"
+	// dueQuery is not scoped to a college: the dispatcher sends the notifications of every college. Rows
+	// another dispatcher is claiming are skipped rather than waited for.
+	dueQuery = "SELECT notification_id,college_id,channel,recipient,subject,body,status,attempts,next_attempt_at,last_error," +
+		"created_at,sent_at from notifications where status=? and next_attempt_at<=? ORDER BY next_attempt_at LIMIT ? " +
+		"FOR UPDATE SKIP LOCKED"
+	claimQuery  = "UPDATE notifications SET next_attempt_at=? WHERE notification_id=?"
+	updateQuery = "UPDATE notifications SET status=?,attempts=?,next_attempt_at=?,last_error=?,sent_at=? WHERE notification_id=?"
+
+	batchSize         = 100
+	insertColumns     = 12
+	insertPlaceholder = "(?,?,?,?,?,?,?,?,?,?,?,?)"
+)
@@ -0,0 +1,248 @@
+package offer
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+// Get returns every offer, or only the offers of studentID when it is not uuid.Nil.
+func (s store) Get(ctx context.Context, studentID uuid.UUID) ([]entities.Offer, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Offer{}, err
+	}
+
+	var rows *sql.Rows
+
+	if studentID == uuid.Nil {
+		var season uuid.UUID
+
+		if season, err = pkgstore.Season(ctx); err != nil {
+			return []entities.Offer{}, err
+		}
+
+		rows, err = s.db.QueryContext(ctx, getQuery, college, season)
+	} else {
+		rows, err = s.db.QueryContext(ctx, getByStudentQuery, college, studentID)
+	}
+
+	if err != nil {
+		return []entities.Offer{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var offers []entities.Offer
+
+	for rows.Next() {
+		offer, err := scanOffer(rows)
+		if err != nil {
+			return []entities.Offer{}, errors.DB{Reason: "scan error"}
+		}
+
+		offers = append(offers, offer)
+	}
+
+	return offers, nil
+}
+
+func (s store) GetByID(ctx context.Context, id uuid.UUID) (entities.Offer, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	offer, err := scanOffer(s.db.QueryRowContext(ctx, getByIDQuery, college, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Offer{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+		}
+
+		return entities.Offer{}, errors.DB{Reason: "server error"}
+	}
+
+	return offer, nil
+}
+
+// Create stores the offer along with an entities.OfferIssued event carrying the offer and its student.
+func (s store) Create(ctx context.Context, offer *entities.Offer) (entities.Offer, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	offer.ID = uuid.New()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entities.Offer{}, errors.DB{Reason: "server error"}
+	}
+
+	res, err := tx.ExecContext(ctx, postQuery, offer.ID, offer.Role, offer.CTC, offer.Location, offer.JoiningDate,
+		offer.ExpiresOn, offer.Status, offer.CreatedAt, offer.RespondedAt, offer.StudentID, offer.Comp.ID, college, season)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return entities.Offer{}, errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		_ = tx.Rollback()
+
+		return entities.Offer{}, errors.EntityNotFound{Reason: "student or company not found"}
+	}
+
+	var st entities.Student
+
+	err = tx.QueryRowContext(ctx, studentQuery, offer.StudentID).
+		Scan(&st.ID, &st.Name, &st.Phone, &st.DOB, &st.Branch, &st.Status, &st.Academic, &st.Email)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return entities.Offer{}, errors.DB{Reason: "server error"}
+	}
+
+	if err = pkgstore.WriteEvents(ctx, tx, &entities.Event{Type: entities.OfferIssued, Student: &st, Offer: offer}); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Offer{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entities.Offer{}, errors.DB{Reason: "server error"}
+	}
+
+	return *offer, nil
+}
+
+// Decline stores the status and response time of a declined offer.
+func (s store) Decline(ctx context.Context, offer *entities.Offer) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, respondQuery, offer.Status, offer.RespondedAt, offer.ID, college, season)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found: " + offer.ID.String()}
+	}
+
+	return nil
+}
+
+// Accept stores the accepted offer, declines the released offers and places the student with the
+// offer's company in one transaction, with an entities.StudentStatusChanged event when the student
+// was not accepted yet.
+func (s store) Accept(ctx context.Context, offer *entities.Offer, released []uuid.UUID) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if _, err = tx.ExecContext(ctx, respondQuery, offer.Status, offer.RespondedAt, offer.ID, college, season); err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	for _, id := range released {
+		if _, err = tx.ExecContext(ctx, respondQuery, entities.OfferDeclined, offer.RespondedAt, id, college, season); err != nil {
+			_ = tx.Rollback()
+
+			return errors.DB{Reason: "server error"}
+		}
+	}
+
+	var st entities.Student
+
+	err = tx.QueryRowContext(ctx, lockStudentQuery, offer.StudentID, college, season).
+		Scan(&st.ID, &st.Name, &st.Phone, &st.DOB, &st.Branch, &st.Status, &st.Academic, &st.Email)
+	if err != nil {
+		_ = tx.Rollback()
+
+		if err == sql.ErrNoRows {
+			return errors.EntityNotFound{Reason: "student not found: " + offer.StudentID.String()}
+		}
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	if _, err = tx.ExecContext(ctx, placeQuery, offer.Comp.ID, entities.ACCEPTED, offer.StudentID, college, season); err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	previous := st.Status
+	st.Comp, st.Status = offer.Comp, entities.ACCEPTED
+
+	if previous != st.Status {
+		e := entities.Event{Type: entities.StudentStatusChanged, Student: &st, PreviousStatus: previous}
+		if err = pkgstore.WriteEvents(ctx, tx, &e); err != nil {
+			_ = tx.Rollback()
+
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanOffer(row scanner) (entities.Offer, error) {
+	var offer entities.Offer
+
+	err := row.Scan(&offer.ID, &offer.StudentID, &offer.Comp.ID, &offer.Comp.Name, &offer.Comp.Category, &offer.Role,
+		&offer.CTC, &offer.Location, &offer.JoiningDate, &offer.ExpiresOn, &offer.Status, &offer.CreatedAt, &offer.RespondedAt)
+	if err != nil {
+		return entities.Offer{}, err
+	}
+
+	return offer, nil
+}
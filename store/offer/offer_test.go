@@ -0,0 +1,319 @@
+package offer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID and seasonID are the college and season the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID, seasonID = uuid.New(), uuid.New()
+
+// writeEventQuery is the outbox insert of a single event written by pkgstore.WriteEvents.
+const writeEventQuery = "INSERT INTO outbox (event_id,college_id,event_type,payload,attempts,last_error,next_attempt_at,created_at) " +
+	"values (?,?,?,?,0,'',?,?)"
+
+// scoped returns a context scoped to collegeID and seasonID, as the college and season middleware set
+// it up.
+func scoped() context.Context {
+	return auth.WithSeason(auth.WithCollege(context.TODO(), collegeID), seasonID)
+}
+
+//nolint:gochecknoglobals // column names shared by the tests
+var offerColumns = []string{"offer_id", "student_id", "company_id", "company_name", "category", "role", "ctc", "location",
+	"joining_date", "expires_on", "status", "created_at", "responded_at"}
+
+func TestGet(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	offerID := uuid.New()
+	stuID := uuid.New()
+	cmpID := uuid.New()
+	created := time.Date(2023, 7, 20, 10, 0, 0, 0, time.UTC)
+	offer := entities.Offer{ID: offerID, StudentID: stuID, Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"},
+		Role: "Project Engineer", CTC: 350000, Location: "Bangalore", JoiningDate: entities.NewDate(2024, 7, 1),
+		ExpiresOn: entities.NewDate(2023, 8, 1), Status: entities.OfferMade, CreatedAt: created}
+
+	tests := []struct {
+		description string
+		studentID   uuid.UUID
+		mock        func()
+		expRes      []entities.Offer
+		expErr      error
+	}{
+		{"Success case: all offers", uuid.Nil, func() {
+			mock.ExpectQuery(getQuery).WithArgs(collegeID, seasonID).WillReturnRows(sqlmock.NewRows(offerColumns).AddRow(offerID, stuID, cmpID, "Wipro", "MASS",
+				"Project Engineer", 350000, "Bangalore", "2024-07-01", "2023-08-01", "OFFERED", created, nil))
+		}, []entities.Offer{offer}, nil},
+		{"Success case: offers of a student", stuID, func() {
+			mock.ExpectQuery(getByStudentQuery).WithArgs(collegeID, stuID).WillReturnRows(sqlmock.NewRows(offerColumns).AddRow(offerID, stuID,
+				cmpID, "Wipro", "MASS", "Project Engineer", 350000, "Bangalore", "2024-07-01", "2023-08-01", "OFFERED", created, nil))
+		}, []entities.Offer{offer}, nil},
+		{"Error case: query fails", uuid.Nil, func() {
+			mock.ExpectQuery(getQuery).WithArgs(collegeID, seasonID).WillReturnError(errors.New("connection refused"))
+		}, []entities.Offer{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Get(scoped(), tc.studentID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetByID(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	offerID := uuid.New()
+	stuID := uuid.New()
+	cmpID := uuid.New()
+	created := time.Date(2023, 7, 20, 10, 0, 0, 0, time.UTC)
+	responded := time.Date(2023, 7, 25, 16, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.Offer
+		expErr      error
+	}{
+		{"Success case: accepted offer", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(collegeID, offerID).WillReturnRows(sqlmock.NewRows(offerColumns).AddRow(offerID, stuID,
+				cmpID, "Google", "DREAM IT", "SDE", 2400000, "", "2024-07-01", "2023-08-01", "ACCEPTED", created, responded))
+		}, entities.Offer{ID: offerID, StudentID: stuID, Comp: entities.Company{ID: cmpID, Name: "Google", Category: "DREAM IT"},
+			Role: "SDE", CTC: 2400000, JoiningDate: entities.NewDate(2024, 7, 1), ExpiresOn: entities.NewDate(2023, 8, 1),
+			Status: entities.OfferAccepted, CreatedAt: created, RespondedAt: &responded}, nil},
+		{"Error case: when id is not present in db", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(collegeID, offerID).WillReturnRows(sqlmock.NewRows(offerColumns))
+		}, entities.Offer{}, errors2.EntityNotFound{Reason: "id not found: " + offerID.String()}},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getByIDQuery).WithArgs(collegeID, offerID).WillReturnError(errors.New("connection refused"))
+		}, entities.Offer{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByID(scoped(), offerID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	input := entities.Offer{StudentID: uuid.New(), Comp: entities.Company{ID: uuid.New()}, Role: "SDE", CTC: 1200000,
+		JoiningDate: entities.NewDate(2024, 7, 1), ExpiresOn: entities.NewDate(2023, 8, 1), Status: entities.OfferMade,
+		CreatedAt: time.Date(2023, 7, 20, 10, 0, 0, 0, time.UTC)}
+	student := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"student_id", "student_name", "student_phone", "dob", "branch", "status", "academic", "email"}).
+			AddRow(input.StudentID, "Aditi", "+916388768118", "02/07/2000", "CSE", "PENDING", nil, "")
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "SDE", 1200000, "", input.JoiningDate, input.ExpiresOn,
+				"OFFERED", input.CreatedAt, nil, input.StudentID, input.Comp.ID, collegeID, seasonID).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(studentQuery).WithArgs(input.StudentID).WillReturnRows(student())
+			mock.ExpectExec(writeEventQuery).WithArgs(sqlmock.AnyArg(), collegeID, entities.OfferIssued, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: student or company outside the college or season", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "SDE", 1200000, "", input.JoiningDate, input.ExpiresOn,
+				"OFFERED", input.CreatedAt, nil, input.StudentID, input.Comp.ID, collegeID, seasonID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "student or company not found"}},
+		{"Error case: insert fails", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "SDE", 1200000, "", input.JoiningDate, input.ExpiresOn,
+				"OFFERED", input.CreatedAt, nil, input.StudentID, input.Comp.ID, collegeID, seasonID).WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: event is not recorded", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "SDE", 1200000, "", input.JoiningDate, input.ExpiresOn,
+				"OFFERED", input.CreatedAt, nil, input.StudentID, input.Comp.ID, collegeID, seasonID).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(studentQuery).WithArgs(input.StudentID).WillReturnRows(student())
+			mock.ExpectExec(writeEventQuery).WithArgs(sqlmock.AnyArg(), collegeID, entities.OfferIssued, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		offer := input
+		output, err := New(db).Create(scoped(), &offer)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestDecline(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	responded := time.Date(2023, 7, 25, 16, 30, 0, 0, time.UTC)
+	offer := entities.Offer{ID: uuid.New(), Status: entities.OfferDeclined, RespondedAt: &responded}
+
+	decline := func() *sqlmock.ExpectedExec {
+		return mock.ExpectExec(respondQuery).WithArgs("DECLINED", responded, offer.ID, collegeID, seasonID)
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case", func() {
+			decline().WillReturnResult(sqlmock.NewResult(0, 1))
+		}, nil},
+		{"Error case: when id is not present in db, or its student is of another season", func() {
+			decline().WillReturnResult(sqlmock.NewResult(0, 0))
+		}, errors2.EntityNotFound{Reason: "id not found: " + offer.ID.String()}},
+		{"Error case: update fails", func() {
+			decline().WillReturnError(errors.New("server error"))
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		err := New(db).Decline(scoped(), &offer)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestAccept(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	responded := time.Date(2023, 7, 25, 16, 30, 0, 0, time.UTC)
+	offer := entities.Offer{ID: uuid.New(), StudentID: uuid.New(), Comp: entities.Company{ID: uuid.New()},
+		Status: entities.OfferAccepted, RespondedAt: &responded}
+	released := uuid.New()
+	student := func(status entities.Status) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"student_id", "student_name", "student_phone", "dob", "branch", "status", "academic", "email"}).
+			AddRow(offer.StudentID, "Aditi", "+916388768118", "02/07/2000", "CSE", status, nil, "")
+	}
+
+	respond := func(status string, id uuid.UUID) *sqlmock.ExpectedExec {
+		return mock.ExpectExec(respondQuery).WithArgs(status, responded, id, collegeID, seasonID)
+	}
+	lock := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(lockStudentQuery).WithArgs(offer.StudentID, collegeID, seasonID)
+	}
+	place := func() *sqlmock.ExpectedExec {
+		return mock.ExpectExec(placeQuery).WithArgs(offer.Comp.ID, "ACCEPTED", offer.StudentID, collegeID, seasonID)
+	}
+
+	tests := []struct {
+		description string
+		released    []uuid.UUID
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: other offers released, student placed and the status change recorded", []uuid.UUID{released}, func() {
+			mock.ExpectBegin()
+			respond("ACCEPTED", offer.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+			respond("DECLINED", released).WillReturnResult(sqlmock.NewResult(0, 1))
+			lock().WillReturnRows(student(entities.PENDING))
+			place().WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(writeEventQuery).WithArgs(sqlmock.AnyArg(), collegeID, entities.StudentStatusChanged, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Success case: student already accepted, no status change", nil, func() {
+			mock.ExpectBegin()
+			respond("ACCEPTED", offer.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+			lock().WillReturnRows(student(entities.ACCEPTED))
+			place().WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: recording the event fails and the transaction is rolled back", nil, func() {
+			mock.ExpectBegin()
+			respond("ACCEPTED", offer.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+			lock().WillReturnRows(student(entities.PENDING))
+			place().WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(writeEventQuery).WithArgs(sqlmock.AnyArg(), collegeID, entities.StudentStatusChanged, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: placing the student fails and the transaction is rolled back", nil, func() {
+			mock.ExpectBegin()
+			respond("ACCEPTED", offer.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+			lock().WillReturnRows(student(entities.PENDING))
+			place().WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: student of another season, such as an archived one, is not placed", nil, func() {
+			mock.ExpectBegin()
+			respond("ACCEPTED", offer.ID).WillReturnResult(sqlmock.NewResult(0, 1))
+			lock().WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "student not found: " + offer.StudentID.String()}},
+		{"Error case: transaction cannot be started", nil, func() {
+			mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		err := New(db).Accept(scoped(), &offer, tc.released)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
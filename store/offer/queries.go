@@ -0,0 +1,23 @@
+package offer
+
+// Offers are scoped by the college of their company; an offer is only stored when its student and
+// company belong to the same college. Listing all offers lists those of the season's students, and
+// offers are only issued, answered and accepted for students of the season.
+const (
+	selectQuery = "SELECT o.offer_id,o.student_id,c.company_id,c.company_name,c.category,o.role,o.ctc,o.location," +
+		"o.joining_date,o.expires_on,o.status,o.created_at,o.responded_at from offers o join companies c on o.company_id=c.company_id " +
+		"where c.college_id=?"
+	getQuery          = selectQuery + " and o.student_id IN (SELECT student_id FROM students WHERE season_id=?) ORDER BY o.created_at"
+	getByStudentQuery = selectQuery + " and o.student_id=? ORDER BY o.created_at"
+	getByIDQuery      = selectQuery + " and o.offer_id=?"
+	postQuery         = "INSERT INTO offers SELECT ?,s.student_id,c.company_id,?,?,?,?,?,?,?,? FROM students s " +
+		"join companies c on c.college_id=s.college_id WHERE s.student_id=? AND c.company_id=? AND s.college_id=? AND s.season_id=?"
+	// studentQuery reads the student of an offer into its event; the insert has checked the college.
+	studentQuery = "SELECT student_id,student_name,student_phone,dob,branch,status,academic,email FROM students WHERE student_id=?"
+	// lockStudentQuery reads the student placed by an accepted offer, locking its row until the offer is stored.
+	lockStudentQuery = studentQuery + " AND college_id=? AND season_id=? FOR UPDATE"
+	respondQuery     = "UPDATE offers SET status=?,responded_at=? WHERE offer_id=? " +
+		"AND company_id IN (SELECT company_id FROM companies WHERE college_id=?) " +
+		"AND student_id IN (SELECT student_id FROM students WHERE season_id=?)"
+	placeQuery = "UPDATE students SET company_id=?,status=? WHERE student_id=? AND college_id=? AND season_id=?"
+)
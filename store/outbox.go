@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+const (
+	outboxQuery       = "INSERT INTO outbox (event_id,college_id,event_type,payload,attempts,last_error,next_attempt_at,created_at) values %s"
+	outboxPlaceholder = "(?,?,?,?,0,'',?,?)"
+	outboxColumns     = 6
+	outboxBatchSize   = 100
+)
+
+// WriteEvents records events in the outbox on tx, so they are relayed if and only if the change they
+// describe is committed. Every event is stamped with a new id, the time and the college and season of
+// ctx before it is recorded.
+func WriteEvents(ctx context.Context, tx *sql.Tx, events ...*entities.Event) error {
+	college, err := College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, _ := auth.SeasonFrom(ctx)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	for start := 0; start < len(events); start += outboxBatchSize {
+		end := start + outboxBatchSize
+		if end > len(events) {
+			end = len(events)
+		}
+
+		batch := events[start:end]
+		placeholders := make([]string, len(batch))
+		args := make([]interface{}, 0, len(batch)*outboxColumns)
+
+		for i, e := range batch {
+			e.ID = uuid.New()
+			e.CollegeID = college
+			e.SeasonID = season
+			e.OccurredAt = now
+
+			payload, err := json.Marshal(e)
+			if err != nil {
+				return errors.DB{Reason: "server error"}
+			}
+
+			placeholders[i] = outboxPlaceholder
+			args = append(args, e.ID, e.CollegeID, e.Type, string(payload), now, now)
+		}
+
+		if _, err = tx.ExecContext(ctx, fmt.Sprintf(outboxQuery, strings.Join(placeholders, ",")), args...); err != nil {
+			return errors.DB{Reason: "server error"}
+		}
+	}
+
+	return nil
+}
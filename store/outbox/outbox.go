@@ -0,0 +1,85 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+// Pending returns up to limit unpublished events of every college whose next attempt is due at now,
+// the oldest first. Events are written to the outbox by the stores with pkgstore.WriteEvents.
+func (s store) Pending(ctx context.Context, now time.Time, limit int) ([]entities.OutboxEntry, error) {
+	rows, err := s.db.QueryContext(ctx, pendingQuery, now, limit)
+	if err != nil {
+		return []entities.OutboxEntry{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var entries []entities.OutboxEntry
+
+	for rows.Next() {
+		var (
+			entry     entities.OutboxEntry
+			payload   string
+			delivered string
+		)
+
+		err = rows.Scan(&payload, &entry.Attempts, &entry.NextAttemptAt, &entry.LastError, &delivered)
+		if err != nil {
+			return []entities.OutboxEntry{}, errors.DB{Reason: "scan error"}
+		}
+
+		if err = json.Unmarshal([]byte(payload), &entry.Event); err != nil {
+			return []entities.OutboxEntry{}, errors.DB{Reason: "invalid payload"}
+		}
+
+		if delivered != "" {
+			entry.Delivered = strings.Split(delivered, ",")
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if rows.Err() != nil {
+		return []entities.OutboxEntry{}, errors.DB{Reason: "server error"}
+	}
+
+	return entries, nil
+}
+
+// MarkDelivered records that the event has been delivered to sink. Recording it twice is not an error.
+func (s store) MarkDelivered(ctx context.Context, eventID uuid.UUID, sink string, at time.Time) error {
+	if _, err := s.db.ExecContext(ctx, deliveredQuery, eventID, sink, at); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// Update records the outcome of a relay attempt on entry.
+func (s store) Update(ctx context.Context, entry *entities.OutboxEntry) error {
+	_, err := s.db.ExecContext(ctx, updateQuery, entry.Attempts, entry.NextAttemptAt, entry.LastError, entry.PublishedAt,
+		entry.Event.ID)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
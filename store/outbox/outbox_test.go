@@ -0,0 +1,131 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+//nolint:gochecknoglobals // column names shared by the tests
+var pendingColumns = []string{"payload", "attempts", "next_attempt_at", "last_error", "delivered"}
+
+func TestPending(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	event := entities.Event{ID: uuid.New(), Type: entities.CompanyCreated, CollegeID: uuid.New(), SeasonID: uuid.New(),
+		OccurredAt: now.Add(-time.Minute), Company: &entities.Company{ID: uuid.New(), Name: "Wipro", Category: "MASS"}}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.OutboxEntry
+		expErr      error
+	}{
+		{"Success case: sinks that have the event are listed", func() {
+			mock.ExpectQuery(pendingQuery).WithArgs(now, 10).WillReturnRows(sqlmock.NewRows(pendingColumns).
+				AddRow(string(payload), 1, now, "webhooks: timeout", "bus,notifications"))
+		}, []entities.OutboxEntry{{Event: event, Attempts: 1, NextAttemptAt: now, LastError: "webhooks: timeout",
+			Delivered: []string{"bus", "notifications"}}}, nil},
+		{"Success case: new event", func() {
+			mock.ExpectQuery(pendingQuery).WithArgs(now, 10).WillReturnRows(sqlmock.NewRows(pendingColumns).
+				AddRow(string(payload), 0, now, "", ""))
+		}, []entities.OutboxEntry{{Event: event, NextAttemptAt: now}}, nil},
+		{"Error case: invalid payload", func() {
+			mock.ExpectQuery(pendingQuery).WithArgs(now, 10).WillReturnRows(sqlmock.NewRows(pendingColumns).
+				AddRow("{", 0, now, "", ""))
+		}, []entities.OutboxEntry{}, errors2.DB{Reason: "invalid payload"}},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(pendingQuery).WithArgs(now, 10).WillReturnError(errors.New("connection refused"))
+		}, []entities.OutboxEntry{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Pending(context.TODO(), now, 10)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestMarkDelivered(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", nil, nil},
+		{"Error case: insert fails", errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(deliveredQuery).WithArgs(id, "webhooks", now).WillReturnResult(sqlmock.NewResult(1, 1)).
+			WillReturnError(tc.mockErr)
+
+		err := New(db).MarkDelivered(context.TODO(), id, "webhooks", now)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	published := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	entry := entities.OutboxEntry{Event: entities.Event{ID: uuid.New()}, Attempts: 2, NextAttemptAt: published,
+		PublishedAt: &published}
+
+	tests := []struct {
+		description string
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", nil, nil},
+		{"Error case: update fails", errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(updateQuery).WithArgs(2, published, "", &published, entry.Event.ID).
+			WillReturnResult(sqlmock.NewResult(1, 1)).WillReturnError(tc.mockErr)
+
+		err := New(db).Update(context.TODO(), &entry)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,10 @@
+package outbox
+
+const (
+	// pendingQuery is not scoped to a college: the relay publishes the events of every college.
+	pendingQuery = "SELECT o.payload,o.attempts,o.next_attempt_at,o.last_error,COALESCE(GROUP_CONCAT(d.sink),'') from outbox o " +
+		"LEFT JOIN outbox_deliveries d on d.event_id=o.event_id where o.published_at IS NULL and o.next_attempt_at<=? " +
+		"GROUP BY o.event_id,o.payload,o.attempts,o.next_attempt_at,o.last_error,o.created_at ORDER BY o.created_at LIMIT ?"
+	deliveredQuery = "INSERT IGNORE INTO outbox_deliveries values (?,?,?)"
+	updateQuery    = "UPDATE outbox SET attempts=?,next_attempt_at=?,last_error=?,published_at=? WHERE event_id=?"
+)
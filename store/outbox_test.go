@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+// payloadOf matches an event payload and records the event it holds.
+type payloadOf struct {
+	event *entities.Event
+}
+
+func (p payloadOf) Match(v driver.Value) bool {
+	s, ok := v.(string)
+
+	return ok && json.Unmarshal([]byte(s), p.event) == nil
+}
+
+func TestWriteEvents(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	collegeID, seasonID := uuid.New(), uuid.New()
+	ctx := auth.WithSeason(auth.WithCollege(context.Background(), collegeID), seasonID)
+	company := entities.Company{ID: uuid.New(), Name: "Wipro", Category: "MASS"}
+	e := entities.Event{Type: entities.CompanyCreated, Company: &company}
+
+	var recorded entities.Event
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO outbox (event_id,college_id,event_type,payload,attempts,last_error,next_attempt_at,created_at) "+
+		"values (?,?,?,?,0,'',?,?)").
+		WithArgs(sqlmock.AnyArg(), collegeID, entities.CompanyCreated, payloadOf{&recorded}, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	tx, err := db.Begin()
+	assert.NoError(t, err)
+
+	err = WriteEvents(ctx, tx, &e)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.NotEqual(t, uuid.Nil, e.ID)
+	assert.Equal(t, collegeID, e.CollegeID)
+	assert.Equal(t, seasonID, e.SeasonID)
+	assert.False(t, e.OccurredAt.IsZero())
+	assert.Equal(t, e.ID, recorded.ID, "the payload should carry the stamped event")
+	assert.Equal(t, company, *recorded.Company)
+}
+
+func TestWriteEventsUnscoped(t *testing.T) {
+	err := WriteEvents(context.Background(), nil, &entities.Event{Type: entities.StudentCreated})
+
+	assert.Equal(t, errors.MissingParam{Param: []string{"college"}}, err)
+}
@@ -0,0 +1,38 @@
+package store
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+// PatchSet builds the SET clause of a partial UPDATE for the given fields, using column to map
+// a field name to its column. Columns are emitted in a stable order so queries are deterministic.
+func PatchSet(fields map[string]interface{}, column func(field string) (string, bool)) (set string, args []interface{}, err error) {
+	if len(fields) == 0 {
+		return "", nil, errors.MissingParam{Param: []string{"fields"}}
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	sets := make([]string, 0, len(keys))
+	args = make([]interface{}, 0, len(keys)+1)
+
+	for _, k := range keys {
+		col, ok := column(k)
+		if !ok {
+			return "", nil, errors.InvalidParam{Param: k}
+		}
+
+		sets = append(sets, col+"=?")
+		args = append(args, fields[k])
+	}
+
+	return strings.Join(sets, ","), args, nil
+}
@@ -0,0 +1,45 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+func TestPatchSet(t *testing.T) {
+	column := func(field string) (string, bool) {
+		switch field {
+		case "name":
+			return "student_name", true
+		case "status":
+			return "status", true
+		default:
+			return "", false
+		}
+	}
+
+	tests := []struct {
+		description string
+		input       map[string]interface{}
+		expSet      string
+		expArgs     []interface{}
+		expErr      error
+	}{
+		{"Success case: single field", map[string]interface{}{"name": "Aditi"}, "student_name=?", []interface{}{"Aditi"}, nil},
+		{"Success case: fields are sorted", map[string]interface{}{"status": "ACCEPTED", "name": "Aditi"},
+			"student_name=?,status=?", []interface{}{"Aditi", "ACCEPTED"}, nil,
+		},
+		{"Error case: unknown field", map[string]interface{}{"age": 23}, "", nil, errors.InvalidParam{Param: "age"}},
+		{"Error case: no fields", nil, "", nil, errors.MissingParam{Param: []string{"fields"}}},
+	}
+
+	for i, tc := range tests {
+		set, args, err := PatchSet(tc.input, column)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expSet, set, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expArgs, args, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,14 @@
+package recruiter
+
+// Recruiters belong to the college of their company. The key hash lookup is not scoped: it is how
+// the college of a portal request is found in the first place.
+const (
+	selectQuery = "SELECT r.recruiter_id,r.company_id,c.college_id,r.name,r.email,r.created_at from recruiters r " +
+		"join companies c on r.company_id=c.company_id"
+
+	getByCompanyQuery = selectQuery + " where c.college_id=? and r.company_id=? ORDER BY r.created_at"
+	getByKeyHashQuery = selectQuery + " where r.key_hash=?"
+	postQuery         = "INSERT INTO recruiters SELECT ?,company_id,?,?,?,? FROM companies WHERE company_id=? AND college_id=?"
+	deleteQuery       = "DELETE FROM recruiters WHERE recruiter_id=? " +
+		"AND company_id IN (SELECT company_id FROM companies WHERE college_id=?)"
+)
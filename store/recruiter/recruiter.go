@@ -0,0 +1,106 @@
+package recruiter
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+func (s store) GetByCompany(ctx context.Context, companyID uuid.UUID) ([]entities.Recruiter, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Recruiter{}, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, getByCompanyQuery, college, companyID)
+	if err != nil {
+		return []entities.Recruiter{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var recruiters []entities.Recruiter
+
+	for rows.Next() {
+		var r entities.Recruiter
+
+		if err = rows.Scan(&r.ID, &r.CompanyID, &r.CollegeID, &r.Name, &r.Email, &r.CreatedAt); err != nil {
+			return []entities.Recruiter{}, errors.DB{Reason: "scan error"}
+		}
+
+		recruiters = append(recruiters, r)
+	}
+
+	return recruiters, nil
+}
+
+// GetByKeyHash returns the recruiter whose API key hashes to hash, in whichever college it is.
+func (s store) GetByKeyHash(ctx context.Context, hash string) (entities.Recruiter, error) {
+	var r entities.Recruiter
+
+	err := s.db.QueryRowContext(ctx, getByKeyHashQuery, hash).Scan(&r.ID, &r.CompanyID, &r.CollegeID, &r.Name, &r.Email,
+		&r.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Recruiter{}, errors.EntityNotFound{Reason: "unknown api key"}
+		}
+
+		return entities.Recruiter{}, errors.DB{Reason: "server error"}
+	}
+
+	return r, nil
+}
+
+// Create stores the recruiter with the hash of its API key; the key itself is never stored.
+func (s store) Create(ctx context.Context, r *entities.Recruiter, keyHash string) (entities.Recruiter, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Recruiter{}, err
+	}
+
+	r.ID = uuid.New()
+	r.CollegeID = college
+
+	res, err := s.db.ExecContext(ctx, postQuery, r.ID, r.Name, r.Email, keyHash, r.CreatedAt, r.CompanyID, college)
+	if err != nil {
+		return entities.Recruiter{}, errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return entities.Recruiter{}, errors.EntityNotFound{Reason: "id not found: " + r.CompanyID.String()}
+	}
+
+	return *r, nil
+}
+
+func (s store) Delete(ctx context.Context, id uuid.UUID) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, id, college)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package recruiter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID is the college the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID = uuid.New()
+
+// scoped returns a context scoped to collegeID, as the college middleware sets it up.
+func scoped() context.Context {
+	return auth.WithCollege(context.TODO(), collegeID)
+}
+
+//nolint:gochecknoglobals // column names shared by the tests
+var recruiterColumns = []string{"recruiter_id", "company_id", "college_id", "name", "email", "created_at"}
+
+const keyHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestGetByCompany(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	cmpID := uuid.New()
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.Recruiter
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getByCompanyQuery).WithArgs(collegeID, cmpID).WillReturnRows(sqlmock.NewRows(recruiterColumns).
+				AddRow(id, cmpID, collegeID, "Ravi", "ravi@wipro.com", created))
+		}, []entities.Recruiter{{ID: id, CompanyID: cmpID, CollegeID: collegeID, Name: "Ravi", Email: "ravi@wipro.com", CreatedAt: created}}, nil},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getByCompanyQuery).WithArgs(collegeID, cmpID).WillReturnError(errors.New("connection refused"))
+		}, []entities.Recruiter{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByCompany(scoped(), cmpID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetByKeyHash(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	cmpID := uuid.New()
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.Recruiter
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getByKeyHashQuery).WithArgs(keyHash).WillReturnRows(sqlmock.NewRows(recruiterColumns).
+				AddRow(id, cmpID, collegeID, "Ravi", "ravi@wipro.com", created))
+		}, entities.Recruiter{ID: id, CompanyID: cmpID, CollegeID: collegeID, Name: "Ravi", Email: "ravi@wipro.com", CreatedAt: created}, nil},
+		{"Error case: unknown key", func() {
+			mock.ExpectQuery(getByKeyHashQuery).WithArgs(keyHash).WillReturnRows(sqlmock.NewRows(recruiterColumns))
+		}, entities.Recruiter{}, errors2.EntityNotFound{Reason: "unknown api key"}},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getByKeyHashQuery).WithArgs(keyHash).WillReturnError(errors.New("connection refused"))
+		}, entities.Recruiter{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByKeyHash(context.TODO(), keyHash)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	cmpID := uuid.New()
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", nil, nil},
+		{"Error case: insert fails", errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		r := entities.Recruiter{CompanyID: cmpID, Name: "Ravi", Email: "ravi@wipro.com", CreatedAt: created}
+
+		mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), "Ravi", "ravi@wipro.com", keyHash, created, cmpID, collegeID).
+			WillReturnResult(sqlmock.NewResult(1, 1)).WillReturnError(tc.mockErr)
+
+		output, err := New(db).Create(scoped(), &r, keyHash)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Equal(t, r, output, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		rows        int64
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", 1, nil, nil},
+		{"Error case: id not found", 0, nil, errors2.EntityNotFound{Reason: "id not found: " + id.String()}},
+		{"Error case: delete fails", 0, errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(deleteQuery).WithArgs(id, collegeID).WillReturnResult(sqlmock.NewResult(0, tc.rows)).WillReturnError(tc.mockErr)
+
+		err := New(db).Delete(scoped(), id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,14 @@
+package report
+
+// Reports cover the students of the request's college and season only.
+const (
+	countByBranchStatusQuery = "SELECT s.branch,s.status,COUNT(*) FROM students s WHERE s.college_id=? AND s.season_id=? " +
+		"GROUP BY s.branch,s.status ORDER BY s.branch,s.status"
+	countByCategoryStatusQuery = "SELECT c.category,s.status,COUNT(*) FROM students s join companies c on s.company_id=c.company_id " +
+		"WHERE s.college_id=? AND s.season_id=? GROUP BY c.category,s.status ORDER BY c.category,s.status"
+	topRecruitersQuery = "SELECT c.company_id,c.company_name,c.category,COUNT(*) AS placed FROM students s " +
+		"join companies c on s.company_id=c.company_id WHERE s.status='ACCEPTED' AND s.college_id=? AND s.season_id=? " +
+		"GROUP BY c.company_id,c.company_name,c.category ORDER BY placed DESC,c.company_name LIMIT ?"
+	acceptedCTCsQuery = "SELECT s.branch,o.ctc FROM offers o join students s on o.student_id=s.student_id " +
+		"WHERE o.status='ACCEPTED' AND s.college_id=? AND s.season_id=? ORDER BY s.branch,o.ctc"
+)
@@ -0,0 +1,169 @@
+package report
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+func (r store) CountByBranchStatus(ctx context.Context) ([]entities.BranchStatusCount, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.BranchStatusCount{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.BranchStatusCount{}, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, countByBranchStatusQuery, college, season)
+	if err != nil {
+		return []entities.BranchStatusCount{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	counts := []entities.BranchStatusCount{}
+
+	for rows.Next() {
+		var count entities.BranchStatusCount
+
+		if err = rows.Scan(&count.Branch, &count.Status, &count.Count); err != nil {
+			return []entities.BranchStatusCount{}, errors.DB{Reason: "scan error"}
+		}
+
+		counts = append(counts, count)
+	}
+
+	if rows.Err() != nil {
+		return []entities.BranchStatusCount{}, errors.DB{Reason: "server error"}
+	}
+
+	return counts, nil
+}
+
+func (r store) CountByCategoryStatus(ctx context.Context) ([]entities.CategoryStatusCount, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.CategoryStatusCount{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.CategoryStatusCount{}, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, countByCategoryStatusQuery, college, season)
+	if err != nil {
+		return []entities.CategoryStatusCount{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	counts := []entities.CategoryStatusCount{}
+
+	for rows.Next() {
+		var count entities.CategoryStatusCount
+
+		if err = rows.Scan(&count.Category, &count.Status, &count.Count); err != nil {
+			return []entities.CategoryStatusCount{}, errors.DB{Reason: "scan error"}
+		}
+
+		counts = append(counts, count)
+	}
+
+	if rows.Err() != nil {
+		return []entities.CategoryStatusCount{}, errors.DB{Reason: "server error"}
+	}
+
+	return counts, nil
+}
+
+func (r store) TopRecruiters(ctx context.Context, limit int) ([]entities.RecruiterCount, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.RecruiterCount{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.RecruiterCount{}, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, topRecruitersQuery, college, season, limit)
+	if err != nil {
+		return []entities.RecruiterCount{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	recruiters := []entities.RecruiterCount{}
+
+	for rows.Next() {
+		var rc entities.RecruiterCount
+
+		if err = rows.Scan(&rc.Company.ID, &rc.Company.Name, &rc.Company.Category, &rc.Placed); err != nil {
+			return []entities.RecruiterCount{}, errors.DB{Reason: "scan error"}
+		}
+
+		recruiters = append(recruiters, rc)
+	}
+
+	if rows.Err() != nil {
+		return []entities.RecruiterCount{}, errors.DB{Reason: "server error"}
+	}
+
+	return recruiters, nil
+}
+
+// AcceptedCTCs returns the CTC of every accepted offer with the student's branch, ordered by branch
+// and CTC so medians can be read off directly.
+func (r store) AcceptedCTCs(ctx context.Context) ([]entities.BranchCTC, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.BranchCTC{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.BranchCTC{}, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, acceptedCTCsQuery, college, season)
+	if err != nil {
+		return []entities.BranchCTC{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	ctcs := []entities.BranchCTC{}
+
+	for rows.Next() {
+		var c entities.BranchCTC
+
+		if err = rows.Scan(&c.Branch, &c.CTC); err != nil {
+			return []entities.BranchCTC{}, errors.DB{Reason: "scan error"}
+		}
+
+		ctcs = append(ctcs, c)
+	}
+
+	if rows.Err() != nil {
+		return []entities.BranchCTC{}, errors.DB{Reason: "server error"}
+	}
+
+	return ctcs, nil
+}
@@ -0,0 +1,173 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID and seasonID are the college and season the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID, seasonID = uuid.New(), uuid.New()
+
+// scoped returns a context scoped to collegeID and seasonID, as the college and season middleware set
+// it up.
+func scoped() context.Context {
+	return auth.WithSeason(auth.WithCollege(context.TODO(), collegeID), seasonID)
+}
+
+func TestCountByBranchStatus(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		description string
+		rows        *sqlmock.Rows
+		mockErr     error
+		expRes      []entities.BranchStatusCount
+		expErr      error
+	}{
+		{"Success case: counts per branch and status",
+			sqlmock.NewRows([]string{"branch", "status", "count"}).AddRow("CSE", "ACCEPTED", 4).AddRow("CSE", "PENDING", 2),
+			nil, []entities.BranchStatusCount{{Branch: "CSE", Status: "ACCEPTED", Count: 4}, {Branch: "CSE", Status: "PENDING", Count: 2}}, nil,
+		},
+		{"Success case: no students", sqlmock.NewRows([]string{"branch", "status", "count"}), nil,
+			[]entities.BranchStatusCount{}, nil,
+		},
+		{"Error case: scan error", sqlmock.NewRows([]string{"branch", "status", "count"}).AddRow("CSE", "ACCEPTED", "many"), nil,
+			[]entities.BranchStatusCount{}, errors2.DB{Reason: "scan error"},
+		},
+		{"Error case: server error", sqlmock.NewRows([]string{"branch"}), errors.New("server error"),
+			[]entities.BranchStatusCount{}, errors2.DB{Reason: "server error"},
+		},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectQuery(countByBranchStatusQuery).WithArgs(collegeID, seasonID).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
+
+		output, err := New(db).CountByBranchStatus(scoped())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCountByCategoryStatus(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		description string
+		rows        *sqlmock.Rows
+		mockErr     error
+		expRes      []entities.CategoryStatusCount
+		expErr      error
+	}{
+		{"Success case: counts per category and status",
+			sqlmock.NewRows([]string{"category", "status", "count"}).AddRow("DREAM IT", "ACCEPTED", 3),
+			nil, []entities.CategoryStatusCount{{Category: "DREAM IT", Status: "ACCEPTED", Count: 3}}, nil,
+		},
+		{"Error case: scan error", sqlmock.NewRows([]string{"category", "status", "count"}).AddRow("MASS", "PENDING", nil), nil,
+			[]entities.CategoryStatusCount{}, errors2.DB{Reason: "scan error"},
+		},
+		{"Error case: server error", sqlmock.NewRows([]string{"category"}), errors.New("server error"),
+			[]entities.CategoryStatusCount{}, errors2.DB{Reason: "server error"},
+		},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectQuery(countByCategoryStatusQuery).WithArgs(collegeID, seasonID).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
+
+		output, err := New(db).CountByCategoryStatus(scoped())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestTopRecruiters(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	cmpID := uuid.New()
+
+	tests := []struct {
+		description string
+		rows        *sqlmock.Rows
+		mockErr     error
+		expRes      []entities.RecruiterCount
+		expErr      error
+	}{
+		{"Success case: recruiters ordered by placements",
+			sqlmock.NewRows([]string{"id", "name", "category", "placed"}).AddRow(cmpID, "Wipro", "MASS", 12),
+			nil, []entities.RecruiterCount{{Company: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Placed: 12}}, nil,
+		},
+		{"Error case: scan error", sqlmock.NewRows([]string{"id", "name", "category", "placed"}).AddRow(cmpID, "Wipro", "MASS", "x"), nil,
+			[]entities.RecruiterCount{}, errors2.DB{Reason: "scan error"},
+		},
+		{"Error case: server error", sqlmock.NewRows([]string{"id"}), errors.New("server error"),
+			[]entities.RecruiterCount{}, errors2.DB{Reason: "server error"},
+		},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectQuery(topRecruitersQuery).WithArgs(collegeID, seasonID, 5).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
+
+		output, err := New(db).TopRecruiters(scoped(), 5)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestAcceptedCTCs(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	tests := []struct {
+		description string
+		rows        *sqlmock.Rows
+		mockErr     error
+		expRes      []entities.BranchCTC
+		expErr      error
+	}{
+		{"Success case: ctc per accepted offer",
+			sqlmock.NewRows([]string{"branch", "ctc"}).AddRow("CSE", 600000).AddRow("CSE", 900000),
+			nil, []entities.BranchCTC{{Branch: "CSE", CTC: 600000}, {Branch: "CSE", CTC: 900000}}, nil,
+		},
+		{"Success case: no accepted offers", sqlmock.NewRows([]string{"branch", "ctc"}), nil, []entities.BranchCTC{}, nil},
+		{"Error case: server error", sqlmock.NewRows([]string{"branch"}), errors.New("server error"),
+			[]entities.BranchCTC{}, errors2.DB{Reason: "server error"},
+		},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectQuery(acceptedCTCsQuery).WithArgs(collegeID, seasonID).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
+
+		output, err := New(db).AcceptedCTCs(scoped())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,13 @@
+package season
+
+const (
+	selectQuery     = "SELECT season_id,name,created_at,archived_at,min_age,age_reference_date from seasons where college_id=?"
+	getQuery        = selectQuery + " ORDER BY created_at"
+	getByIDQuery    = selectQuery + " and season_id=?"
+	getCurrentQuery = selectQuery + " and archived_at IS NULL"
+	postQuery       = "INSERT INTO seasons values (?,?,?,?,?,?,?)"
+
+	archiveQuery = "UPDATE seasons SET archived_at=? WHERE season_id=? AND college_id=? AND archived_at IS NULL"
+	// carryForwardQuery copies the companies taking part in one season into another.
+	carryForwardQuery = "INSERT INTO season_companies SELECT ?,company_id FROM season_companies WHERE season_id=?"
+)
@@ -0,0 +1,178 @@
+package season
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+// errSeasonExists is returned when a season violates the unique name per college on seasons.
+//
+//nolint:gochecknoglobals // sentinel error
+var errSeasonExists = errors.Conflict{Reason: "a season with this name already exists"}
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+func (s store) Get(ctx context.Context) ([]entities.Season, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Season{}, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, getQuery, college)
+	if err != nil {
+		return []entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var seasons []entities.Season
+
+	for rows.Next() {
+		season, err := scanSeason(rows)
+		if err != nil {
+			return []entities.Season{}, errors.DB{Reason: "scan error"}
+		}
+
+		seasons = append(seasons, season)
+	}
+
+	return seasons, nil
+}
+
+func (s store) GetByID(ctx context.Context, id uuid.UUID) (entities.Season, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Season{}, err
+	}
+
+	season, err := scanSeason(s.db.QueryRowContext(ctx, getByIDQuery, college, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Season{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+		}
+
+		return entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	return season, nil
+}
+
+// GetCurrent returns the season of the college that is not archived.
+func (s store) GetCurrent(ctx context.Context) (entities.Season, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Season{}, err
+	}
+
+	season, err := scanSeason(s.db.QueryRowContext(ctx, getCurrentQuery, college))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Season{}, errors.EntityNotFound{Reason: "no current season"}
+		}
+
+		return entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	return season, nil
+}
+
+func (s store) Create(ctx context.Context, season *entities.Season) (entities.Season, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Season{}, err
+	}
+
+	season.ID = uuid.New()
+
+	_, err = s.db.ExecContext(ctx, postQuery, season.ID, college, season.Name, season.CreatedAt, season.ArchivedAt,
+		season.MinAge, season.AgeReferenceDate)
+	if err != nil {
+		if pkgstore.IsDuplicateEntry(err) {
+			return entities.Season{}, errSeasonExists
+		}
+
+		return entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	return *season, nil
+}
+
+// Rollover archives the season from as of next.CreatedAt and stores next with the companies taking
+// part in from, in one transaction. It fails with a conflict when from was archived in the meantime.
+func (s store) Rollover(ctx context.Context, from uuid.UUID, next *entities.Season) (entities.Season, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Season{}, err
+	}
+
+	next.ID = uuid.New()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	res, err := tx.ExecContext(ctx, archiveQuery, next.CreatedAt, from, college)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		_ = tx.Rollback()
+
+		return entities.Season{}, errors.Conflict{Reason: "season already archived: " + from.String()}
+	}
+
+	if _, err = tx.ExecContext(ctx, postQuery, next.ID, college, next.Name, next.CreatedAt, next.ArchivedAt,
+		next.MinAge, next.AgeReferenceDate); err != nil {
+		_ = tx.Rollback()
+
+		if pkgstore.IsDuplicateEntry(err) {
+			return entities.Season{}, errSeasonExists
+		}
+
+		return entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	if _, err = tx.ExecContext(ctx, carryForwardQuery, next.ID, from); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entities.Season{}, errors.DB{Reason: "server error"}
+	}
+
+	return *next, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSeason(row scanner) (entities.Season, error) {
+	var season entities.Season
+
+	err := row.Scan(&season.ID, &season.Name, &season.CreatedAt, &season.ArchivedAt, &season.MinAge, &season.AgeReferenceDate)
+	if err != nil {
+		return entities.Season{}, err
+	}
+
+	return season, nil
+}
@@ -0,0 +1,199 @@
+package season
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID is the college the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID = uuid.New()
+
+// scoped returns a context scoped to collegeID, as the college middleware sets it up.
+func scoped() context.Context {
+	return auth.WithCollege(context.TODO(), collegeID)
+}
+
+//nolint:gochecknoglobals // column names shared by the tests
+var seasonColumns = []string{"season_id", "name", "created_at", "archived_at", "min_age", "age_reference_date"}
+
+func TestGet(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	oldID, curID := uuid.New(), uuid.New()
+	created := time.Date(2022, 7, 1, 9, 0, 0, 0, time.UTC)
+	archived := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	minAge, refDate := 21, entities.NewDate(2022, 7, 1)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.Season
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getQuery).WithArgs(collegeID).WillReturnRows(sqlmock.NewRows(seasonColumns).
+				AddRow(oldID, "2022-23", created, archived, 21, "2022-07-01").AddRow(curID, "2023-24", archived, nil, nil, nil))
+		}, []entities.Season{{ID: oldID, Name: "2022-23", MinAge: &minAge, AgeReferenceDate: &refDate, CreatedAt: created, ArchivedAt: &archived},
+			{ID: curID, Name: "2023-24", CreatedAt: archived}}, nil},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getQuery).WithArgs(collegeID).WillReturnError(errors.New("connection refused"))
+		}, []entities.Season{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Get(scoped())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetCurrent(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.Season
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getCurrentQuery).WithArgs(collegeID).WillReturnRows(sqlmock.NewRows(seasonColumns).
+				AddRow(id, "2023-24", created, nil, nil, nil))
+		}, entities.Season{ID: id, Name: "2023-24", CreatedAt: created}, nil},
+		{"Error case: no current season", func() {
+			mock.ExpectQuery(getCurrentQuery).WithArgs(collegeID).WillReturnRows(sqlmock.NewRows(seasonColumns))
+		}, entities.Season{}, errors2.EntityNotFound{Reason: "no current season"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetCurrent(scoped())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	minAge := 21
+
+	tests := []struct {
+		description string
+		minAge      *int
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", nil, nil, nil},
+		{"Success case: own minimum age", &minAge, nil, nil},
+		{"Error case: name taken", nil, &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, errSeasonExists},
+		{"Error case: insert fails", nil, errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		season := entities.Season{Name: "2023-24", MinAge: tc.minAge, CreatedAt: created}
+
+		var minAgeArg driver.Value
+		if tc.minAge != nil {
+			minAgeArg = int64(*tc.minAge)
+		}
+
+		mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), collegeID, "2023-24", created, nil, minAgeArg, nil).
+			WillReturnResult(sqlmock.NewResult(1, 1)).WillReturnError(tc.mockErr)
+
+		output, err := New(db).Create(scoped(), &season)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestRollover(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	from := uuid.New()
+	now := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(archiveQuery).WithArgs(now, from, collegeID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), collegeID, "2024-25", now, nil, nil, nil).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(carryForwardQuery).WithArgs(sqlmock.AnyArg(), from).WillReturnResult(sqlmock.NewResult(3, 3))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: season archived meanwhile", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(archiveQuery).WithArgs(now, from, collegeID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+		}, errors2.Conflict{Reason: "season already archived: " + from.String()}},
+		{"Error case: carrying companies forward fails", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(archiveQuery).WithArgs(now, from, collegeID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), collegeID, "2024-25", now, nil, nil, nil).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(carryForwardQuery).WithArgs(sqlmock.AnyArg(), from).WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		next := entities.Season{Name: "2024-25", CreatedAt: now}
+		_, err := New(db).Rollover(scoped(), from, &next)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
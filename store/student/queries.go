@@ -1,14 +1,38 @@
 package student
 
+// Students are read and written by id within the college and season of the request, so a request in
+// the current season can't change a student whose season has been archived.
 const (
-	getByIDQuery = "select s.student_id,s.student_name,s.student_phone,s.dob,s.branch," +
-		"c.company_id,c.company_name,c.category,s.status from students s join companies c on s. company_id=c. company_id " +
-		"where s.student_id=?"
+	getByIDQuery = "select s.student_id,s.student_name,s.student_phone,s.dob,s.branch,c.company_id,c.company_name," +
+		"c.category,s.status,s.academic,s.email from students s join companies c on s. company_id=c. company_id " +
+		"where s.student_id=? and s.college_id=? and s.season_id=?"
 	getDataWithCompQuery = "SELECT s.student_id,s.student_name,s.student_phone,s.dob,s.branch,c.company_id,c.company_name," +
-		"c.category,s.status from students s join companies c on s. company_id=c. company_id"
-	getDataQuery    = "SELECT student_id,student_name,student_phone,dob,branch,status From students s"
-	postQuery       = "INSERT INTO students values (?,?,?,?,?,?,?)"
-	updateQuery     = "UPDATE students SET student_name=?,student_phone=?,dob=?,branch=?,company_id=?,status=? WHERE student_id=?"
-	deleteQuery     = "DELETE FROM students WHERE student_id=?"
-	getCompanyQuery = "SELECT c.company_id,c.company_name,c.category from companies c where c.company_id=?"
+		"c.category,s.status,s.academic,s.email from students s join companies c on s. company_id=c. company_id " +
+		"where s.college_id=? and s.season_id=?"
+	getDataQuery = "SELECT student_id,student_name,student_phone,dob,branch,status,academic,email From students s " +
+		"where s.college_id=? and s.season_id=?"
+	postQuery      = "INSERT INTO students values (?,?,?,?,?,?,?,?,?,?,?)"
+	batchPostQuery = "INSERT INTO students values %s"
+	updateQuery    = "UPDATE students SET student_name=?,student_phone=?,dob=?,branch=?,company_id=?,status=?,academic=?,email=? " +
+		"WHERE student_id=? AND college_id=? AND season_id=?"
+	lockStatusQuery = "SELECT status FROM students WHERE student_id=? AND college_id=? AND season_id=? FOR UPDATE"
+	patchQuery      = "UPDATE students SET %s WHERE student_id=? AND college_id=? AND season_id=?"
+	deleteQuery     = "DELETE FROM students WHERE student_id=? AND college_id=? AND season_id=?"
+	getCompanyQuery = "SELECT c.company_id,c.company_name,c.category,c.eligibility from companies c " +
+		"join season_companies sc on sc.company_id=c.company_id where c.company_id=? and c.college_id=? and sc.season_id=?"
+
+	findByPhoneQuery   = "SELECT student_id FROM students WHERE student_phone=? AND student_id<>? AND college_id=? LIMIT 1"
+	findByNameDOBQuery = "SELECT student_id FROM students WHERE student_name=? AND dob=? AND student_id<>? AND college_id=? LIMIT 1"
+	mergeQuery         = "UPDATE students SET company_id=?,status=? WHERE student_id=? AND college_id=? AND season_id=?"
+
+	moveRegistrationsQuery = "UPDATE IGNORE drive_registrations SET student_id=? WHERE student_id=?"
+	moveResultsQuery       = "UPDATE IGNORE round_results SET student_id=? WHERE student_id=?"
+	moveOffersQuery        = "UPDATE offers SET student_id=? WHERE student_id=?"
+	moveDocumentsQuery     = "UPDATE IGNORE documents SET student_id=? WHERE student_id=?"
+)
+
+const (
+	batchSize         = 100
+	insertColumns     = 11
+	insertPlaceholder = "(?,?,?,?,?,?,?,?,?,?,?)"
 )
@@ -4,13 +4,23 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
 	"github.com/aditi-zs/Placement-API/entities"
 	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
 )
 
+// errPhoneTaken is returned when a write violates phoneKey, the unique key on student_phone within a
+// college. Other unique key violations are server errors.
+//
+//nolint:gochecknoglobals // sentinel error
+var errPhoneTaken = errors.Conflict{Reason: "phone number already registered"}
+
+const phoneKey = "uq_students_phone"
+
 type store struct {
 	db *sql.DB
 }
@@ -23,10 +33,17 @@ func New(d *sql.DB) store {
 func (s store) GetByID(ctx context.Context, id uuid.UUID) (entities.Student, error) {
 	var student entities.Student
 
-	row := s.db.QueryRowContext(ctx, getByIDQuery, id)
-	err := row.Scan(&student.ID, &student.Name, &student.Phone, &student.DOB, &student.Branch,
-		&student.Comp.ID, &student.Comp.Name, &student.Comp.Category, &student.Status)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return entities.Student{}, err
+	}
 
+	err = scanStudent(s.db.QueryRowContext(ctx, getByIDQuery, id, college, season), &student)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return entities.Student{}, errors.EntityNotFound{Reason: "id not found"}
@@ -39,10 +56,19 @@ func (s store) GetByID(ctx context.Context, id uuid.UUID) (entities.Student, err
 }
 
 func (s store) GetWithCompany(ctx context.Context, name, branch string) ([]entities.Student, error) {
-	query := getDataWithCompQuery
-	query += queryBuilder(name, branch)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Student{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.Student{}, err
+	}
 
-	rows, err := s.db.QueryContext(ctx, query)
+	filter, args := queryBuilder(name, branch)
+
+	rows, err := s.db.QueryContext(ctx, getDataWithCompQuery+filter, append([]interface{}{college, season}, args...)...)
 	if err != nil {
 		return []entities.Student{}, errors.DB{Reason: "server error"}
 	}
@@ -54,7 +80,7 @@ func (s store) GetWithCompany(ctx context.Context, name, branch string) ([]entit
 	for rows.Next() {
 		var student entities.Student
 		err = rows.Scan(&student.ID, &student.Name, &student.Phone, &student.DOB, &student.Branch,
-			&student.Comp.ID, &student.Comp.Name, &student.Comp.Category, &student.Status)
+			&student.Comp.ID, &student.Comp.Name, &student.Comp.Category, &student.Status, &student.Academic, &student.Email)
 
 		if err != nil {
 			return []entities.Student{}, errors.DB{Reason: "scan error"}
@@ -74,9 +100,18 @@ func (s store) GetWithCompany(ctx context.Context, name, branch string) ([]entit
 	return students, nil
 }
 func (s store) Get(ctx context.Context, name, branch string) ([]entities.Student, error) {
-	query := getDataQuery
-	query += queryBuilder(name, branch)
-	rows, err := s.db.QueryContext(ctx, query)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Student{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return []entities.Student{}, err
+	}
+
+	filter, args := queryBuilder(name, branch)
+	rows, err := s.db.QueryContext(ctx, getDataQuery+filter, append([]interface{}{college, season}, args...)...)
 
 	if err != nil {
 		return []entities.Student{}, errors.DB{Reason: "server error"}
@@ -87,7 +122,8 @@ func (s store) Get(ctx context.Context, name, branch string) ([]entities.Student
 
 	for rows.Next() {
 		var student entities.Student
-		err = rows.Scan(&student.ID, &student.Name, &student.Phone, &student.DOB, &student.Branch, &student.Status)
+		err = rows.Scan(&student.ID, &student.Name, &student.Phone, &student.DOB, &student.Branch, &student.Status,
+			&student.Academic, &student.Email)
 
 		if err != nil {
 			return []entities.Student{}, errors.DB{Reason: "scan error"}
@@ -106,36 +142,344 @@ func (s store) Get(ctx context.Context, name, branch string) ([]entities.Student
 
 	return students, nil
 }
+
+// Stream calls fn for every student matching the filters while the rows are being read, so the
+// result set is never held in memory. Iteration stops at the first error returned by fn.
+func (s store) Stream(ctx context.Context, name, branch string, withCompany bool, fn func(entities.Student) error) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := getDataQuery
+	if withCompany {
+		query = getDataWithCompQuery
+	}
+
+	filter, args := queryBuilder(name, branch)
+
+	rows, err := s.db.QueryContext(ctx, query+filter, append([]interface{}{college, season}, args...)...)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var student entities.Student
+
+		if withCompany {
+			err = rows.Scan(&student.ID, &student.Name, &student.Phone, &student.DOB, &student.Branch,
+				&student.Comp.ID, &student.Comp.Name, &student.Comp.Category, &student.Status, &student.Academic, &student.Email)
+		} else {
+			err = rows.Scan(&student.ID, &student.Name, &student.Phone, &student.DOB, &student.Branch, &student.Status,
+				&student.Academic, &student.Email)
+		}
+
+		if err != nil {
+			return errors.DB{Reason: "scan error"}
+		}
+
+		if err = fn(student); err != nil {
+			return err
+		}
+	}
+
+	if rows.Err() != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// Create stores the student in the season of ctx.
 func (s store) Create(ctx context.Context, st *entities.Student) (entities.Student, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
 	st.ID = uuid.New()
 
-	_, err := s.db.ExecContext(ctx, postQuery, st.ID,
-		st.Name, st.Phone, st.DOB, st.Branch, st.Status, st.Comp.ID)
+	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return entities.Student{}, errors.DB{Reason: "server error"}
 	}
 
+	_, err = tx.ExecContext(ctx, postQuery, st.ID,
+		st.Name, st.Phone, st.DOB, st.Branch, st.Status, st.Comp.ID, st.Academic, college, season, st.Email)
+	if err != nil {
+		_ = tx.Rollback()
+
+		if pkgstore.IsDuplicateKey(err, phoneKey) {
+			return entities.Student{}, errPhoneTaken
+		}
+
+		return entities.Student{}, errors.DB{Reason: "server error"}
+	}
+
+	if err = pkgstore.WriteEvents(ctx, tx, &entities.Event{Type: entities.StudentCreated, Student: st}); err != nil {
+		_ = tx.Rollback()
+
+		return entities.Student{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entities.Student{}, errors.DB{Reason: "server error"}
+	}
+
 	return *st, nil
 }
 
+// CreateBatch inserts the students in a single transaction, batchSize rows per statement.
+// Either every student is stored, along with its entities.StudentCreated event, or none is.
+func (s store) CreateBatch(ctx context.Context, students []*entities.Student) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	for start := 0; start < len(students); start += batchSize {
+		end := start + batchSize
+		if end > len(students) {
+			end = len(students)
+		}
+
+		batch := students[start:end]
+		placeholders := make([]string, len(batch))
+		args := make([]interface{}, 0, len(batch)*insertColumns)
+
+		for i, st := range batch {
+			st.ID = uuid.New()
+			placeholders[i] = insertPlaceholder
+			args = append(args, st.ID, st.Name, st.Phone, st.DOB, st.Branch, st.Status, st.Comp.ID, st.Academic, college,
+				season, st.Email)
+		}
+
+		if _, err = tx.ExecContext(ctx, fmt.Sprintf(batchPostQuery, strings.Join(placeholders, ",")), args...); err != nil {
+			_ = tx.Rollback()
+
+			if pkgstore.IsDuplicateKey(err, phoneKey) {
+				return errPhoneTaken
+			}
+
+			return errors.DB{Reason: "server error"}
+		}
+	}
+
+	created := make([]*entities.Event, len(students))
+	for i, st := range students {
+		created[i] = &entities.Event{Type: entities.StudentCreated, Student: st}
+	}
+
+	if err = pkgstore.WriteEvents(ctx, tx, created...); err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// Update replaces the student and records an entities.StudentStatusChanged event when the status
+// changes. The row is locked while its previous status is read, so concurrent updates can't both
+// miss the change.
 func (s store) Update(ctx context.Context, id uuid.UUID, st *entities.Student) (entities.Student, error) {
-	res, err := s.db.ExecContext(ctx, updateQuery,
-		st.Name, st.Phone, st.DOB, st.Branch, st.Comp.ID, st.Status, id)
+	college, err := pkgstore.College(ctx)
 	if err != nil {
-		return entities.Student{}, errors.DB{Reason: err.Error()}
+		return entities.Student{}, err
 	}
 
-	if n, _ := res.RowsAffected(); n == 0 {
-		return entities.Student{}, errors.EntityNotFound{Reason: "id not found"}
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return entities.Student{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return entities.Student{}, errors.DB{Reason: "server error"}
+	}
+
+	previous, err := lockStatus(ctx, tx, id, college, season)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return entities.Student{}, err
+	}
+
+	_, err = tx.ExecContext(ctx, updateQuery,
+		st.Name, st.Phone, st.DOB, st.Branch, st.Comp.ID, st.Status, st.Academic, st.Email, id, college, season)
+	if err != nil {
+		_ = tx.Rollback()
+
+		if pkgstore.IsDuplicateKey(err, phoneKey) {
+			return entities.Student{}, errPhoneTaken
+		}
+
+		return entities.Student{}, errors.DB{Reason: err.Error()}
 	}
 
 	st.ID = id
 
+	if st.Status != previous {
+		e := entities.Event{Type: entities.StudentStatusChanged, Student: st, PreviousStatus: previous}
+		if err = pkgstore.WriteEvents(ctx, tx, &e); err != nil {
+			_ = tx.Rollback()
+
+			return entities.Student{}, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return entities.Student{}, errors.DB{Reason: "server error"}
+	}
+
 	return *st, nil
 }
 
+func (s store) Patch(ctx context.Context, id uuid.UUID, fields map[string]interface{}) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	set, args, err := pkgstore.PatchSet(fields, patchColumn)
+	if err != nil {
+		return err
+	}
+
+	query := fmt.Sprintf(patchQuery, set)
+
+	if _, ok := fields["status"]; ok {
+		return s.patchStatus(ctx, id, college, season, query, args)
+	}
+
+	res, err := s.db.ExecContext(ctx, query, append(args, id, college, season)...)
+	if err != nil {
+		if pkgstore.IsDuplicateKey(err, phoneKey) {
+			return errPhoneTaken
+		}
+
+		return errors.DB{Reason: err.Error()}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found"}
+	}
+
+	return nil
+}
+
+// patchStatus runs a patch changing the status in a transaction recording an
+// entities.StudentStatusChanged event with the patched student when the status changes.
+func (s store) patchStatus(ctx context.Context, id, college, season uuid.UUID, query string, args []interface{}) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	previous, err := lockStatus(ctx, tx, id, college, season)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, query, append(args, id, college, season)...); err != nil {
+		_ = tx.Rollback()
+
+		if pkgstore.IsDuplicateKey(err, phoneKey) {
+			return errPhoneTaken
+		}
+
+		return errors.DB{Reason: err.Error()}
+	}
+
+	var st entities.Student
+	if err = scanStudent(tx.QueryRowContext(ctx, getByIDQuery, id, college, season), &st); err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	if st.Status != previous {
+		e := entities.Event{Type: entities.StudentStatusChanged, Student: &st, PreviousStatus: previous}
+		if err = pkgstore.WriteEvents(ctx, tx, &e); err != nil {
+			_ = tx.Rollback()
+
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// lockStatus returns the status of the student, locking its row until tx ends.
+func lockStatus(ctx context.Context, tx *sql.Tx, id, college, season uuid.UUID) (entities.Status, error) {
+	var status entities.Status
+
+	if err := tx.QueryRowContext(ctx, lockStatusQuery, id, college, season).Scan(&status); err != nil {
+		if err == sql.ErrNoRows {
+			return "", errors.EntityNotFound{Reason: "id not found"}
+		}
+
+		return "", errors.DB{Reason: "server error"}
+	}
+
+	return status, nil
+}
+
+// scanStudent scans a row of getByIDQuery into st.
+func scanStudent(row *sql.Row, st *entities.Student) error {
+	return row.Scan(&st.ID, &st.Name, &st.Phone, &st.DOB, &st.Branch,
+		&st.Comp.ID, &st.Comp.Name, &st.Comp.Category, &st.Status, &st.Academic, &st.Email)
+}
+
 func (s store) Delete(ctx context.Context, id uuid.UUID) error {
-	res, err := s.db.ExecContext(ctx, deleteQuery, id)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, id, college, season)
 
 	if err != nil {
 		return errors.DB{Reason: err.Error()}
@@ -148,12 +492,24 @@ func (s store) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// GetCompanyByID returns a company of the college taking part in the season of ctx. Companies of
+// other seasons are not found, so students, drives and offers cannot be linked to them.
 func (s store) GetCompanyByID(ctx context.Context, id uuid.UUID) (entities.Company, error) {
 	var company entities.Company
 
-	row := s.db.QueryRowContext(ctx, getCompanyQuery, id)
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Company{}, err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return entities.Company{}, err
+	}
 
-	err := row.Scan(&company.ID, &company.Name, &company.Category)
+	row := s.db.QueryRowContext(ctx, getCompanyQuery, id, college, season)
+
+	err = row.Scan(&company.ID, &company.Name, &company.Category, &company.Criteria)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -166,17 +522,145 @@ func (s store) GetCompanyByID(ctx context.Context, id uuid.UUID) (entities.Compa
 	return company, nil
 }
 
-func queryBuilder(name, branch string) string {
-	var query string
+// FindDuplicate returns the ID of another student matching stu on key, or uuid.Nil when there is
+// none. stu itself is skipped, so it can be used for updates as well as creates.
+func (s store) FindDuplicate(ctx context.Context, stu *entities.Student, key entities.DuplicateKey) (uuid.UUID, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	var row *sql.Row
+
+	switch key {
+	case entities.PhoneKey:
+		row = s.db.QueryRowContext(ctx, findByPhoneQuery, stu.Phone, stu.ID, college)
+	case entities.NameDOBKey:
+		row = s.db.QueryRowContext(ctx, findByNameDOBQuery, stu.Name, stu.DOB, stu.ID, college)
+	default:
+		return uuid.Nil, errors.InvalidParam{Param: "duplicate key " + string(key)}
+	}
+
+	var id uuid.UUID
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return uuid.Nil, nil
+		}
+
+		return uuid.Nil, errors.DB{Reason: "server error"}
+	}
+
+	return id, nil
+}
+
+// Merge stores the company link of keep, moves the duplicate's drive registrations, round results,
+// offers and documents over to keep and deletes the duplicate in one transaction, recording an
+// entities.StudentStatusChanged event when keep's status changes. Rows keep already
+// has for the same drive, round or file are dropped along with the duplicate. The rows are moved by
+// student id alone; the transaction is rolled back unless the duplicate is deleted from the college
+// and season, so nothing moves for a duplicate of another college or season.
+func (s store) Merge(ctx context.Context, keep *entities.Student, duplicateID uuid.UUID) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	season, err := pkgstore.Season(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	previous, err := lockStatus(ctx, tx, keep.ID, college, season)
+	if err != nil {
+		_ = tx.Rollback()
 
-	switch {
-	case name != "" && branch != "":
-		query = query + " " + fmt.Sprintf("where s.student_name='%v' AND s.branch='%v'", name, branch)
-	case name != "" && branch == "":
-		query = query + " " + fmt.Sprintf("where s.student_name='%v'", name)
-	case name == "" && branch != "":
-		query = query + " " + fmt.Sprintf("where s.branch='%v'", branch)
+		return err
 	}
 
-	return query
+	if _, err = tx.ExecContext(ctx, mergeQuery, keep.Comp.ID, keep.Status, keep.ID, college, season); err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	for _, query := range []string{moveRegistrationsQuery, moveResultsQuery, moveOffersQuery, moveDocumentsQuery} {
+		if _, err = tx.ExecContext(ctx, query, keep.ID, duplicateID); err != nil {
+			_ = tx.Rollback()
+
+			return errors.DB{Reason: "server error"}
+		}
+	}
+
+	res, err := tx.ExecContext(ctx, deleteQuery, duplicateID, college, season)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		_ = tx.Rollback()
+
+		return errors.EntityNotFound{Reason: "id not found"}
+	}
+
+	if keep.Status != previous {
+		e := entities.Event{Type: entities.StudentStatusChanged, Student: keep, PreviousStatus: previous}
+		if err = pkgstore.WriteEvents(ctx, tx, &e); err != nil {
+			_ = tx.Rollback()
+
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// queryBuilder returns the filters on name and branch to append to a student query, with their
+// arguments.
+func queryBuilder(name, branch string) (query string, args []interface{}) {
+	if name != "" {
+		query += " AND s.student_name=?"
+		args = append(args, name)
+	}
+
+	if branch != "" {
+		query += " AND s.branch=?"
+		args = append(args, branch)
+	}
+
+	return query, args
+}
+
+func patchColumn(field string) (string, bool) {
+	switch field {
+	case "name":
+		return "student_name", true
+	case "phone":
+		return "student_phone", true
+	case "dob":
+		return "dob", true
+	case "branch":
+		return "branch", true
+	case "comp":
+		return "company_id", true
+	case "status":
+		return "status", true
+	case "academic":
+		return "academic", true
+	case "email":
+		return "email", true
+	default:
+		return "", false
+	}
 }
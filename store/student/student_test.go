@@ -5,16 +5,52 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
+	"strings"
 	"testing"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/aditi-zs/Placement-API/auth"
 	"github.com/aditi-zs/Placement-API/entities"
 	errors2 "github.com/aditi-zs/Placement-API/errors"
 )
 
+// collegeID and seasonID are the college and season the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID, seasonID = uuid.New(), uuid.New()
+
+// writeEventsQuery returns the outbox insert of n events written by pkgstore.WriteEvents.
+func writeEventsQuery(n int) string {
+	return "INSERT INTO outbox (event_id,college_id,event_type,payload,attempts,last_error,next_attempt_at,created_at) values " +
+		strings.TrimSuffix(strings.Repeat("(?,?,?,?,0,'',?,?),", n), ",")
+}
+
+// scoped returns a context scoped to collegeID and seasonID, as the college and season middleware set
+// it up.
+func scoped() context.Context {
+	return auth.WithSeason(auth.WithCollege(context.TODO(), collegeID), seasonID)
+}
+
+// filterArgs returns the arguments of a student query in the scope of scoped filtered on name and branch.
+func filterArgs(name, branch string) []driver.Value {
+	args := []driver.Value{collegeID, seasonID}
+
+	if name != "" {
+		args = append(args, name)
+	}
+
+	if branch != "" {
+		args = append(args, branch)
+	}
+
+	return args
+}
+
 func TestGetWithCompany(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
@@ -35,49 +71,49 @@ func TestGetWithCompany(t *testing.T) {
 		mockErr     error
 		expErr      error
 	}{
-		{"Success case: All entries are present", "Monika", "ECE", getDataWithCompQuery + " where s.student_name='Monika' AND s.branch='ECE'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}).
-				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED"),
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+		{"Success case: All entries are present", "Monika", "ECE", getDataWithCompQuery + " AND s.student_name=? AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}).
+				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED", nil, ""),
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 				Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil, nil,
 		},
-		{"Error case: when only name is present as query param", "Monika", "", getDataWithCompQuery + " where s.student_name='Monika'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}).
-				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED"),
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+		{"Error case: when only name is present as query param", "Monika", "", getDataWithCompQuery + " AND s.student_name=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}).
+				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED", nil, ""),
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 				Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil, nil,
 		},
-		{"Error case: when only branch is present as query param", "", "ECE", getDataWithCompQuery + " where s.branch='ECE'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}).
-				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED"),
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+		{"Error case: when only branch is present as query param", "", "ECE", getDataWithCompQuery + " AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}).
+				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED", nil, ""),
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 				Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil, nil,
 		},
 		{"Error case: when no query param is present", "", "", getDataWithCompQuery,
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}).
-				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED"),
-			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}).
+				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED", nil, ""),
+			[]entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
 				Branch: "ECE", Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil, nil,
 		},
-		{"Error case", "Monika", "E", getDataWithCompQuery + " where s.student_name='Monika' AND s.branch='E'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}),
+		{"Error case", "Monika", "E", getDataWithCompQuery + " AND s.student_name=? AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}),
 			[]entities.Student{}, nil, errors2.DB{Reason: "no rows found"},
 		},
-		{"Error case", "Monika", "ECE", getDataWithCompQuery + " where s.student_name='Monika' AND s.branch='ECE'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}).
-				AddRow(id, nil, "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED"),
+		{"Error case", "Monika", "ECE", getDataWithCompQuery + " AND s.student_name=? AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}).
+				AddRow(id, nil, "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED", nil, ""),
 			[]entities.Student{}, nil, errors2.DB{Reason: "scan error"},
 		},
-		{"failure case", "Monika", "ECE", getDataWithCompQuery + " where s.student_name='Monika' AND s.branch='E'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}),
+		{"failure case", "Monika", "ECE", getDataWithCompQuery + " AND s.student_name=? AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}),
 			[]entities.Student{}, errors.New("server error"), errors2.DB{Reason: "server error"},
 		},
 	}
 	for i, tc := range tests {
-		mock.ExpectQuery(tc.queryR).WillReturnRows(tc.rows).WillReturnError(tc.mockErr).WillReturnError(tc.mockErr)
+		mock.ExpectQuery(tc.queryR).WithArgs(filterArgs(tc.inputName, tc.inputBranch)...).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
 
 		store := New(db)
-		ctx := context.TODO()
+		ctx := scoped()
 		output, err := store.GetWithCompany(ctx, tc.inputName, tc.inputBranch)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
@@ -104,45 +140,49 @@ func TestGet(t *testing.T) {
 		mockErr     error
 		expErr      error
 	}{
-		{"Success case: All entries are present", "Aditi", "ECE", getDataQuery + " where s.student_name='Aditi' AND s.branch='ECE'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status"}).
-				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "ACCEPTED"),
-			[]entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: "02/03/2000", Branch: "ECE", Status: "ACCEPTED"}}, nil, nil,
+		{"Success case: All entries are present", "Aditi", "ECE", getDataQuery + " AND s.student_name=? AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}).
+				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "ACCEPTED", nil, ""),
+			[]entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: entities.NewDate(2000, 3, 2), Branch: "ECE", Status: "ACCEPTED"}}, nil, nil,
 		},
-		{"Error case: when only name is present as query param", "Aditi", "", getDataQuery + " where s.student_name='Aditi'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status"}).
-				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "ACCEPTED"),
-			[]entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: "02/03/2000", Branch: "ECE", Status: "ACCEPTED"}}, nil, nil,
+		{"Error case: when only name is present as query param", "Aditi", "", getDataQuery + " AND s.student_name=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}).
+				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "ACCEPTED", nil, ""),
+			[]entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: entities.NewDate(2000, 3, 2), Branch: "ECE", Status: "ACCEPTED"}}, nil, nil,
 		},
-		{"Error case: when only branch is present as query param", "", "ECE", getDataQuery + " where s.branch='ECE'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status"}).
-				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "ACCEPTED"),
-			[]entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: "02/03/2000", Branch: "ECE", Status: "ACCEPTED"}}, nil, nil,
+		{"Error case: when only branch is present as query param", "", "ECE", getDataQuery + " AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}).
+				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "ACCEPTED", nil, ""),
+			[]entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: entities.NewDate(2000, 3, 2), Branch: "ECE", Status: "ACCEPTED"}}, nil, nil,
+		},
+		{"Success case: filters are passed as arguments", "x' OR '1'='1", "", getDataQuery + " AND s.student_name=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}), []entities.Student{},
+			nil, errors2.DB{Reason: "no rows found"},
 		},
 		{"Error case: when no query params present", "", "", getDataQuery,
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status"}).
-				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "ACCEPTED"),
-			[]entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: "02/03/2000", Branch: "ECE", Status: "ACCEPTED"}}, nil, nil,
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}).
+				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "ACCEPTED", nil, ""),
+			[]entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: entities.NewDate(2000, 3, 2), Branch: "ECE", Status: "ACCEPTED"}}, nil, nil,
 		},
-		{"Error case", "Aditi", "E", getDataQuery + " where s.student_name='Aditi' AND s.branch='E'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status"}), []entities.Student{},
+		{"Error case", "Aditi", "E", getDataQuery + " AND s.student_name=? AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}), []entities.Student{},
 			nil, errors2.DB{Reason: "no rows found"},
 		},
-		{"Error case", "Monika", "ECE", getDataQuery + " where s.student_name='Monika' AND s.branch='ECE'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status"}).
-				AddRow(id, nil, "6388768118", "02/07/2000", "ECE", "ACCEPTED"), []entities.Student{}, nil, errors2.DB{Reason: "scan error"},
+		{"Error case", "Monika", "ECE", getDataQuery + " AND s.student_name=? AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}).
+				AddRow(id, nil, "6388768118", "02/07/2000", "ECE", "ACCEPTED", nil, ""), []entities.Student{}, nil, errors2.DB{Reason: "scan error"},
 		},
-		{"Error case", "Aditi", "E", getDataQuery + " where s.student_name='Aditi' AND s.branch='E'",
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status"}), []entities.Student{},
+		{"Error case", "Aditi", "E", getDataQuery + " AND s.student_name=? AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}), []entities.Student{},
 			errors.New("server error"), errors2.DB{Reason: "server error"},
 		},
 	}
 
 	for i, tc := range tests {
-		mock.ExpectQuery(tc.queryR).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
+		mock.ExpectQuery(tc.queryR).WithArgs(filterArgs(tc.inputName, tc.inputBranch)...).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
 
 		store := New(db)
-		ctx := context.TODO()
+		ctx := scoped()
 		output, err := store.Get(ctx, tc.inputName, tc.inputBranch)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
@@ -169,26 +209,34 @@ func TestGetByID(t *testing.T) {
 		expErr      error
 	}{
 		{"Success case: for valid id", id,
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}).
-				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED"),
-			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}).
+				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED", nil, ""),
+			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
 				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}, nil, nil,
 		},
+		{"Success case: with academic profile", id,
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}).
+				AddRow(id, "Monika Jaiswal", "6388768118", "2000-07-02", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED",
+					[]byte(`{"cgpa":8.1,"activeBacklogs":0,"graduationYear":2023}`), ""),
+			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED",
+				Academic: &entities.AcademicProfile{CGPA: 8.1, GraduationYear: 2023}}, nil, nil,
+		},
 		{"Error case : when id is not present in db", id,
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}),
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}),
 			entities.Student{}, sql.ErrNoRows, errors2.EntityNotFound{Reason: "id not found"},
 		},
 		{"Error case: server error", id,
-			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status"}),
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}),
 			entities.Student{}, errors.New("server error"), errors2.DB{Reason: "server error"},
 		},
 	}
 	for i, tc := range tests {
 		mock.ExpectQuery(getByIDQuery).
-			WithArgs(tc.inputID).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
+			WithArgs(tc.inputID, collegeID, seasonID).WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
 
 		store := New(db)
-		ctx := context.TODO()
+		ctx := scoped()
 		output, err := store.GetByID(ctx, tc.inputID)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
@@ -202,43 +250,219 @@ func TestCreate(t *testing.T) {
 	}
 	defer db.Close()
 
+	cmpID := uuid.New()
+	input := entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2),
+		Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}
+
+	insert := func() *sqlmock.ExpectedExec {
+		return mock.ExpectExec(postQuery).
+			WithArgs(sqlmock.AnyArg(), input.Name, input.Phone, input.DOB, input.Branch,
+				input.Status, input.Comp.ID, input.Academic, collegeID, seasonID, input.Email)
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: student and event are stored", func() {
+			mock.ExpectBegin()
+			insert().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventsQuery(1)).WithArgs(sqlmock.AnyArg(), collegeID, entities.StudentCreated, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: server error", func() {
+			mock.ExpectBegin()
+			insert().WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: phone already registered", func() {
+			mock.ExpectBegin()
+			insert().WillReturnError(&mysql.MySQLError{Number: 1062,
+				Message: "Duplicate entry '" + collegeID.String() + "-6388768118' for key 'students.uq_students_phone'"})
+			mock.ExpectRollback()
+		}, errors2.Conflict{Reason: "phone number already registered"}},
+		{"Error case: another unique key", func() {
+			mock.ExpectBegin()
+			insert().WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '" + input.ID.String() +
+				"' for key 'students.PRIMARY'"})
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: event is not recorded", func() {
+			mock.ExpectBegin()
+			insert().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventsQuery(1)).WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		st := input
+		output, err := New(db).Create(scoped(), &st)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			exp := input
+			exp.ID = output.ID
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+			assert.Equal(t, exp, output, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
 	id := uuid.New()
 	cmpID := uuid.New()
+	stopErr := errors.New("client went away")
 
 	tests := []struct {
 		description string
-		input       entities.Student
-		res         driver.Result
-		expRes      entities.Student
+		withCompany bool
+		queryR      string
+		rows        *sqlmock.Rows
+		fnErr       error
 		mockErr     error
+		expRes      []entities.Student
 		expErr      error
 	}{
-		{"Success case: All entries are present", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
-			Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, sqlmock.NewResult(1, 1),
-			entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
-				Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, nil, nil,
+		{"Success case: with company", true, getDataWithCompQuery + " AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}).
+				AddRow(id, "Monika Jaiswal", "6388768118", "02/07/2000", "ECE", cmpID, "Wipro", "MASS", "ACCEPTED", nil, ""),
+			nil, nil, []entities.Student{{ID: id, Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+				Comp: entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, Status: "ACCEPTED"}}, nil,
 		},
-		{"Error case: server error", entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: "02/07/2000", Branch: "ECE",
-			Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, sqlmock.NewResult(0, 0),
-			entities.Student{}, errors.New("server error"), errors2.DB{Reason: "server error"},
+		{"Success case: without company", false, getDataQuery + " AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}).
+				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "PENDING", nil, ""),
+			nil, nil, []entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: entities.NewDate(2000, 3, 2), Branch: "ECE",
+				Status: "PENDING"}}, nil,
+		},
+		{"Success case: no rows is not an error", false, getDataQuery + " AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}), nil, nil, nil, nil,
+		},
+		{"Error case: callback error stops the iteration", false, getDataQuery + " AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}).
+				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "PENDING", nil, "").
+				AddRow(id, "Aditi", "6388768119", "02/03/2000", "ECE", "PENDING", nil, ""),
+			stopErr, nil, []entities.Student{{ID: id, Name: "Aditi", Phone: "6388768119", DOB: entities.NewDate(2000, 3, 2), Branch: "ECE",
+				Status: "PENDING"}}, stopErr,
+		},
+		{"Error case: scan error", false, getDataQuery + " AND s.branch=?",
+			sqlmock.NewRows([]string{"ID", "Name", "Phone", "dob", "branch", "status", "academic", "email"}).
+				AddRow(id, nil, "6388768119", "02/03/2000", "ECE", "PENDING", nil, ""),
+			nil, nil, nil, errors2.DB{Reason: "scan error"},
 		},
+		{"Error case: server error", true, getDataWithCompQuery + " AND s.branch=?",
+			sqlmock.NewRows([]string{"ID"}), nil, errors.New("server error"), nil, errors2.DB{Reason: "server error"},
+		},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectQuery(tc.queryR).WithArgs(collegeID, seasonID, "ECE").WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
+
+		var output []entities.Student
+
+		store := New(db)
+		err := store.Stream(scoped(), "", "ECE", tc.withCompany, func(st entities.Student) error {
+			output = append(output, st)
+			return tc.fnErr
+		})
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreateBatch(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	cmpID := uuid.New()
+	stu := entities.Student{Name: "Monika Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+		Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}
+
+	students := func(n int) []*entities.Student {
+		res := make([]*entities.Student, n)
+		for i := range res {
+			st := stu
+			res[i] = &st
+		}
+
+		return res
+	}
+
+	query := func(n int) string {
+		return fmt.Sprintf(batchPostQuery, strings.TrimSuffix(strings.Repeat(insertPlaceholder+",", n), ","))
+	}
+
+	tests := []struct {
+		description string
+		input       []*entities.Student
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: single batch", students(2), func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(query(2)).WillReturnResult(sqlmock.NewResult(2, 2))
+			mock.ExpectExec(writeEventsQuery(2)).WillReturnResult(sqlmock.NewResult(2, 2))
+			mock.ExpectCommit()
+		}, nil},
+		{"Success case: rows are split into batches", students(batchSize + 1), func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(query(batchSize)).WillReturnResult(sqlmock.NewResult(batchSize, batchSize))
+			mock.ExpectExec(query(1)).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventsQuery(batchSize)).WillReturnResult(sqlmock.NewResult(batchSize, batchSize))
+			mock.ExpectExec(writeEventsQuery(1)).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: insert fails and the transaction is rolled back", students(1), func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(query(1)).WillReturnError(errors.New("duplicate entry"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: begin fails", students(1), func() {
+			mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: events are not recorded", students(1), func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(query(1)).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventsQuery(1)).WillReturnError(errors.New("connection reset"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: commit fails", students(1), func() {
+			mock.ExpectBegin()
+			mock.ExpectExec(query(1)).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventsQuery(1)).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit().WillReturnError(errors.New("connection reset"))
+		}, errors2.DB{Reason: "server error"}},
 	}
+
 	for i, tc := range tests {
-		mock.ExpectExec(postQuery).
-			WithArgs(sqlmock.AnyArg(), tc.input.Name, tc.input.Phone, tc.input.DOB, tc.input.Branch,
-				tc.input.Status, tc.input.Comp.ID).WillReturnResult(tc.res).WillReturnError(tc.mockErr)
+		tc.mock()
 
 		store := New(db)
-		ctx := context.TODO()
-		output, err := store.Create(ctx, &tc.input)
-
-		assert.Equal(t, tc.expRes.Name, output.Name, "Test[%d] failed\n(%s)", i, tc.description)
-		assert.Equal(t, tc.expRes.Phone, output.Phone, "Test[%d] failed\n(%s)", i, tc.description)
-		assert.Equal(t, tc.expRes.Branch, output.Branch, "Test[%d] failed\n(%s)", i, tc.description)
-		assert.Equal(t, tc.expRes.DOB, output.DOB, "Test[%d] failed\n(%s)", i, tc.description)
-		assert.Equal(t, tc.expRes.Comp, output.Comp, "Test[%d] failed\n(%s)", i, tc.description)
-		assert.Equal(t, tc.expRes.Status, output.Status, "Test[%d] failed\n(%s)", i, tc.description)
+		err := store.CreateBatch(scoped(), tc.input)
+
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			assert.NotEqual(t, uuid.Nil, tc.input[0].ID, "Test[%d] failed\n(%s)", i, tc.description)
+		}
 	}
 }
 
@@ -251,46 +475,200 @@ func TestUpdate(t *testing.T) {
 
 	id := uuid.New()
 	cmpID := uuid.New()
+	input := entities.Student{Name: "Aditi Jaiswal", Phone: "6388768118", DOB: entities.NewDate(2000, 7, 2), Branch: "ECE",
+		Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}
+
+	lock := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(lockStatusQuery).WithArgs(id, collegeID, seasonID)
+	}
+	update := func() *sqlmock.ExpectedExec {
+		return mock.ExpectExec(updateQuery).WithArgs(input.Name, input.Phone, input.DOB, input.Branch,
+			input.Comp.ID, input.Status, input.Academic, input.Email, id, collegeID, seasonID)
+	}
 
 	tests := []struct {
 		description string
-		inputID     uuid.UUID
-		input       entities.Student
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: status change is recorded", func() {
+			mock.ExpectBegin()
+			lock().WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("PENDING"))
+			update().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectExec(writeEventsQuery(1)).WithArgs(sqlmock.AnyArg(), collegeID, entities.StudentStatusChanged, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Success case: unchanged status records no event", func() {
+			mock.ExpectBegin()
+			lock().WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("ACCEPTED"))
+			update().WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: when id is valid but it doesn't exist in db", func() {
+			mock.ExpectBegin()
+			lock().WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "id not found"}},
+		{"Error case: student of another season, such as an archived one, is not updated", func() {
+			mock.ExpectBegin()
+			lock().WillReturnRows(sqlmock.NewRows([]string{"status"}))
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "id not found"}},
+		{"Error case: when company id is foreign key", func() {
+			mock.ExpectBegin()
+			lock().WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("PENDING"))
+			update().WillReturnError(errors.New("this id is used as a foreign key"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "this id is used as a foreign key"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		st := input
+		output, err := New(db).Update(scoped(), id, &st)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.expErr == nil {
+			exp := input
+			exp.ID = id
+			assert.Equal(t, exp, output, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestPatch(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	cmpID := uuid.New()
+
+	tests := []struct {
+		description string
+		input       map[string]interface{}
+		mockTimes   int
+		query       string
+		args        []driver.Value
 		res         driver.Result
-		expRes      entities.Student
 		mockErr     error
 		expErr      error
 	}{
-		{"Success case: for valid id", id, entities.Student{ID: id, Name: "Aditi Jaiswal", Phone: "6388768118",
-			DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, sqlmock.NewResult(1, 1),
-			entities.Student{ID: id, Name: "Aditi Jaiswal", Phone: "6388768118", DOB: "02/07/2000",
-				Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, nil, nil,
-		},
-		{"Error case: when id is valid but it doesn't exist in db", id, entities.Student{Name: "Aditi Jaiswal", Phone: "6388768118",
-			DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"}, sqlmock.NewResult(0, 0),
-			entities.Student{}, nil, errors2.EntityNotFound{Reason: "id not found"},
-		},
-		{"Error case: when company id is foreign key", id, entities.Student{Name: "Aditi Jaiswal", Phone: "6388768118",
-			DOB: "02/07/2000", Branch: "ECE", Comp: entities.Company{ID: cmpID}, Status: "ACCEPTED"},
-			sqlmock.NewResult(0, 0), entities.Student{}, errors.New("this id is used as a foreign key"),
-			errors2.DB{Reason: "this id is used as a foreign key"},
+		{"Success case: only the changed column is written", map[string]interface{}{"name": "Aditi J"}, 1,
+			"UPDATE students SET student_name=? WHERE student_id=? AND college_id=? AND season_id=?",
+			[]driver.Value{"Aditi J", id, collegeID, seasonID},
+			sqlmock.NewResult(1, 1), nil, nil,
+		},
+		{"Success case: columns are written in a stable order",
+			map[string]interface{}{"phone": "6388768118", "comp": cmpID, "name": "Aditi Jaiswal"}, 1,
+			"UPDATE students SET company_id=?,student_name=?,student_phone=? WHERE student_id=? AND college_id=? AND season_id=?",
+			[]driver.Value{cmpID, "Aditi Jaiswal", "6388768118", id, collegeID, seasonID}, sqlmock.NewResult(1, 1), nil, nil,
+		},
+		{"Error case: when id is valid but it doesn't exist in db", map[string]interface{}{"phone": "6388768118"}, 1,
+			"UPDATE students SET student_phone=? WHERE student_id=? AND college_id=? AND season_id=?",
+			[]driver.Value{"6388768118", id, collegeID, seasonID},
+			sqlmock.NewResult(0, 0), nil, errors2.EntityNotFound{Reason: "id not found"},
+		},
+		{"Error case: server error", map[string]interface{}{"branch": entities.CSE}, 1,
+			"UPDATE students SET branch=? WHERE student_id=? AND college_id=? AND season_id=?",
+			[]driver.Value{entities.CSE, id, collegeID, seasonID},
+			sqlmock.NewResult(0, 0), errors.New("server error"), errors2.DB{Reason: "server error"},
+		},
+		{"Error case: unknown field", map[string]interface{}{"age": 23}, 0, "", nil, nil, nil,
+			errors2.InvalidParam{Param: "age"},
+		},
+		{"Error case: no fields", map[string]interface{}{}, 0, "", nil, nil, nil,
+			errors2.MissingParam{Param: []string{"fields"}},
 		},
 	}
 	for i, tc := range tests {
-		mock.ExpectExec(updateQuery).
-			WithArgs(tc.input.Name, tc.input.Phone, tc.input.DOB, tc.input.Branch,
-				tc.input.Comp.ID, tc.input.Status, tc.inputID).
-			WillReturnResult(tc.res).WillReturnError(tc.mockErr)
+		if tc.mockTimes != 0 {
+			mock.ExpectExec(tc.query).WithArgs(tc.args...).WillReturnResult(tc.res).WillReturnError(tc.mockErr)
+		}
 
 		store := New(db)
-		ctx := context.TODO()
-		output, err := store.Update(ctx, tc.inputID, &tc.input)
+		ctx := scoped()
+		err := store.Patch(ctx, id, tc.input)
 
-		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
 	}
 }
 
+func TestPatchStatus(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	cmpID := uuid.New()
+	columns := []string{"ID", "Name", "Phone", "dob", "branch", "ID", "Name", "category", "status", "academic", "email"}
+	patched := func() *sqlmock.Rows {
+		return sqlmock.NewRows(columns).
+			AddRow(id, "Aditi Jaiswal", "6388768118", "02/07/2000", "CSE", cmpID, "Wipro", "MASS", "ACCEPTED", nil, "")
+	}
+
+	lock := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(lockStatusQuery).WithArgs(id, collegeID, seasonID)
+	}
+	patch := func() *sqlmock.ExpectedExec {
+		return mock.ExpectExec("UPDATE students SET company_id=?,status=? WHERE student_id=? AND college_id=? AND season_id=?").
+			WithArgs(cmpID, entities.ACCEPTED, id, collegeID, seasonID)
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: status change is recorded with the patched student", func() {
+			mock.ExpectBegin()
+			lock().WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("PENDING"))
+			patch().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(getByIDQuery).WithArgs(id, collegeID, seasonID).WillReturnRows(patched())
+			mock.ExpectExec(writeEventsQuery(1)).WithArgs(sqlmock.AnyArg(), collegeID, entities.StudentStatusChanged, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Success case: unchanged status records no event", func() {
+			mock.ExpectBegin()
+			lock().WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("ACCEPTED"))
+			patch().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(getByIDQuery).WithArgs(id, collegeID, seasonID).WillReturnRows(patched())
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: when id is valid but it doesn't exist in db", func() {
+			mock.ExpectBegin()
+			lock().WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "id not found"}},
+		{"Error case: event is not recorded", func() {
+			mock.ExpectBegin()
+			lock().WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("PENDING"))
+			patch().WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery(getByIDQuery).WithArgs(id, collegeID, seasonID).WillReturnRows(patched())
+			mock.ExpectExec(writeEventsQuery(1)).WillReturnError(errors.New("server error"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		err := New(db).Patch(scoped(), id, map[string]interface{}{"status": entities.ACCEPTED, "comp": cmpID})
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
 func TestDelete(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	if err != nil {
@@ -316,10 +694,10 @@ func TestDelete(t *testing.T) {
 		},
 	}
 	for i, tc := range tests {
-		mock.ExpectExec(deleteQuery).WithArgs(tc.inputID).WillReturnResult(tc.res).WillReturnError(tc.sqlErr)
+		mock.ExpectExec(deleteQuery).WithArgs(tc.inputID, collegeID, seasonID).WillReturnResult(tc.res).WillReturnError(tc.sqlErr)
 
 		store := New(db)
-		ctx := context.TODO()
+		ctx := scoped()
 		err := store.Delete(ctx, tc.inputID)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
@@ -344,26 +722,158 @@ func TestGetCompanyByID(t *testing.T) {
 		mockErr     error
 		expErr      error
 	}{
-		{"Success case: for valid id", cmpID, sqlmock.NewRows([]string{"ID", "Name", "category"}).AddRow(cmpID, "Wipro", "MASS"),
+		{"Success case: for valid id", cmpID, sqlmock.NewRows([]string{"ID", "Name", "category", "eligibility"}).AddRow(cmpID, "Wipro", "MASS", nil),
 			entities.Company{ID: cmpID, Name: "Wipro", Category: "MASS"}, nil, nil,
 		},
-		{"Error case: when id is not present in db", id, sqlmock.NewRows([]string{"ID", "Name", "category"}),
+		{"Error case: when id is not present in db", id, sqlmock.NewRows([]string{"ID", "Name", "category", "eligibility"}),
 			entities.Company{}, nil, errors2.EntityNotFound{Reason: "id not found"},
 		},
-		{"Error case: server error", cmpID, sqlmock.NewRows([]string{"ID", "Name", "category"}).AddRow(cmpID, "Wipro", "MASS"),
+		{"Error case: server error", cmpID, sqlmock.NewRows([]string{"ID", "Name", "category", "eligibility"}).AddRow(cmpID, "Wipro", "MASS", nil),
 			entities.Company{}, errors.New("server error"), errors2.DB{Reason: "server error"},
 		},
 	}
 
 	for i, tc := range tests {
-		mock.ExpectQuery(getCompanyQuery).WithArgs(tc.inputID).
+		mock.ExpectQuery(getCompanyQuery).WithArgs(tc.inputID, collegeID, seasonID).
 			WillReturnRows(tc.rows).WillReturnError(tc.mockErr)
 
 		store := New(db)
-		ctx := context.TODO()
+		ctx := scoped()
 		output, err := store.GetCompanyByID(ctx, tc.inputID)
 
 		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
 		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
 	}
 }
+
+func TestFindDuplicate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	existingID := uuid.New()
+	stu := entities.Student{ID: id, Name: "Monika Jaiswal", Phone: "+916388768118", DOB: entities.NewDate(2000, 7, 2)}
+
+	tests := []struct {
+		description string
+		key         entities.DuplicateKey
+		mock        func()
+		expRes      uuid.UUID
+		expErr      error
+	}{
+		{"Success case: phone matches", entities.PhoneKey, func() {
+			mock.ExpectQuery(findByPhoneQuery).WithArgs(stu.Phone, id, collegeID).
+				WillReturnRows(sqlmock.NewRows([]string{"student_id"}).AddRow(existingID))
+		}, existingID, nil},
+		{"Success case: name and dob match", entities.NameDOBKey, func() {
+			mock.ExpectQuery(findByNameDOBQuery).WithArgs(stu.Name, stu.DOB, id, collegeID).
+				WillReturnRows(sqlmock.NewRows([]string{"student_id"}).AddRow(existingID))
+		}, existingID, nil},
+		{"Success case: no match", entities.PhoneKey, func() {
+			mock.ExpectQuery(findByPhoneQuery).WithArgs(stu.Phone, id, collegeID).WillReturnError(sql.ErrNoRows)
+		}, uuid.Nil, nil},
+		{"Error case: server error", entities.PhoneKey, func() {
+			mock.ExpectQuery(findByPhoneQuery).WithArgs(stu.Phone, id, collegeID).WillReturnError(errors.New("connection refused"))
+		}, uuid.Nil, errors2.DB{Reason: "server error"}},
+		{"Error case: unknown key", "email", func() {}, uuid.Nil, errors2.InvalidParam{Param: "duplicate key email"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).FindDuplicate(scoped(), &stu, tc.key)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Errorf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	keep := entities.Student{ID: uuid.New(), Comp: entities.Company{ID: uuid.New()}, Status: "ACCEPTED"}
+	duplicateID := uuid.New()
+
+	lock := func(status string) {
+		mock.ExpectQuery(lockStatusQuery).WithArgs(keep.ID, collegeID, seasonID).WillReturnRows(sqlmock.NewRows([]string{"status"}).
+			AddRow(status))
+	}
+	moved := func() {
+		mock.ExpectExec(mergeQuery).WithArgs(keep.Comp.ID, keep.Status, keep.ID, collegeID, seasonID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(moveRegistrationsQuery).WithArgs(keep.ID, duplicateID).WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(moveResultsQuery).WithArgs(keep.ID, duplicateID).WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(moveOffersQuery).WithArgs(keep.ID, duplicateID).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(moveDocumentsQuery).WithArgs(keep.ID, duplicateID).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expErr      error
+	}{
+		{"Success case: company link updated, rows moved, duplicate deleted and status change recorded", func() {
+			mock.ExpectBegin()
+			lock("PENDING")
+			moved()
+			mock.ExpectExec(deleteQuery).WithArgs(duplicateID, collegeID, seasonID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(writeEventsQuery(1)).WithArgs(sqlmock.AnyArg(), collegeID, entities.StudentStatusChanged, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Success case: unchanged status records no event", func() {
+			mock.ExpectBegin()
+			lock("ACCEPTED")
+			moved()
+			mock.ExpectExec(deleteQuery).WithArgs(duplicateID, collegeID, seasonID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+		}, nil},
+		{"Error case: duplicate already deleted", func() {
+			mock.ExpectBegin()
+			lock("PENDING")
+			moved()
+			mock.ExpectExec(deleteQuery).WithArgs(duplicateID, collegeID, seasonID).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "id not found"}},
+		{"Error case: kept student not found", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockStatusQuery).WithArgs(keep.ID, collegeID, seasonID).WillReturnError(sql.ErrNoRows)
+			mock.ExpectRollback()
+		}, errors2.EntityNotFound{Reason: "id not found"}},
+		{"Error case: event not recorded and the transaction is rolled back", func() {
+			mock.ExpectBegin()
+			lock("PENDING")
+			moved()
+			mock.ExpectExec(deleteQuery).WithArgs(duplicateID, collegeID, seasonID).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(writeEventsQuery(1)).WithArgs(sqlmock.AnyArg(), collegeID, entities.StudentStatusChanged, sqlmock.AnyArg(),
+				sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: update fails and the transaction is rolled back", func() {
+			mock.ExpectBegin()
+			lock("PENDING")
+			mock.ExpectExec(mergeQuery).WithArgs(keep.Comp.ID, keep.Status, keep.ID, collegeID, seasonID).
+				WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+		}, errors2.DB{Reason: "server error"}},
+		{"Error case: begin fails", func() {
+			mock.ExpectBegin().WillReturnError(errors.New("connection refused"))
+		}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		err := New(db).Merge(scoped(), &keep, duplicateID)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
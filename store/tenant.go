@@ -0,0 +1,44 @@
+package store
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/errors"
+)
+
+// College returns the college ctx is scoped to. Every query on college data is filtered by it, and
+// without one the query is not run at all, so a request that lost its scope gets an error instead
+// of another college's rows.
+func College(ctx context.Context) (uuid.UUID, error) {
+	id, ok := auth.CollegeFrom(ctx)
+	if !ok {
+		return uuid.Nil, errors.MissingParam{Param: []string{"college"}}
+	}
+
+	return id, nil
+}
+
+// Season returns the placement season ctx is scoped to. Lists are filtered by it and new students,
+// drives and company participations are recorded in it.
+func Season(ctx context.Context) (uuid.UUID, error) {
+	id, ok := auth.SeasonFrom(ctx)
+	if !ok {
+		return uuid.Nil, errors.MissingParam{Param: []string{"season"}}
+	}
+
+	return id, nil
+}
+
+// Principal returns the client ctx was authenticated as, such as "college:<id>" or "recruiter:<id>".
+// Records kept for a single client, like idempotency keys, are filtered by it as well as the college.
+func Principal(ctx context.Context) (string, error) {
+	p, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return "", errors.MissingParam{Param: []string{"principal"}}
+	}
+
+	return p, nil
+}
@@ -0,0 +1,29 @@
+package webhook
+
+const (
+	selectQuery = "SELECT webhook_id,url,events,created_at from webhooks where college_id=?"
+	getQuery    = selectQuery + " ORDER BY created_at"
+	// getByEventQuery matches the webhooks subscribed to an event type in the comma separated events.
+	getByEventQuery = selectQuery + " and FIND_IN_SET(?,events)"
+	getByIDQuery    = selectQuery + " and webhook_id=?"
+	postQuery       = "INSERT INTO webhooks values (?,?,?,?,?,?)"
+	deleteQuery     = "DELETE FROM webhooks WHERE webhook_id=? AND college_id=?"
+
+	deliverySelect = "SELECT d.delivery_id,d.webhook_id,d.event_id,d.event_type,d.payload,d.status,d.attempts,d.response_status," +
+		"d.last_error,d.next_attempt_at,d.created_at,d.delivered_at"
+	getDeliveriesQuery = deliverySelect + " from webhook_deliveries d join webhooks w on w.webhook_id=d.webhook_id " +
+		"where w.college_id=? and d.webhook_id=? ORDER BY d.created_at DESC"
+	getDeliveryQuery = deliverySelect + " from webhook_deliveries d join webhooks w on w.webhook_id=d.webhook_id " +
+		"where w.college_id=? and d.webhook_id=? and d.delivery_id=?"
+	// dueQuery is not scoped to a college: the dispatcher delivers the events of every college. Rows
+	// another dispatcher is claiming are skipped rather than waited for.
+	dueQuery = deliverySelect + ",w.url,w.secret from webhook_deliveries d join webhooks w on w.webhook_id=d.webhook_id " +
+		"where d.status=? and d.next_attempt_at<=? ORDER BY d.next_attempt_at LIMIT ? FOR UPDATE OF d SKIP LOCKED"
+	claimQuery             = "UPDATE webhook_deliveries SET next_attempt_at=? WHERE delivery_id=?"
+	batchPostDeliveryQuery = "INSERT INTO webhook_deliveries values %s"
+	updateDeliveryQuery    = "UPDATE webhook_deliveries SET status=?,attempts=?,response_status=?,last_error=?,next_attempt_at=?," +
+		"delivered_at=? WHERE delivery_id=?"
+
+	deliveryColumns     = 12
+	deliveryPlaceholder = "(?,?,?,?,?,?,?,?,?,?,?,?)"
+)
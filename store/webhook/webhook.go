@@ -0,0 +1,292 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	pkgstore "github.com/aditi-zs/Placement-API/store"
+)
+
+type store struct {
+	db *sql.DB
+}
+
+//nolint:revive // it's a factory function
+func New(d *sql.DB) store {
+	return store{db: d}
+}
+
+func (s store) Get(ctx context.Context) ([]entities.Webhook, error) {
+	return s.list(ctx, getQuery)
+}
+
+// GetByEvent returns the webhooks of the college subscribed to events of type t.
+func (s store) GetByEvent(ctx context.Context, t entities.EventType) ([]entities.Webhook, error) {
+	return s.list(ctx, getByEventQuery, t)
+}
+
+func (s store) list(ctx context.Context, query string, args ...interface{}) ([]entities.Webhook, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Webhook{}, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, append([]interface{}{college}, args...)...)
+	if err != nil {
+		return []entities.Webhook{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var webhooks []entities.Webhook
+
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return []entities.Webhook{}, errors.DB{Reason: "scan error"}
+		}
+
+		webhooks = append(webhooks, w)
+	}
+
+	return webhooks, nil
+}
+
+func (s store) GetByID(ctx context.Context, id uuid.UUID) (entities.Webhook, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Webhook{}, err
+	}
+
+	w, err := scanWebhook(s.db.QueryRowContext(ctx, getByIDQuery, college, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Webhook{}, errors.EntityNotFound{Reason: "id not found: " + id.String()}
+		}
+
+		return entities.Webhook{}, errors.DB{Reason: "server error"}
+	}
+
+	return w, nil
+}
+
+// Create stores w with its secret, which is kept as is since deliveries are signed with it.
+func (s store) Create(ctx context.Context, w *entities.Webhook) (entities.Webhook, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Webhook{}, err
+	}
+
+	w.ID = uuid.New()
+
+	_, err = s.db.ExecContext(ctx, postQuery, w.ID, college, w.URL, w.Secret, joinEvents(w.Events), w.CreatedAt)
+	if err != nil {
+		return entities.Webhook{}, errors.DB{Reason: "server error"}
+	}
+
+	return *w, nil
+}
+
+func (s store) Delete(ctx context.Context, id uuid.UUID) error {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.db.ExecContext(ctx, deleteQuery, id, college)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return errors.EntityNotFound{Reason: "id not found: " + id.String()}
+	}
+
+	return nil
+}
+
+// GetDeliveries returns the delivery history of a webhook of the college, the latest first.
+func (s store) GetDeliveries(ctx context.Context, webhookID uuid.UUID) ([]entities.Delivery, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return []entities.Delivery{}, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, getDeliveriesQuery, college, webhookID)
+	if err != nil {
+		return []entities.Delivery{}, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var deliveries []entities.Delivery
+
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return []entities.Delivery{}, errors.DB{Reason: "scan error"}
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
+
+func (s store) GetDelivery(ctx context.Context, webhookID, id uuid.UUID) (entities.Delivery, error) {
+	college, err := pkgstore.College(ctx)
+	if err != nil {
+		return entities.Delivery{}, err
+	}
+
+	d, err := scanDelivery(s.db.QueryRowContext(ctx, getDeliveryQuery, college, webhookID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return entities.Delivery{}, errors.EntityNotFound{Reason: "delivery not found: " + id.String()}
+		}
+
+		return entities.Delivery{}, errors.DB{Reason: "server error"}
+	}
+
+	return d, nil
+}
+
+// Enqueue stores new deliveries in one statement. Their webhooks are read in the college scope
+// beforehand, so the deliveries are not checked against it again.
+func (s store) Enqueue(ctx context.Context, deliveries []entities.Delivery) error {
+	placeholders := make([]string, len(deliveries))
+	args := make([]interface{}, 0, len(deliveries)*deliveryColumns)
+
+	for i := range deliveries {
+		d := &deliveries[i]
+		d.ID = uuid.New()
+		placeholders[i] = deliveryPlaceholder
+		args = append(args, d.ID, d.WebhookID, d.EventID, d.EventType, d.Payload, d.Status, d.Attempts, d.ResponseStatus,
+			d.LastError, d.NextAttemptAt, d.CreatedAt, d.DeliveredAt)
+	}
+
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(batchPostDeliveryQuery, strings.Join(placeholders, ",")), args...)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+// Claim returns up to limit pending deliveries of every college whose next attempt is due at now, with
+// the URL and secret of their webhook, and moves their next attempt to until so no other dispatcher
+// claims them while they are sent. A delivery whose outcome is never recorded, as when the dispatcher
+// stops, is claimed again once until has passed.
+func (s store) Claim(ctx context.Context, now, until time.Time, limit int) ([]entities.Delivery, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []entities.Delivery{}, errors.DB{Reason: "server error"}
+	}
+
+	deliveries, err := due(ctx, tx, now, limit)
+	if err != nil {
+		_ = tx.Rollback()
+
+		return []entities.Delivery{}, err
+	}
+
+	for i := range deliveries {
+		if _, err = tx.ExecContext(ctx, claimQuery, until, deliveries[i].ID); err != nil {
+			_ = tx.Rollback()
+
+			return []entities.Delivery{}, errors.DB{Reason: "server error"}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return []entities.Delivery{}, errors.DB{Reason: "server error"}
+	}
+
+	return deliveries, nil
+}
+
+// due returns up to limit pending deliveries due at now, locking their rows until tx ends.
+func due(ctx context.Context, tx *sql.Tx, now time.Time, limit int) ([]entities.Delivery, error) {
+	rows, err := tx.QueryContext(ctx, dueQuery, entities.DeliveryPending, now, limit)
+	if err != nil {
+		return nil, errors.DB{Reason: "server error"}
+	}
+
+	defer rows.Close()
+
+	var deliveries []entities.Delivery
+
+	for rows.Next() {
+		var d entities.Delivery
+
+		err = rows.Scan(&d.ID, &d.WebhookID, &d.EventID, &d.EventType, &d.Payload, &d.Status, &d.Attempts, &d.ResponseStatus,
+			&d.LastError, &d.NextAttemptAt, &d.CreatedAt, &d.DeliveredAt, &d.URL, &d.Secret)
+		if err != nil {
+			return nil, errors.DB{Reason: "scan error"}
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDelivery records the outcome of a delivery attempt on d.
+func (s store) UpdateDelivery(ctx context.Context, d *entities.Delivery) error {
+	_, err := s.db.ExecContext(ctx, updateDeliveryQuery, d.Status, d.Attempts, d.ResponseStatus, d.LastError, d.NextAttemptAt,
+		d.DeliveredAt, d.ID)
+	if err != nil {
+		return errors.DB{Reason: "server error"}
+	}
+
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanWebhook(row scanner) (entities.Webhook, error) {
+	var (
+		w      entities.Webhook
+		events string
+	)
+
+	if err := row.Scan(&w.ID, &w.URL, &events, &w.CreatedAt); err != nil {
+		return entities.Webhook{}, err
+	}
+
+	for _, e := range strings.Split(events, ",") {
+		w.Events = append(w.Events, entities.EventType(e))
+	}
+
+	return w, nil
+}
+
+func scanDelivery(row scanner) (entities.Delivery, error) {
+	var d entities.Delivery
+
+	err := row.Scan(&d.ID, &d.WebhookID, &d.EventID, &d.EventType, &d.Payload, &d.Status, &d.Attempts, &d.ResponseStatus,
+		&d.LastError, &d.NextAttemptAt, &d.CreatedAt, &d.DeliveredAt)
+	if err != nil {
+		return entities.Delivery{}, err
+	}
+
+	return d, nil
+}
+
+func joinEvents(events []entities.EventType) string {
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = string(e)
+	}
+
+	return strings.Join(names, ",")
+}
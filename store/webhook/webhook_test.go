@@ -0,0 +1,266 @@
+package webhook
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/auth"
+	"github.com/aditi-zs/Placement-API/entities"
+	errors2 "github.com/aditi-zs/Placement-API/errors"
+)
+
+// collegeID is the college the tests run in.
+//
+//nolint:gochecknoglobals // shared by the tests
+var collegeID = uuid.New()
+
+// scoped returns a context scoped to collegeID, as the college middleware sets it up.
+func scoped() context.Context {
+	return auth.WithCollege(context.TODO(), collegeID)
+}
+
+//nolint:gochecknoglobals // column names shared by the tests
+var (
+	webhookColumns      = []string{"webhook_id", "url", "events", "created_at"}
+	deliveryColumnNames = []string{"delivery_id", "webhook_id", "event_id", "event_type", "payload", "status", "attempts",
+		"response_status", "last_error", "next_attempt_at", "created_at", "delivered_at"}
+)
+
+func TestGetByEvent(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.Webhook
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getByEventQuery).WithArgs(collegeID, entities.StudentCreated).WillReturnRows(sqlmock.NewRows(webhookColumns).
+				AddRow(id, "https://erp.example.com/hooks", "student.created,company.created", created))
+		}, []entities.Webhook{{ID: id, URL: "https://erp.example.com/hooks",
+			Events: []entities.EventType{entities.StudentCreated, entities.CompanyCreated}, CreatedAt: created}}, nil},
+		{"Error case: query fails", func() {
+			mock.ExpectQuery(getByEventQuery).WithArgs(collegeID, entities.StudentCreated).WillReturnError(errors.New("connection refused"))
+		}, []entities.Webhook{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetByEvent(scoped(), entities.StudentCreated)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	created := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	input := entities.Webhook{URL: "https://erp.example.com/hooks", Secret: "s3cret",
+		Events: []entities.EventType{entities.StudentCreated, entities.StudentStatusChanged}, CreatedAt: created}
+
+	tests := []struct {
+		description string
+		mockErr     error
+		expErr      error
+	}{
+		{"Success case", nil, nil},
+		{"Error case: insert fails", errors.New("connection refused"), errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		exp := mock.ExpectExec(postQuery).WithArgs(sqlmock.AnyArg(), collegeID, input.URL, "s3cret",
+			"student.created,student.status_changed", created)
+		if tc.mockErr != nil {
+			exp.WillReturnError(tc.mockErr)
+		} else {
+			exp.WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+
+		w := input
+		output, err := New(db).Create(scoped(), &w)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if err == nil {
+			assert.NotEqual(t, uuid.Nil, output.ID, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	id := uuid.New()
+
+	tests := []struct {
+		description string
+		affected    int64
+		expErr      error
+	}{
+		{"Success case", 1, nil},
+		{"Error case: webhook of another college or missing", 0, errors2.EntityNotFound{Reason: "id not found: " + id.String()}},
+	}
+
+	for i, tc := range tests {
+		mock.ExpectExec(deleteQuery).WithArgs(id, collegeID).WillReturnResult(sqlmock.NewResult(0, tc.affected))
+
+		err := New(db).Delete(scoped(), id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestGetDelivery(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	webhookID, id, eventID := uuid.New(), uuid.New(), uuid.New()
+	at := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      entities.Delivery
+		expErr      error
+	}{
+		{"Success case", func() {
+			mock.ExpectQuery(getDeliveryQuery).WithArgs(collegeID, webhookID, id).WillReturnRows(sqlmock.NewRows(deliveryColumnNames).
+				AddRow(id, webhookID, eventID, "student.created", `{"type":"student.created"}`, "DELIVERED", 1, 200, "", at, at, at))
+		}, entities.Delivery{ID: id, WebhookID: webhookID, EventID: eventID, EventType: entities.StudentCreated,
+			Payload: `{"type":"student.created"}`, Status: entities.DeliveryDelivered, Attempts: 1, ResponseStatus: 200,
+			NextAttemptAt: at, CreatedAt: at, DeliveredAt: &at}, nil},
+		{"Error case: not found", func() {
+			mock.ExpectQuery(getDeliveryQuery).WithArgs(collegeID, webhookID, id).WillReturnRows(sqlmock.NewRows(deliveryColumnNames))
+		}, entities.Delivery{}, errors2.EntityNotFound{Reason: "delivery not found: " + id.String()}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).GetDelivery(scoped(), webhookID, id)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestEnqueue(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	webhookID, eventID := uuid.New(), uuid.New()
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	deliveries := []entities.Delivery{{WebhookID: webhookID, EventID: eventID, EventType: entities.CompanyCreated, Payload: "{}",
+		Status: entities.DeliveryPending, NextAttemptAt: now, CreatedAt: now}}
+	args := []driver.Value{sqlmock.AnyArg(), webhookID, eventID, entities.CompanyCreated, "{}", entities.DeliveryPending, 0, 0, "",
+		now, now, nil}
+
+	mock.ExpectExec("INSERT INTO webhook_deliveries values " + deliveryPlaceholder).WithArgs(args...).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = New(db).Enqueue(context.TODO(), deliveries)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, deliveries[0].ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaim(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	webhookID, id, eventID := uuid.New(), uuid.New(), uuid.New()
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	until := now.Add(10 * time.Minute)
+
+	due := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(dueQuery).WithArgs(entities.DeliveryPending, now, 10)
+	}
+	dueRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows(append(deliveryColumnNames, "url", "secret")).
+			AddRow(id, webhookID, eventID, "company.created", "{}", "PENDING", 1, 503, "503 Service Unavailable", now, now, nil,
+				"https://erp.example.com/hooks", "s3cret")
+	}
+
+	tests := []struct {
+		description string
+		mock        func()
+		expRes      []entities.Delivery
+		expErr      error
+	}{
+		{"Success case: due deliveries are claimed until the lease ends", func() {
+			mock.ExpectBegin()
+			due().WillReturnRows(dueRows())
+			mock.ExpectExec(claimQuery).WithArgs(until, id).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+		}, []entities.Delivery{{ID: id, WebhookID: webhookID, EventID: eventID, EventType: entities.CompanyCreated,
+			Payload: "{}", Status: entities.DeliveryPending, Attempts: 1, ResponseStatus: 503, LastError: "503 Service Unavailable",
+			NextAttemptAt: now, CreatedAt: now, URL: "https://erp.example.com/hooks", Secret: "s3cret"}}, nil},
+		{"Success case: nothing due", func() {
+			mock.ExpectBegin()
+			due().WillReturnRows(sqlmock.NewRows(append(deliveryColumnNames, "url", "secret")))
+			mock.ExpectCommit()
+		}, nil, nil},
+		{"Error case: claim fails and the transaction is rolled back", func() {
+			mock.ExpectBegin()
+			due().WillReturnRows(dueRows())
+			mock.ExpectExec(claimQuery).WithArgs(until, id).WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+		}, []entities.Delivery{}, errors2.DB{Reason: "server error"}},
+		{"Error case: query fails", func() {
+			mock.ExpectBegin()
+			due().WillReturnError(errors.New("connection refused"))
+			mock.ExpectRollback()
+		}, []entities.Delivery{}, errors2.DB{Reason: "server error"}},
+	}
+
+	for i, tc := range tests {
+		tc.mock()
+
+		output, err := New(db).Claim(context.TODO(), now, until, 10)
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.NoError(t, mock.ExpectationsWereMet(), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
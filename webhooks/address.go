@@ -0,0 +1,46 @@
+package webhooks
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"syscall"
+)
+
+// ErrNotPublic is returned when a webhook would be sent to an address that is not on the public
+// internet, such as the server itself or a host of its private network.
+//
+//nolint:gochecknoglobals // sentinel error
+var ErrNotPublic = errors.New("webhooks: address is not public")
+
+// sharedAddressSpace is the carrier-grade NAT range, private to the network of a provider.
+//
+//nolint:gochecknoglobals // fixed prefix
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublic reports whether webhooks may be sent to addr. Loopback, private, link-local, multicast
+// and unspecified addresses are refused, as are IPv4 addresses written as IPv6.
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// dialControl refuses connections to addresses allowed turns down. It runs once the host name has
+// been resolved, so a name resolving to an internal address is refused however it was checked
+// when the webhook was created.
+func dialControl(allowed func(netip.Addr) bool) func(network, address string, c syscall.RawConn) error {
+	return func(_, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+
+		addr, err := netip.ParseAddr(host)
+		if err != nil || !allowed(addr) {
+			return ErrNotPublic
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,34 @@
+package webhooks
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		description string
+		addr        string
+		exp         bool
+	}{
+		{"public IPv4", "93.184.216.34", true},
+		{"public IPv6", "2606:2800:220:1:248:1893:25c8:1946", true},
+		{"loopback", "127.0.0.1", false},
+		{"IPv6 loopback", "::1", false},
+		{"private", "10.1.2.3", false},
+		{"private 192.168", "192.168.0.10", false},
+		{"link-local metadata service", "169.254.169.254", false},
+		{"IPv6 unique local", "fd00::1", false},
+		{"IPv6 link-local", "fe80::1", false},
+		{"carrier-grade NAT", "100.64.0.1", false},
+		{"unspecified", "0.0.0.0", false},
+		{"multicast", "224.0.0.1", false},
+		{"IPv4-mapped loopback", "::ffff:127.0.0.1", false},
+	}
+
+	for i, tc := range tests {
+		assert.Equal(t, tc.exp, IsPublic(netip.MustParseAddr(tc.addr)), "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
@@ -0,0 +1,274 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+	maxBackoff     = 6 * time.Hour
+	maxErrorLen    = 1024
+	// maxDrain is how much of a response body is read so the connection can be reused.
+	maxDrain = 64 << 10
+)
+
+// DispatchConfig holds how often pending deliveries are polled and how failed ones are retried.
+type DispatchConfig struct {
+	// Interval is the time between two polls of the pending deliveries.
+	Interval time.Duration
+	// BatchSize is the most deliveries attempted in one poll.
+	BatchSize int
+	// Concurrency is the most deliveries sent to the same webhook URL at a time. Zero means one.
+	Concurrency int
+	// Lease is how long claimed deliveries are kept from other dispatchers. It must exceed the time a
+	// batch takes to send, or a slow batch may be claimed and sent again.
+	Lease time.Duration
+	// MaxAttempts is the number of attempts after which a delivery is given up as failed.
+	MaxAttempts int
+	// Backoff is the wait before the first retry. It doubles with every further attempt, up to six hours.
+	Backoff time.Duration
+}
+
+// Dispatcher POSTs pending deliveries to their webhooks. A delivery counts as delivered on any 2xx
+// response. Several dispatchers may share the database, as each claims the deliveries it sends. A
+// delivery may be sent again if recording its outcome fails, so receivers should expect an event
+// more than once.
+//
+// Deliveries only go to public addresses, checked when connecting, and redirects are not followed:
+// a redirect response counts as a failed delivery.
+type Dispatcher struct {
+	webhooks store.WebhookStore
+	client   *http.Client
+	cfg      DispatchConfig
+	// now is replaced in tests to control which deliveries are due.
+	now func() time.Time
+	// allowed is replaced in tests to deliver to local test servers.
+	allowed func(netip.Addr) bool
+}
+
+func NewDispatcher(webhooks store.WebhookStore, cfg DispatchConfig) *Dispatcher {
+	d := &Dispatcher{webhooks: webhooks, cfg: cfg, now: time.Now, allowed: IsPublic}
+
+	dialer := &net.Dialer{Timeout: requestTimeout, Control: dialControl(func(a netip.Addr) bool { return d.allowed(a) })}
+
+	// Deliveries are not sent through a proxy, which would be the only address checked.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	d.client = &http.Client{
+		Timeout:   requestTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	return d
+}
+
+// Run dispatches the due deliveries every Interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Dispatch(ctx); err != nil {
+			log.Printf("webhooks: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch claims the deliveries due now, attempts each once and records the outcome of every
+// attempt. Deliveries to different webhook URLs are sent in parallel, and at most Concurrency at a
+// time to the same URL. Once recording an outcome fails no further deliveries are sent; the ones
+// left are claimed again after the lease.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	now := d.now().UTC()
+
+	due, err := d.webhooks.Claim(ctx, now, now.Add(d.cfg.Lease), d.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	byURL := map[string][]*entities.Delivery{}
+	for i := range due {
+		byURL[due[i].URL] = append(byURL[due[i].URL], &due[i])
+	}
+
+	var (
+		wg   sync.WaitGroup
+		errs firstError
+	)
+
+	for _, deliveries := range byURL {
+		queue := make(chan *entities.Delivery, len(deliveries))
+		for _, del := range deliveries {
+			queue <- del
+		}
+
+		close(queue)
+
+		for w := 0; w < d.workers(len(deliveries)); w++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				d.send(ctx, queue, &errs)
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	return errs.get()
+}
+
+// workers returns how many deliveries of the n to the same URL are sent at a time.
+func (d *Dispatcher) workers(n int) int {
+	workers := d.cfg.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+
+	if workers > n {
+		return n
+	}
+
+	return workers
+}
+
+// send attempts the deliveries of queue and records their outcome, until queue is drained or
+// recording an outcome fails in any worker.
+func (d *Dispatcher) send(ctx context.Context, queue <-chan *entities.Delivery, errs *firstError) {
+	for del := range queue {
+		if errs.get() != nil {
+			return
+		}
+
+		d.deliver(ctx, del)
+
+		if err := d.webhooks.UpdateDelivery(ctx, del); err != nil {
+			errs.set(err)
+		}
+	}
+}
+
+// deliver POSTs del and updates it with the outcome: delivered, retried after a backoff or, once out
+// of attempts, failed.
+func (d *Dispatcher) deliver(ctx context.Context, del *entities.Delivery) {
+	status, err := d.post(ctx, del)
+
+	now := d.now().UTC()
+	del.Attempts++
+	del.ResponseStatus = status
+
+	if err == nil {
+		del.Status = entities.DeliveryDelivered
+		del.DeliveredAt = &now
+		del.LastError = ""
+
+		return
+	}
+
+	del.LastError = err.Error()
+	if len(del.LastError) > maxErrorLen {
+		del.LastError = del.LastError[:maxErrorLen]
+	}
+
+	if del.Attempts >= d.cfg.MaxAttempts {
+		del.Status = entities.DeliveryFailed
+
+		return
+	}
+
+	del.NextAttemptAt = now.Add(d.backoff(del.Attempts))
+}
+
+// post sends del and returns the response status, 0 when no response was received.
+func (d *Dispatcher) post(ctx context.Context, del *entities.Delivery) (int, error) {
+	body := []byte(del.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, del.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := d.now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(del.EventType))
+	req.Header.Set(DeliveryHeader, del.ID.String())
+	req.Header.Set(TimestampHeader, fmt.Sprint(timestamp))
+	req.Header.Set(SignatureHeader, Sign(del.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrain))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// backoff returns the wait after the given number of failed attempts.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	wait := d.cfg.Backoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+
+	return wait
+}
+
+// firstError keeps the first error the workers of a dispatch run into.
+type firstError struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (e *firstError) set(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.err == nil {
+		e.err = err
+	}
+}
+
+func (e *firstError) get() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.err
+}
@@ -0,0 +1,232 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/errors"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func TestDispatch(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	cfg := DispatchConfig{BatchSize: 10, Lease: 5 * time.Minute, MaxAttempts: 3, Backoff: time.Minute}
+
+	tests := []struct {
+		description string
+		status      int
+		attempts    int
+		expStatus   entities.DeliveryStatus
+		expNext     time.Time
+		expError    string
+	}{
+		{"Success case: delivered", http.StatusNoContent, 0, entities.DeliveryDelivered, now, ""},
+		{"error response is retried after the backoff", http.StatusServiceUnavailable, 1, entities.DeliveryPending,
+			now.Add(2 * time.Minute), "webhook responded 503 Service Unavailable"},
+		{"last attempt fails the delivery", http.StatusInternalServerError, 2, entities.DeliveryFailed, now,
+			"webhook responded 500 Internal Server Error"},
+	}
+
+	for i, tc := range tests {
+		var (
+			verified bool
+			event    string
+		)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			ts, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+			verified = r.Header.Get(SignatureHeader) == Sign("s3cret", ts, body)
+			event = r.Header.Get(EventHeader)
+
+			w.WriteHeader(tc.status)
+		}))
+
+		ctrl := gomock.NewController(t)
+		mockWebhook := store.NewMockWebhookStore(ctrl)
+		del := entities.Delivery{ID: uuid.New(), EventType: entities.StudentCreated, Payload: `{"type":"student.created"}`,
+			Status: entities.DeliveryPending, Attempts: tc.attempts, NextAttemptAt: now, URL: server.URL, Secret: "s3cret"}
+
+		exp := del
+		exp.Attempts++
+		exp.Status, exp.NextAttemptAt, exp.LastError, exp.ResponseStatus = tc.expStatus, tc.expNext, tc.expError, tc.status
+
+		if tc.expStatus == entities.DeliveryDelivered {
+			exp.DeliveredAt = &now
+		}
+
+		mockWebhook.EXPECT().Claim(context.Background(), now, now.Add(5*time.Minute), cfg.BatchSize).Return([]entities.Delivery{del}, nil)
+		mockWebhook.EXPECT().UpdateDelivery(context.Background(), &exp).Return(nil)
+
+		d := NewDispatcher(mockWebhook, cfg)
+		d.now = func() time.Time { return now }
+		d.allowed = func(netip.Addr) bool { return true }
+
+		err := d.Dispatch(context.Background())
+
+		server.Close()
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.True(t, verified, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, "student.created", event, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestDispatchRefused(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	cfg := DispatchConfig{BatchSize: 10, Lease: 5 * time.Minute, MaxAttempts: 3, Backoff: time.Minute}
+
+	tests := []struct {
+		description string
+		allowLocal  bool
+		expStatus   int
+		expError    string
+	}{
+		{"internal address is refused when connecting", false, 0, ErrNotPublic.Error()},
+		{"redirect is not followed", true, http.StatusFound, "webhook responded 302 Found"},
+	}
+
+	for i, tc := range tests {
+		var paths []string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			paths = append(paths, r.URL.Path)
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+		}))
+
+		ctrl := gomock.NewController(t)
+		mockWebhook := store.NewMockWebhookStore(ctrl)
+		del := entities.Delivery{ID: uuid.New(), EventType: entities.StudentCreated, Payload: `{"type":"student.created"}`,
+			Status: entities.DeliveryPending, NextAttemptAt: now, URL: server.URL + "/hooks", Secret: "s3cret"}
+
+		var got entities.Delivery
+
+		mockWebhook.EXPECT().Claim(context.Background(), now, now.Add(5*time.Minute), cfg.BatchSize).Return([]entities.Delivery{del}, nil)
+		mockWebhook.EXPECT().UpdateDelivery(context.Background(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, d *entities.Delivery) error {
+				got = *d
+
+				return nil
+			})
+
+		d := NewDispatcher(mockWebhook, cfg)
+		d.now = func() time.Time { return now }
+
+		if tc.allowLocal {
+			d.allowed = func(netip.Addr) bool { return true }
+		}
+
+		err := d.Dispatch(context.Background())
+
+		server.Close()
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expStatus, got.ResponseStatus, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Contains(t, got.LastError, tc.expError, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, entities.DeliveryPending, got.Status, "Test[%d] failed\n(%s)", i, tc.description)
+
+		if tc.allowLocal {
+			assert.Equal(t, []string{"/hooks"}, paths, "Test[%d] failed\n(%s)", i, tc.description)
+		} else {
+			assert.Empty(t, paths, "Test[%d] failed\n(%s)", i, tc.description)
+		}
+	}
+}
+
+func TestDispatchConcurrency(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	var (
+		mu               sync.Mutex
+		inFlight, maxURL = map[string]int{}, map[string]int{}
+		total, maxTotal  int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight[r.URL.Path]++
+		total++
+
+		if inFlight[r.URL.Path] > maxURL[r.URL.Path] {
+			maxURL[r.URL.Path] = inFlight[r.URL.Path]
+		}
+
+		if total > maxTotal {
+			maxTotal = total
+		}
+		mu.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mu.Lock()
+		inFlight[r.URL.Path]--
+		total--
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var due []entities.Delivery
+
+	for i := 0; i < 12; i++ {
+		due = append(due, entities.Delivery{ID: uuid.New(), EventType: entities.StudentCreated, Payload: "{}",
+			Status: entities.DeliveryPending, NextAttemptAt: now, URL: server.URL + []string{"/a", "/b"}[i%2], Secret: "s3cret"})
+	}
+
+	ctrl := gomock.NewController(t)
+	mockWebhook := store.NewMockWebhookStore(ctrl)
+	mockWebhook.EXPECT().Claim(gomock.Any(), now, now.Add(time.Minute), 12).Return(due, nil)
+	mockWebhook.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).Return(nil).Times(12)
+
+	d := NewDispatcher(mockWebhook, DispatchConfig{BatchSize: 12, Concurrency: 2, Lease: time.Minute, MaxAttempts: 3})
+	d.now = func() time.Time { return now }
+	d.allowed = func(netip.Addr) bool { return true }
+
+	assert.NoError(t, d.Dispatch(context.Background()))
+	assert.Equal(t, map[string]int{"/a": 2, "/b": 2}, maxURL)
+	assert.Equal(t, 4, maxTotal)
+}
+
+func TestDispatchStopsWhenNotRecorded(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var due []entities.Delivery
+
+	for i := 0; i < 3; i++ {
+		due = append(due, entities.Delivery{ID: uuid.New(), EventType: entities.StudentCreated, Payload: "{}",
+			Status: entities.DeliveryPending, NextAttemptAt: now, URL: server.URL, Secret: "s3cret"})
+	}
+
+	ctrl := gomock.NewController(t)
+	mockWebhook := store.NewMockWebhookStore(ctrl)
+	mockWebhook.EXPECT().Claim(gomock.Any(), now, now.Add(time.Minute), 10).Return(due, nil)
+	mockWebhook.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).Return(errors.DB{Reason: "server error"})
+
+	d := NewDispatcher(mockWebhook, DispatchConfig{BatchSize: 10, Lease: time.Minute, MaxAttempts: 3})
+	d.now = func() time.Time { return now }
+	d.allowed = func(netip.Addr) bool { return true }
+
+	assert.Equal(t, errors.DB{Reason: "server error"}, d.Dispatch(context.Background()))
+	assert.Equal(t, 1, requests)
+}
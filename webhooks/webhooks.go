@@ -0,0 +1,71 @@
+// Package webhooks delivers events to the webhooks subscribed to them. The Publisher records a
+// delivery per subscribed webhook and the Dispatcher POSTs them, signed with the webhook's secret,
+// retrying failed deliveries with a backoff.
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+// Headers sent with every delivery. The signature is "sha256=" followed by the hex encoded
+// HMAC-SHA256, keyed with the webhook secret, of the timestamp, a dot and the body; receivers should
+// drop deliveries whose timestamp is too old to be fresh.
+const (
+	EventHeader     = "X-Placement-Event"
+	DeliveryHeader  = "X-Placement-Delivery"
+	TimestampHeader = "X-Placement-Timestamp"
+	SignatureHeader = "X-Placement-Signature"
+)
+
+// Sign returns the signature header value of body sent at timestamp, a Unix time in seconds.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Publisher records the deliveries of events to the webhooks subscribed to them.
+type Publisher struct {
+	webhooks store.WebhookStore
+	// now is replaced in tests to stamp a fixed time.
+	now func() time.Time
+}
+
+func NewPublisher(webhooks store.WebhookStore) *Publisher {
+	return &Publisher{webhooks: webhooks, now: time.Now}
+}
+
+// Handle queues a delivery of e to every webhook of the college subscribed to its type. It is an
+// outbox sink; the payload carries the event ID, which receivers can use to drop duplicates.
+func (p *Publisher) Handle(ctx context.Context, e *entities.Event) error {
+	subscribed, err := p.webhooks.GetByEvent(ctx, e.Type)
+	if err != nil || len(subscribed) == 0 {
+		return err
+	}
+
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	now := p.now().UTC()
+	deliveries := make([]entities.Delivery, len(subscribed))
+
+	for i := range subscribed {
+		deliveries[i] = entities.Delivery{WebhookID: subscribed[i].ID, EventID: e.ID, EventType: e.Type, Payload: string(payload),
+			Status: entities.DeliveryPending, NextAttemptAt: now, CreatedAt: now}
+	}
+
+	return p.webhooks.Enqueue(ctx, deliveries)
+}
@@ -0,0 +1,60 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aditi-zs/Placement-API/entities"
+	"github.com/aditi-zs/Placement-API/store"
+)
+
+func TestSign(t *testing.T) {
+	// Computed with: printf '1688202000.{}' | openssl dgst -sha256 -hmac s3cret
+	assert.Equal(t, "sha256=70c142e53b7d3f03fd93fda3c32e2c9af66c39e54c1cc08fb4c1a05c5fe65f20", Sign("s3cret", 1688202000, []byte("{}")))
+}
+
+func TestHandle(t *testing.T) {
+	now := time.Date(2023, 7, 1, 9, 0, 0, 0, time.UTC)
+	hookA, hookB := uuid.New(), uuid.New()
+	e := entities.Event{ID: uuid.New(), Type: entities.CompanyCreated, OccurredAt: now,
+		Company: &entities.Company{ID: uuid.New(), Name: "Wipro", Category: "MASS"}}
+	payload, _ := json.Marshal(&e)
+
+	tests := []struct {
+		description string
+		subscribed  []entities.Webhook
+		expQueued   []entities.Delivery
+	}{
+		{"a delivery per subscribed webhook", []entities.Webhook{{ID: hookA}, {ID: hookB}}, []entities.Delivery{
+			{WebhookID: hookA, EventID: e.ID, EventType: e.Type, Payload: string(payload), Status: entities.DeliveryPending,
+				NextAttemptAt: now, CreatedAt: now},
+			{WebhookID: hookB, EventID: e.ID, EventType: e.Type, Payload: string(payload), Status: entities.DeliveryPending,
+				NextAttemptAt: now, CreatedAt: now},
+		}},
+		{"nothing is queued without subscribers", nil, nil},
+	}
+
+	for i, tc := range tests {
+		ctrl := gomock.NewController(t)
+		mockWebhook := store.NewMockWebhookStore(ctrl)
+
+		mockWebhook.EXPECT().GetByEvent(context.Background(), entities.CompanyCreated).Return(tc.subscribed, nil)
+
+		if tc.expQueued != nil {
+			mockWebhook.EXPECT().Enqueue(context.Background(), tc.expQueued).Return(nil)
+		}
+
+		p := NewPublisher(mockWebhook)
+		p.now = func() time.Time { return now }
+
+		err := p.Handle(context.Background(), &e)
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
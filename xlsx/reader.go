@@ -0,0 +1,225 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The limits of a worksheet, as set by Excel, and of what ReadRows decompresses and allocates.
+// Rows, columns and parts beyond them are refused, since the sizes come from the file itself and
+// a small upload could otherwise expand to gigabytes.
+const (
+	maxRows     = 1 << 20  // row 1048576
+	maxColumns  = 1 << 14  // column XFD
+	maxCells    = 1 << 22  // including the empty cells padded in before a cell
+	maxPartSize = 64 << 20 // decompressed size of a single XML part
+)
+
+var (
+	errNoSheet      = errors.New("xlsx: workbook has no worksheets")
+	errTooManyRows  = errors.New("xlsx: row number is beyond the last row of a worksheet")
+	errTooManyCols  = errors.New("xlsx: column is beyond the last column of a worksheet")
+	errTooManyCells = errors.New("xlsx: worksheet has too many cells")
+	errPartTooLarge = errors.New("xlsx: workbook part is too large")
+)
+
+type sharedStrings struct {
+	Items []richText `xml:"si"`
+}
+
+type richText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (r richText) String() string {
+	if len(r.Runs) == 0 {
+		return r.Text
+	}
+
+	var b strings.Builder
+	for _, run := range r.Runs {
+		b.WriteString(run.Text)
+	}
+
+	return b.String()
+}
+
+type worksheet struct {
+	Rows []struct {
+		Index int `xml:"r,attr"`
+		Cells []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline richText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// ReadRows returns the cell values of the first worksheet in the workbook, one slice per row.
+// Rows and cells that are absent from the sheet are returned as empty values.
+func ReadRows(r io.ReaderAt, size int64) ([][]string, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	sheet := firstSheet(files)
+	if sheet == nil {
+		return nil, errNoSheet
+	}
+
+	var shared sharedStrings
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		if err = decode(f, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	var ws worksheet
+	if err = decode(sheet, &ws); err != nil {
+		return nil, err
+	}
+
+	var (
+		rows  [][]string
+		cells int
+	)
+
+	for _, row := range ws.Rows {
+		if row.Index > maxRows || (row.Index == 0 && len(rows) >= maxRows) {
+			return nil, errTooManyRows
+		}
+
+		for row.Index > len(rows)+1 {
+			rows = append(rows, nil)
+		}
+
+		var values []string
+
+		for _, c := range row.Cells {
+			col, ok := columnIndex(c.Ref, len(values))
+			if !ok {
+				return nil, errTooManyCols
+			}
+
+			if cells += col + 1 - len(values); cells > maxCells {
+				return nil, errTooManyCells
+			}
+
+			for len(values) < col {
+				values = append(values, "")
+			}
+
+			values = append(values, cellValue(c.Type, c.Value, c.Inline, shared))
+		}
+
+		rows = append(rows, values)
+	}
+
+	return rows, nil
+}
+
+// SerialToTime converts a spreadsheet date serial number to a time in UTC.
+func SerialToTime(serial float64) time.Time {
+	const secondsPerDay = 24 * 60 * 60
+
+	epoch := time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+	return epoch.Add(time.Duration(serial*secondsPerDay) * time.Second)
+}
+
+func cellValue(typ, value string, inline richText, shared sharedStrings) string {
+	switch typ {
+	case "s":
+		i, err := strconv.Atoi(value)
+		if err != nil || i < 0 || i >= len(shared.Items) {
+			return ""
+		}
+
+		return shared.Items[i].String()
+	case "inlineStr":
+		return inline.String()
+	default:
+		return value
+	}
+}
+
+// columnIndex converts the column letters of a cell reference such as "C7" to a zero based index.
+// Cells without a reference are placed after the previous cell. It reports false for a column
+// beyond XFD.
+func columnIndex(ref string, next int) (int, bool) {
+	col := 0
+
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+
+		if col = col*26 + int(ch-'A'+1); col > maxColumns {
+			return 0, false
+		}
+	}
+
+	if col == 0 {
+		return next, next < maxColumns
+	}
+
+	return col - 1, true
+}
+
+func firstSheet(files map[string]*zip.File) *zip.File {
+	if f, ok := files["xl/worksheets/sheet1.xml"]; ok {
+		return f
+	}
+
+	var names []string
+
+	for name := range files {
+		if path.Dir(name) == "xl/worksheets" && path.Ext(name) == ".xml" {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 {
+		return nil
+	}
+
+	sort.Strings(names)
+
+	return files[names[0]]
+}
+
+func decode(f *zip.File, v interface{}) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+
+	defer rc.Close()
+
+	// one byte past the limit tells a part that is too large apart from one that fits exactly
+	lr := &io.LimitedReader{R: rc, N: maxPartSize + 1}
+
+	err = xml.NewDecoder(lr).Decode(v)
+	if lr.N == 0 {
+		return errPartTooLarge
+	}
+
+	return err
+}
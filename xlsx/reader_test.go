@@ -0,0 +1,113 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func buildWorkbook(t *testing.T, files map[string]string) *bytes.Reader {
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _ = w.Write([]byte(content))
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestReadRows(t *testing.T) {
+	shared := `<sst><si><t>name</t></si><si><t>branch</t></si><si><r><t>Aditi </t></r><r><t>Jaiswal</t></r></si></sst>`
+	sheet := `<worksheet><sheetData>` +
+		`<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c><c r="C1" t="inlineStr"><is><t>dob</t></is></c></row>` +
+		`<row r="3"><c r="A3" t="s"><v>2</v></c><c r="C3"><v>36708</v></c></row>` +
+		`</sheetData></worksheet>`
+
+	tests := []struct {
+		description string
+		files       map[string]string
+		expRes      [][]string
+		expErr      error
+	}{
+		{"Success case: shared, inline and numeric cells",
+			map[string]string{"xl/sharedStrings.xml": shared, "xl/worksheets/sheet1.xml": sheet},
+			[][]string{{"name", "branch", "dob"}, nil, {"Aditi Jaiswal", "", "36708"}}, nil,
+		},
+		{"Success case: first sheet by name when sheet1 is absent",
+			map[string]string{"xl/worksheets/sheet2.xml": `<worksheet><sheetData><row r="1"><c t="str"><v>x</v></c></row></sheetData></worksheet>`},
+			[][]string{{"x"}}, nil,
+		},
+		{"Error case: no worksheets", map[string]string{"xl/workbook.xml": `<workbook/>`}, nil, errNoSheet},
+	}
+
+	for i, tc := range tests {
+		r := buildWorkbook(t, tc.files)
+		output, err := ReadRows(r, r.Size())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.expRes, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestReadRowsLimits(t *testing.T) {
+	sheet := func(rows string) map[string]string {
+		return map[string]string{"xl/worksheets/sheet1.xml": `<worksheet><sheetData>` + rows + `</sheetData></worksheet>`}
+	}
+
+	tests := []struct {
+		description string
+		files       map[string]string
+		expLen      int
+		expErr      error
+	}{
+		{"Success case: last row and column", sheet(`<row r="1048576"><c r="XFD1048576"><v>1</v></c></row>`), maxRows, nil},
+		{"Error case: row beyond the last row", sheet(`<row r="1000000000"><c r="A1"><v>1</v></c></row>`), 0, errTooManyRows},
+		{"Error case: column beyond XFD", sheet(`<row r="1"><c r="XFE1"><v>1</v></c></row>`), 0, errTooManyCols},
+		{"Error case: column letters overflowing", sheet(`<row r="1"><c r="ZZZZZZZZZZZZZZZZ1"><v>1</v></c></row>`), 0,
+			errTooManyCols},
+		{"Error case: too many padded cells", sheet(strings.Repeat(`<row><c r="XFD1"><v>1</v></c></row>`, 300)), 0,
+			errTooManyCells},
+		{"Error case: decompressed sheet too large",
+			map[string]string{"xl/worksheets/sheet1.xml": `<worksheet>` + strings.Repeat(" ", maxPartSize) + `</worksheet>`}, 0,
+			errPartTooLarge},
+		{"Error case: decompressed shared strings too large", map[string]string{
+			"xl/sharedStrings.xml":     `<sst>` + strings.Repeat(" ", maxPartSize) + `</sst>`,
+			"xl/worksheets/sheet1.xml": `<worksheet/>`,
+		}, 0, errPartTooLarge},
+	}
+
+	for i, tc := range tests {
+		r := buildWorkbook(t, tc.files)
+		output, err := ReadRows(r, r.Size())
+
+		assert.Equal(t, tc.expErr, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Len(t, output, tc.expLen, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestReadRowsInvalidArchive(t *testing.T) {
+	r := bytes.NewReader([]byte("name,branch"))
+	_, err := ReadRows(r, r.Size())
+
+	assert.Error(t, err)
+}
+
+func TestSerialToTime(t *testing.T) {
+	assert.Equal(t, time.Date(2000, time.July, 2, 0, 0, 0, 0, time.UTC), SerialToTime(36709))
+	assert.Equal(t, time.Date(1899, time.December, 31, 12, 0, 0, 0, time.UTC), SerialToTime(1.5))
+}
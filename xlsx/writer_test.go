@@ -0,0 +1,50 @@
+package xlsx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	tests := []struct {
+		description string
+		rows        [][]string
+	}{
+		{"Success case: header and data rows", [][]string{{"name", "branch"}, {"Aditi", "CSE"}}},
+		{"Success case: values are escaped", [][]string{{`<b>&"Co"</b>`, " padded "}}},
+		{"Success case: no rows", nil},
+	}
+
+	for i, tc := range tests {
+		var buf bytes.Buffer
+
+		w, err := NewWriter(&buf, "Students & Results")
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+
+		for _, row := range tc.rows {
+			assert.NoError(t, w.WriteRow(row), "Test[%d] failed\n(%s)", i, tc.description)
+		}
+
+		assert.NoError(t, w.Close(), "Test[%d] failed\n(%s)", i, tc.description)
+
+		output, err := ReadRows(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+		assert.NoError(t, err, "Test[%d] failed\n(%s)", i, tc.description)
+		assert.Equal(t, tc.rows, output, "Test[%d] failed\n(%s)", i, tc.description)
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	tests := map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"}
+
+	for input, exp := range tests {
+		assert.Equal(t, exp, columnName(input), "column %d", input)
+
+		col, ok := columnIndex(exp+"1", 0)
+
+		assert.True(t, ok, "column %s", exp)
+		assert.Equal(t, input, col, "column %s", exp)
+	}
+}